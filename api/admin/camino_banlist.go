@@ -0,0 +1,209 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package admin
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+var (
+	errBanlistNotSet   = errors.New("banlist is not enabled")
+	errNoPeer          = errors.New("either nodeID or ip must be specified")
+	errNodeIDAndIP     = errors.New("only one of nodeID and ip can be specified")
+	errInvalidIP       = errors.New("invalid IP")
+	errExpiryInThePast = errors.New("expiry is in the past")
+)
+
+// BanPeerArgs are the arguments for calling BanPeer. Exactly one of [NodeID]
+// and [IP] must be specified.
+type BanPeerArgs struct {
+	NodeID *ids.NodeID `json:"nodeID"`
+	IP     string      `json:"ip"`
+	// Unix timestamp, at which the ban expires. If 0, the ban never expires.
+	Expiry json.Uint64 `json:"expiry"`
+	Reason string      `json:"reason"`
+}
+
+// BanPeer bans a node ID or an IP. Existing connections to the banned peer are
+// closed and new connections are refused. The ban is persisted in the node
+// database.
+func (a *Admin) BanPeer(_ *http.Request, args *BanPeerArgs, _ *api.EmptyReply) error {
+	a.Log.Debug("Admin: BanPeer called",
+		zap.Stringer("nodeID", args.NodeID),
+		logging.UserString("ip", args.IP),
+		zap.Uint64("expiry", uint64(args.Expiry)),
+		logging.UserString("reason", args.Reason),
+	)
+
+	if a.Banlist == nil {
+		return errBanlistNotSet
+	}
+	ip, err := parsePeer(args.NodeID, args.IP)
+	if err != nil {
+		return err
+	}
+	var expiry time.Time
+	if args.Expiry != 0 {
+		expiry = time.Unix(int64(args.Expiry), 0)
+		if !expiry.After(time.Now()) {
+			return errExpiryInThePast
+		}
+	}
+
+	if args.NodeID != nil {
+		err = a.Banlist.BanNodeID(*args.NodeID, expiry, args.Reason)
+	} else {
+		err = a.Banlist.BanIP(ip, expiry, args.Reason)
+	}
+	if err != nil {
+		return err
+	}
+	a.Network.DisconnectBannedPeers()
+	return nil
+}
+
+// UnbanPeerArgs are the arguments for calling UnbanPeer. Exactly one of
+// [NodeID] and [IP] must be specified.
+type UnbanPeerArgs struct {
+	NodeID *ids.NodeID `json:"nodeID"`
+	IP     string      `json:"ip"`
+}
+
+// UnbanPeer removes the ban of a node ID or of an IP
+func (a *Admin) UnbanPeer(_ *http.Request, args *UnbanPeerArgs, _ *api.EmptyReply) error {
+	a.Log.Debug("Admin: UnbanPeer called",
+		zap.Stringer("nodeID", args.NodeID),
+		logging.UserString("ip", args.IP),
+	)
+
+	if a.Banlist == nil {
+		return errBanlistNotSet
+	}
+	ip, err := parsePeer(args.NodeID, args.IP)
+	if err != nil {
+		return err
+	}
+	if args.NodeID != nil {
+		return a.Banlist.UnbanNodeID(*args.NodeID)
+	}
+	return a.Banlist.UnbanIP(ip)
+}
+
+// APIBan is a ban of a node ID or of an IP
+type APIBan struct {
+	NodeID *ids.NodeID `json:"nodeID,omitempty"`
+	IP     string      `json:"ip,omitempty"`
+	// Unix timestamp, at which the ban expires. If 0, the ban never expires.
+	Expiry json.Uint64 `json:"expiry"`
+	Reason string      `json:"reason"`
+}
+
+// ListBansReply is the response from calling ListBans
+type ListBansReply struct {
+	Bans []APIBan `json:"bans"`
+}
+
+// ListBans returns all the bans, which didn't expire yet
+func (a *Admin) ListBans(_ *http.Request, _ *struct{}, reply *ListBansReply) error {
+	a.Log.Debug("Admin: ListBans called")
+
+	if a.Banlist == nil {
+		return errBanlistNotSet
+	}
+	bans := a.Banlist.Bans()
+	reply.Bans = make([]APIBan, len(bans))
+	for i, ban := range bans {
+		apiBan := APIBan{Reason: ban.Reason}
+		if ban.IP != nil {
+			apiBan.IP = ban.IP.String()
+		} else {
+			nodeID := ban.NodeID
+			apiBan.NodeID = &nodeID
+		}
+		if !ban.Expiry.IsZero() {
+			apiBan.Expiry = json.Uint64(ban.Expiry.Unix())
+		}
+		reply.Bans[i] = apiBan
+	}
+	return nil
+}
+
+// AllowPeerArgs are the arguments for calling AllowPeer and DisallowPeer
+type AllowPeerArgs struct {
+	NodeID ids.NodeID `json:"nodeID"`
+}
+
+// AllowPeer adds a node ID to the allowlist. The allowlist is persisted in the
+// node database. It is only enforced if the node runs in allowlist mode.
+func (a *Admin) AllowPeer(_ *http.Request, args *AllowPeerArgs, _ *api.EmptyReply) error {
+	a.Log.Debug("Admin: AllowPeer called",
+		zap.Stringer("nodeID", args.NodeID),
+	)
+
+	if a.Banlist == nil {
+		return errBanlistNotSet
+	}
+	return a.Banlist.Allow(args.NodeID)
+}
+
+// DisallowPeer removes a node ID from the allowlist. If the node runs in
+// allowlist mode, the existing connection to the peer is closed.
+func (a *Admin) DisallowPeer(_ *http.Request, args *AllowPeerArgs, _ *api.EmptyReply) error {
+	a.Log.Debug("Admin: DisallowPeer called",
+		zap.Stringer("nodeID", args.NodeID),
+	)
+
+	if a.Banlist == nil {
+		return errBanlistNotSet
+	}
+	if err := a.Banlist.Disallow(args.NodeID); err != nil {
+		return err
+	}
+	a.Network.DisconnectBannedPeers()
+	return nil
+}
+
+// ListAllowedPeersReply is the response from calling ListAllowedPeers
+type ListAllowedPeersReply struct {
+	NodeIDs []ids.NodeID `json:"nodeIDs"`
+}
+
+// ListAllowedPeers returns all the node IDs in the allowlist
+func (a *Admin) ListAllowedPeers(_ *http.Request, _ *struct{}, reply *ListAllowedPeersReply) error {
+	a.Log.Debug("Admin: ListAllowedPeers called")
+
+	if a.Banlist == nil {
+		return errBanlistNotSet
+	}
+	reply.NodeIDs = a.Banlist.Allowed()
+	return nil
+}
+
+// parsePeer verifies that exactly one of [nodeID] and [ipStr] is specified and
+// returns the parsed IP, if [ipStr] is specified
+func parsePeer(nodeID *ids.NodeID, ipStr string) (net.IP, error) {
+	switch {
+	case nodeID == nil && ipStr == "":
+		return nil, errNoPeer
+	case nodeID != nil && ipStr != "":
+		return nil, errNodeIDAndIP
+	case nodeID != nil:
+		return nil, nil
+	}
+	ip := net.ParseIP(ipStr)
+	if ip == nil {
+		return nil, errInvalidIP
+	}
+	return ip, nil
+}
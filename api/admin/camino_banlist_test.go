@@ -0,0 +1,126 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package admin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network"
+	"github.com/ava-labs/avalanchego/network/banlist"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+type testNetwork struct {
+	network.Network
+	numDisconnects int
+}
+
+func (n *testNetwork) DisconnectBannedPeers() {
+	n.numDisconnects++
+}
+
+func newBanlistTestAdmin(t *testing.T) (*Admin, *testNetwork) {
+	bl, err := banlist.New(memdb.New())
+	require.NoError(t, err)
+	net := &testNetwork{}
+	return &Admin{Config: Config{
+		Log:     logging.NoLog{},
+		Network: net,
+		Banlist: bl,
+	}}, net
+}
+
+func TestServiceBanPeer(t *testing.T) {
+	require := require.New(t)
+
+	admin, net := newBanlistTestAdmin(t)
+
+	nodeID := ids.GenerateTestNodeID()
+	expiry := json.Uint64(time.Now().Add(time.Hour).Unix())
+	require.NoError(admin.BanPeer(nil, &BanPeerArgs{
+		NodeID: &nodeID,
+		Expiry: expiry,
+		Reason: "spam",
+	}, &api.EmptyReply{}))
+	require.NoError(admin.BanPeer(nil, &BanPeerArgs{
+		IP: "1.2.3.4",
+	}, &api.EmptyReply{}))
+	require.Equal(2, net.numDisconnects)
+
+	reply := ListBansReply{}
+	require.NoError(admin.ListBans(nil, nil, &reply))
+	require.ElementsMatch([]APIBan{
+		{NodeID: &nodeID, Expiry: expiry, Reason: "spam"},
+		{IP: "1.2.3.4"},
+	}, reply.Bans)
+
+	require.NoError(admin.UnbanPeer(nil, &UnbanPeerArgs{NodeID: &nodeID}, &api.EmptyReply{}))
+	require.NoError(admin.UnbanPeer(nil, &UnbanPeerArgs{IP: "1.2.3.4"}, &api.EmptyReply{}))
+	require.NoError(admin.ListBans(nil, nil, &reply))
+	require.Empty(reply.Bans)
+}
+
+func TestServiceBanPeerInvalidArgs(t *testing.T) {
+	nodeID := ids.GenerateTestNodeID()
+	tests := map[string]struct {
+		args        BanPeerArgs
+		expectedErr error
+	}{
+		"no peer": {
+			args:        BanPeerArgs{},
+			expectedErr: errNoPeer,
+		},
+		"node ID and IP": {
+			args:        BanPeerArgs{NodeID: &nodeID, IP: "1.2.3.4"},
+			expectedErr: errNodeIDAndIP,
+		},
+		"invalid IP": {
+			args:        BanPeerArgs{IP: "1.2.3"},
+			expectedErr: errInvalidIP,
+		},
+		"expiry in the past": {
+			args:        BanPeerArgs{NodeID: &nodeID, Expiry: 1},
+			expectedErr: errExpiryInThePast,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			admin, net := newBanlistTestAdmin(t)
+			err := admin.BanPeer(nil, &tt.args, &api.EmptyReply{})
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Zero(t, net.numDisconnects)
+		})
+	}
+}
+
+func TestServiceAllowPeer(t *testing.T) {
+	require := require.New(t)
+
+	admin, net := newBanlistTestAdmin(t)
+
+	nodeID := ids.GenerateTestNodeID()
+	require.NoError(admin.AllowPeer(nil, &AllowPeerArgs{NodeID: nodeID}, &api.EmptyReply{}))
+
+	reply := ListAllowedPeersReply{}
+	require.NoError(admin.ListAllowedPeers(nil, nil, &reply))
+	require.Equal([]ids.NodeID{nodeID}, reply.NodeIDs)
+
+	require.NoError(admin.DisallowPeer(nil, &AllowPeerArgs{NodeID: nodeID}, &api.EmptyReply{}))
+	require.Equal(1, net.numDisconnects)
+	require.NoError(admin.ListAllowedPeers(nil, nil, &reply))
+	require.Empty(reply.NodeIDs)
+}
+
+func TestServiceBanlistNotSet(t *testing.T) {
+	admin := &Admin{Config: Config{Log: logging.NoLog{}}}
+	err := admin.BanPeer(nil, &BanPeerArgs{IP: "1.2.3.4"}, &api.EmptyReply{})
+	require.ErrorIs(t, err, errBanlistNotSet)
+}
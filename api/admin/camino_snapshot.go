@@ -0,0 +1,191 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/chains"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/snapshot"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/perms"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ chains.Registrant = (*chainTracker)(nil)
+
+	errNoSnapshotPath  = errors.New("snapshot path is not specified")
+	errSnapshotExists  = errors.New("snapshot file already exists")
+	errSnapshotsNotSet = errors.New("database snapshots are not enabled")
+)
+
+// chainTracker tracks created chains, so their last accepted blocks could be
+// recorded in the database snapshot manifest.
+type chainTracker struct {
+	lock   sync.Mutex
+	chains []trackedChain
+}
+
+type trackedChain struct {
+	name   string
+	engine common.Engine
+}
+
+// newChainTracker returns a new chain tracker. It must be registered as a
+// chain registrant to the chain manager before any chain is created.
+func newChainTracker() *chainTracker {
+	return &chainTracker{}
+}
+
+// RegisterChain adds the chain of [engine] to the tracked chains.
+func (t *chainTracker) RegisterChain(name string, engine common.Engine) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.chains = append(t.chains, trackedChain{
+		name:   name,
+		engine: engine,
+	})
+}
+
+// snapshot returns the state of the tracked chains and the iterator over
+// [db], which reads the same state. Context locks of all tracked chains are
+// held while the iterator is created, so no chain can accept anything in
+// between.
+func (t *chainTracker) snapshot(ctx context.Context, db database.Iteratee) ([]snapshot.Chain, database.Iterator, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for _, chain := range t.chains {
+		chain.engine.Context().Lock.Lock()
+	}
+	defer func() {
+		for _, chain := range t.chains {
+			chain.engine.Context().Lock.Unlock()
+		}
+	}()
+
+	chains := make([]snapshot.Chain, len(t.chains))
+	for i, chain := range t.chains {
+		chains[i] = snapshot.Chain{
+			ChainID: chain.engine.Context().ChainID,
+			Name:    chain.name,
+		}
+
+		vm, ok := chain.engine.GetVM().(block.ChainVM)
+		if !ok {
+			continue
+		}
+		lastAcceptedID, err := vm.LastAccepted(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("couldn't get last accepted block of chain %s: %w", chain.name, err)
+		}
+		lastAccepted, err := vm.GetBlock(ctx, lastAcceptedID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("couldn't get last accepted block of chain %s: %w", chain.name, err)
+		}
+		chains[i].LastAccepted = lastAcceptedID
+		chains[i].Height = json.Uint64(lastAccepted.Height())
+	}
+	return chains, db.NewIterator(), nil
+}
+
+// SnapshotDBArgs are the arguments for calling SnapshotDB
+type SnapshotDBArgs struct {
+	// Path of the archive file, which must not exist yet
+	Path string `json:"path"`
+}
+
+// SnapshotDBReply is the response from calling SnapshotDB
+type SnapshotDBReply struct {
+	Manifest snapshot.Manifest `json:"manifest"`
+	// Number of key-value pairs written into the archive
+	Keys json.Uint64 `json:"keys"`
+}
+
+// SnapshotDB writes a point-in-time copy of the current version database into
+// the archive at the given path. The archive can be restored into a fresh
+// database directory while the node is stopped.
+//
+// The archive isn't encrypted. If the database is encrypted at rest, the
+// archive contains its decrypted keys and values, so it must be protected in
+// the same way, as the database encryption key.
+func (a *Admin) SnapshotDB(r *http.Request, args *SnapshotDBArgs, reply *SnapshotDBReply) error {
+	a.Log.Debug("Admin: SnapshotDB called",
+		logging.UserString("path", args.Path),
+	)
+
+	if a.DBManager == nil {
+		return errSnapshotsNotSet
+	}
+	if args.Path == "" {
+		return errNoSnapshotPath
+	}
+	if _, err := os.Stat(args.Path); err == nil {
+		return fmt.Errorf("%w: %s", errSnapshotExists, args.Path)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	// The archive is written into a temporary file first, so an incomplete
+	// archive is never left at [args.Path].
+	tmpPath := args.Path + ".tmp"
+	file, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perms.ReadWrite)
+	if err != nil {
+		return err
+	}
+	if err := a.writeSnapshot(r.Context(), file, reply); err != nil {
+		errs := wrappers.Errs{}
+		errs.Add(err, file.Close(), os.Remove(tmpPath))
+		return errs.Err
+	}
+	if err := file.Close(); err != nil {
+		errs := wrappers.Errs{}
+		errs.Add(err, os.Remove(tmpPath))
+		return errs.Err
+	}
+	if err := os.Rename(tmpPath, args.Path); err != nil {
+		return err
+	}
+
+	a.Log.Info("created database snapshot",
+		zap.String("path", args.Path),
+		zap.Uint64("keys", uint64(reply.Keys)),
+	)
+	return nil
+}
+
+func (a *Admin) writeSnapshot(ctx context.Context, file *os.File, reply *SnapshotDBReply) error {
+	currentDB := a.DBManager.Current()
+	chains, it, err := a.chainTracker.snapshot(ctx, currentDB.Database)
+	if err != nil {
+		return err
+	}
+	defer it.Release()
+
+	reply.Manifest = snapshot.Manifest{
+		DatabaseVersion: currentDB.Version.String(),
+		Timestamp:       time.Now().UTC(),
+		Chains:          chains,
+	}
+	keys, err := snapshot.Write(file, &reply.Manifest, it)
+	if err != nil {
+		return err
+	}
+	reply.Keys = json.Uint64(keys)
+	return file.Sync()
+}
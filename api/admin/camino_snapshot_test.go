@@ -0,0 +1,144 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package admin
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/chains"
+	"github.com/ava-labs/avalanchego/database/manager"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/database/snapshot"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/choices"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/version"
+)
+
+func TestServiceSnapshotDB(t *testing.T) {
+	require := require.New(t)
+
+	dbManager := manager.NewMemDB(version.CurrentDatabase)
+	db := dbManager.Current().Database
+	require.NoError(db.Put([]byte("key"), []byte("value")))
+
+	lastAccepted := &snowman.TestBlock{
+		TestDecidable: choices.TestDecidable{IDV: ids.GenerateTestID()},
+		HeightV:       7,
+	}
+	vm := &block.TestVM{
+		LastAcceptedF: func(context.Context) (ids.ID, error) {
+			return lastAccepted.ID(), nil
+		},
+		GetBlockF: func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+			require.Equal(lastAccepted.ID(), blkID)
+			return lastAccepted, nil
+		},
+	}
+	linearCtx := snow.DefaultConsensusContextTest()
+	linearCtx.ChainID = ids.GenerateTestID()
+	dagCtx := snow.DefaultConsensusContextTest()
+	dagCtx.ChainID = ids.GenerateTestID()
+
+	chainTracker := newChainTracker()
+	chainTracker.RegisterChain("linear", &common.EngineTest{
+		ContextF: func() *snow.ConsensusContext { return linearCtx },
+		GetVMF:   func() common.VM { return vm },
+	})
+	chainTracker.RegisterChain("dag", &common.EngineTest{
+		ContextF: func() *snow.ConsensusContext { return dagCtx },
+		GetVMF:   func() common.VM { return &common.TestVM{} },
+	})
+
+	admin := &Admin{
+		Config: Config{
+			Log:       logging.NoLog{},
+			DBManager: dbManager,
+		},
+		chainTracker: chainTracker,
+	}
+
+	path := filepath.Join(t.TempDir(), "snapshot")
+	request, err := http.NewRequest(http.MethodPost, "", nil)
+	require.NoError(err)
+	reply := &SnapshotDBReply{}
+	require.NoError(admin.SnapshotDB(request, &SnapshotDBArgs{Path: path}, reply))
+	require.EqualValues(1, reply.Keys)
+	require.Equal(version.CurrentDatabase.String(), reply.Manifest.DatabaseVersion)
+	require.Equal([]snapshot.Chain{
+		{
+			ChainID:      linearCtx.ChainID,
+			Name:         "linear",
+			LastAccepted: lastAccepted.ID(),
+			Height:       7,
+		},
+		{
+			ChainID: dagCtx.ChainID,
+			Name:    "dag",
+		},
+	}, reply.Manifest.Chains)
+
+	// chain locks must be released
+	require.True(linearCtx.Lock.TryLock())
+	require.True(dagCtx.Lock.TryLock())
+
+	file, err := os.Open(path)
+	require.NoError(err)
+	defer file.Close()
+	reader, err := snapshot.NewReader(file)
+	require.NoError(err)
+	require.Equal(reply.Manifest.Chains, reader.Manifest().Chains)
+	restoredDB := memdb.New()
+	keys, err := reader.Restore(restoredDB)
+	require.NoError(err)
+	require.EqualValues(1, keys)
+	value, err := restoredDB.Get([]byte("key"))
+	require.NoError(err)
+	require.Equal([]byte("value"), value)
+
+	// existing snapshots must not be overwritten
+	err = admin.SnapshotDB(request, &SnapshotDBArgs{Path: path}, &SnapshotDBReply{})
+	require.ErrorIs(err, errSnapshotExists)
+}
+
+type registrantsManager struct {
+	chains.MockManager
+	registrants []chains.Registrant
+}
+
+func (m *registrantsManager) AddRegistrant(r chains.Registrant) {
+	m.registrants = append(m.registrants, r)
+}
+
+func TestNewServiceRegistersChainTracker(t *testing.T) {
+	require := require.New(t)
+
+	chainManager := &registrantsManager{}
+	_, err := NewService(Config{
+		Log:          logging.NoLog{},
+		ChainManager: chainManager,
+		DBManager:    manager.NewMemDB(version.CurrentDatabase),
+	})
+	require.NoError(err)
+	require.Len(chainManager.registrants, 1)
+	require.IsType(&chainTracker{}, chainManager.registrants[0])
+}
+
+func TestNewServiceWithoutChainManager(t *testing.T) {
+	_, err := NewService(Config{
+		Log:       logging.NoLog{},
+		DBManager: manager.NewMemDB(version.CurrentDatabase),
+	})
+	require.NoError(t, err)
+}
@@ -39,6 +39,13 @@ type Client interface {
 	SetLoggerLevel(ctx context.Context, loggerName, logLevel, displayLevel string, options ...rpc.Option) error
 	GetLoggerLevel(ctx context.Context, loggerName string, options ...rpc.Option) (map[string]LogAndDisplayLevels, error)
 	GetConfig(ctx context.Context, options ...rpc.Option) (interface{}, error)
+	SnapshotDB(ctx context.Context, path string, options ...rpc.Option) (*SnapshotDBReply, error)
+	BanPeer(ctx context.Context, args *BanPeerArgs, options ...rpc.Option) error
+	UnbanPeer(ctx context.Context, args *UnbanPeerArgs, options ...rpc.Option) error
+	ListBans(ctx context.Context, options ...rpc.Option) ([]APIBan, error)
+	AllowPeer(ctx context.Context, nodeID ids.NodeID, options ...rpc.Option) error
+	DisallowPeer(ctx context.Context, nodeID ids.NodeID, options ...rpc.Option) error
+	ListAllowedPeers(ctx context.Context, options ...rpc.Option) ([]ids.NodeID, error)
 }
 
 // Client implementation for the Avalanche Platform Info API Endpoint
@@ -150,6 +157,46 @@ func (c *client) GetConfig(ctx context.Context, options ...rpc.Option) (interfac
 	return res, err
 }
 
+func (c *client) SnapshotDB(ctx context.Context, path string, options ...rpc.Option) (*SnapshotDBReply, error) {
+	res := &SnapshotDBReply{}
+	err := c.requester.SendRequest(ctx, "admin.snapshotDB", &SnapshotDBArgs{
+		Path: path,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) BanPeer(ctx context.Context, args *BanPeerArgs, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "admin.banPeer", args, &api.EmptyReply{}, options...)
+}
+
+func (c *client) UnbanPeer(ctx context.Context, args *UnbanPeerArgs, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "admin.unbanPeer", args, &api.EmptyReply{}, options...)
+}
+
+func (c *client) ListBans(ctx context.Context, options ...rpc.Option) ([]APIBan, error) {
+	res := &ListBansReply{}
+	err := c.requester.SendRequest(ctx, "admin.listBans", struct{}{}, res, options...)
+	return res.Bans, err
+}
+
+func (c *client) AllowPeer(ctx context.Context, nodeID ids.NodeID, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "admin.allowPeer", &AllowPeerArgs{
+		NodeID: nodeID,
+	}, &api.EmptyReply{}, options...)
+}
+
+func (c *client) DisallowPeer(ctx context.Context, nodeID ids.NodeID, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "admin.disallowPeer", &AllowPeerArgs{
+		NodeID: nodeID,
+	}, &api.EmptyReply{}, options...)
+}
+
+func (c *client) ListAllowedPeers(ctx context.Context, options ...rpc.Option) ([]ids.NodeID, error) {
+	res := &ListAllowedPeersReply{}
+	err := c.requester.SendRequest(ctx, "admin.listAllowedPeers", struct{}{}, res, options...)
+	return res.NodeIDs, err
+}
+
 func (c *client) GetNodeSigner(ctx context.Context, _ string, options ...rpc.Option) (*GetNodeSignerReply, error) {
 	res := &GetNodeSignerReply{}
 	err := c.requester.SendRequest(ctx, "getNodeSigner", nil, res, options...)
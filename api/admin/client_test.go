@@ -64,6 +64,15 @@ func (mc *mockClient) SendRequest(_ context.Context, _ string, _ interface{}, re
 	case *GetLoggerLevelReply:
 		response := mc.response.(*GetLoggerLevelReply)
 		*p = *response
+	case *SnapshotDBReply:
+		response := mc.response.(*SnapshotDBReply)
+		*p = *response
+	case *ListBansReply:
+		response := mc.response.(*ListBansReply)
+		*p = *response
+	case *ListAllowedPeersReply:
+		response := mc.response.(*ListAllowedPeersReply)
+		*p = *response
 	case *interface{}:
 		response := mc.response.(*interface{})
 		*p = *response
@@ -385,3 +394,44 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestSnapshotDB(t *testing.T) {
+	t.Run("successful", func(t *testing.T) {
+		expectedReply := &SnapshotDBReply{Keys: 10}
+		mockClient := client{requester: NewMockClient(expectedReply, nil)}
+
+		reply, err := mockClient.SnapshotDB(context.Background(), "path")
+		require.NoError(t, err)
+		require.Equal(t, expectedReply, reply)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mockClient := client{requester: NewMockClient(&SnapshotDBReply{}, errors.New("some error"))}
+
+		_, err := mockClient.SnapshotDB(context.Background(), "path")
+
+		require.EqualError(t, err, "some error")
+	})
+}
+
+func TestListBans(t *testing.T) {
+	nodeID := ids.GenerateTestNodeID()
+	expectedReply := &ListBansReply{Bans: []APIBan{
+		{NodeID: &nodeID, Reason: "spam"},
+		{IP: "1.2.3.4", Expiry: 10},
+	}}
+	mockClient := client{requester: NewMockClient(expectedReply, nil)}
+
+	bans, err := mockClient.ListBans(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, expectedReply.Bans, bans)
+}
+
+func TestListAllowedPeers(t *testing.T) {
+	expectedReply := &ListAllowedPeersReply{NodeIDs: []ids.NodeID{ids.GenerateTestNodeID()}}
+	mockClient := client{requester: NewMockClient(expectedReply, nil)}
+
+	nodeIDs, err := mockClient.ListAllowedPeers(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, expectedReply.NodeIDs, nodeIDs)
+}
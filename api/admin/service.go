@@ -25,7 +25,10 @@ import (
 	"github.com/ava-labs/avalanchego/api"
 	"github.com/ava-labs/avalanchego/api/server"
 	"github.com/ava-labs/avalanchego/chains"
+	"github.com/ava-labs/avalanchego/database/manager"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network"
+	"github.com/ava-labs/avalanchego/network/banlist"
 	"github.com/ava-labs/avalanchego/node"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/utils"
@@ -62,12 +65,16 @@ type Config struct {
 	HTTPServer   server.PathAdderWithReadLock
 	VMRegistry   registry.VMRegistry
 	VMManager    vms.Manager
+	DBManager    manager.Manager
+	Network      network.Network
+	Banlist      banlist.List
 }
 
 // Admin is the API service for node admin management
 type Admin struct {
 	Config
-	profiler profiler.Profiler
+	profiler     profiler.Profiler
+	chainTracker *chainTracker
 }
 
 // NewService returns a new admin API service.
@@ -77,9 +84,14 @@ func NewService(config Config) (*common.HTTPHandler, error) {
 	codec := json.NewCodec()
 	newServer.RegisterCodec(codec, "application/json")
 	newServer.RegisterCodec(codec, "application/json;charset=UTF-8")
+	chainTracker := newChainTracker()
+	if config.ChainManager != nil {
+		config.ChainManager.AddRegistrant(chainTracker)
+	}
 	if err := newServer.RegisterService(&Admin{
-		Config:   config,
-		profiler: profiler.New(config.ProfileDir),
+		Config:       config,
+		profiler:     profiler.New(config.ProfileDir),
+		chainTracker: chainTracker,
 	}, "admin"); err != nil {
 		return nil, err
 	}
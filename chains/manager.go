@@ -37,6 +37,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/syncer"
 	"github.com/ava-labs/avalanchego/snow/networking/handler"
+	"github.com/ava-labs/avalanchego/snow/networking/reputation"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 	"github.com/ava-labs/avalanchego/snow/networking/sender"
 	"github.com/ava-labs/avalanchego/snow/networking/timeout"
@@ -207,6 +208,8 @@ type ManagerConfig struct {
 
 	// Tracks CPU/disk usage caused by each peer.
 	ResourceTracker timetracker.ResourceTracker
+	// Tracks the reputation of each peer.
+	ReputationTracker reputation.Tracker
 
 	StateSyncBeacons []ids.NodeID
 
@@ -693,6 +696,7 @@ func (m *manager) createAvalancheChain(
 		sb.afterBootstrapped(),
 		m.ConsensusGossipFrequency,
 		m.ResourceTracker,
+		m.ReputationTracker,
 		validators.UnhandledSubnetConnector, // avalanche chains don't use subnet connector
 	)
 	if err != nil {
@@ -966,6 +970,7 @@ func (m *manager) createSnowmanChain(
 		sb.afterBootstrapped(),
 		m.ConsensusGossipFrequency,
 		m.ResourceTracker,
+		m.ReputationTracker,
 		subnetConnector,
 	)
 	if err != nil {
@@ -14,6 +14,7 @@
 package config
 
 import (
+	"bytes"
 	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
@@ -33,6 +34,7 @@ import (
 	"github.com/ava-labs/avalanchego/chains"
 	"github.com/ava-labs/avalanchego/genesis"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer"
 	"github.com/ava-labs/avalanchego/ipcs"
 	"github.com/ava-labs/avalanchego/nat"
 	"github.com/ava-labs/avalanchego/network"
@@ -42,11 +44,14 @@ import (
 	"github.com/ava-labs/avalanchego/snow/consensus/avalanche"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
+	"github.com/ava-labs/avalanchego/snow/networking/reputation"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 	"github.com/ava-labs/avalanchego/snow/networking/sender"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
+	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/staking"
 	"github.com/ava-labs/avalanchego/trace"
+	"github.com/ava-labs/avalanchego/utils/compression"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/dynamicip"
@@ -68,10 +73,14 @@ const (
 	chainConfigFileName  = "config"
 	chainUpgradeFileName = "upgrade"
 	subnetConfigFileExt  = ".json"
+
+	minDBEncryptionKeyLen = 32
 )
 
 var (
-	deprecatedKeys = map[string]string{}
+	deprecatedKeys = map[string]string{
+		NetworkCompressionEnabledKey: fmt.Sprintf("use --%s instead", NetworkCompressionTypeKey),
+	}
 
 	errInvalidStakerWeights          = errors.New("staking weights must be positive")
 	errStakingDisableOnPublicNetwork = errors.New("staking disabled on public network")
@@ -88,6 +97,8 @@ var (
 	errStakingKeyContentUnset        = fmt.Errorf("%s key not set but %s set", StakingTLSKeyContentKey, StakingCertContentKey)
 	errStakingCertContentUnset       = fmt.Errorf("%s key set but %s not set", StakingTLSKeyContentKey, StakingCertContentKey)
 	errTracingEndpointEmpty          = fmt.Errorf("%s cannot be empty", TracingEndpointKey)
+	errDBEncryptionKeyTooShort       = fmt.Errorf("database encryption key must be at least %d bytes", minDBEncryptionKeyLen)
+	errDBEncryptionKeyUnset          = errors.New("database encryption key not set but previous encryption key set")
 )
 
 func GetRunnerConfig(v *viper.Viper) (runner.Config, error) {
@@ -255,12 +266,14 @@ func getHTTPConfig(v *viper.Viper) (node.HTTPConfig, error) {
 		}
 	}
 
+	indexerConfig, err := getIndexerConfig(v)
+	if err != nil {
+		return node.HTTPConfig{}, err
+	}
+
 	config := node.HTTPConfig{
 		APIConfig: node.APIConfig{
-			APIIndexerConfig: node.APIIndexerConfig{
-				IndexAPIEnabled:      v.GetBool(IndexEnabledKey),
-				IndexAllowIncomplete: v.GetBool(IndexAllowIncompleteKey),
-			},
+			APIIndexerConfig:   indexerConfig,
 			AdminAPIEnabled:    v.GetBool(AdminAPIEnabledKey),
 			InfoAPIEnabled:     v.GetBool(InfoAPIEnabledKey),
 			KeystoreAPIEnabled: v.GetBool(KeystoreAPIEnabledKey),
@@ -350,6 +363,28 @@ func getNetworkConfig(v *viper.Viper, halflife time.Duration) (network.Config, e
 	upgradeCooldown := v.GetDuration(InboundConnUpgradeThrottlerCooldownKey)
 	upgradeCooldownInSeconds := upgradeCooldown.Seconds()
 	maxRecentConnsUpgraded := int(math.Ceil(maxInboundConnsPerSec * upgradeCooldownInSeconds))
+
+	compressionType, err := compression.TypeFromString(v.GetString(NetworkCompressionTypeKey))
+	if err != nil {
+		return network.Config{}, fmt.Errorf("couldn't parse %s: %w", NetworkCompressionTypeKey, err)
+	}
+	// TODO: remove this after [NetworkCompressionEnabledKey] is removed
+	if !v.GetBool(NetworkCompressionEnabledKey) {
+		compressionType = compression.TypeNone
+	}
+
+	registeredNodeAllowlist := set.Set[ids.NodeID]{}
+	for _, id := range strings.Split(v.GetString(NetworkRegisteredNodeAllowlistKey), ",") {
+		if id == "" {
+			continue
+		}
+		nodeID, err := ids.NodeIDFromString(id)
+		if err != nil {
+			return network.Config{}, fmt.Errorf("couldn't parse %s: %w", NetworkRegisteredNodeAllowlistKey, err)
+		}
+		registeredNodeAllowlist.Add(nodeID)
+	}
+
 	config := network.Config{
 		// Throttling
 		ThrottlerConfig: network.ThrottlerConfig{
@@ -420,15 +455,23 @@ func getNetworkConfig(v *viper.Viper, halflife time.Duration) (network.Config, e
 		},
 
 		MaxClockDifference:           v.GetDuration(NetworkMaxClockDifferenceKey),
-		CompressionEnabled:           v.GetBool(NetworkCompressionEnabledKey),
+		CompressionType:              compressionType,
 		PingFrequency:                v.GetDuration(NetworkPingFrequencyKey),
 		AllowPrivateIPs:              v.GetBool(NetworkAllowPrivateIPsKey),
 		UptimeMetricFreq:             v.GetDuration(UptimeMetricFreqKey),
 		MaximumInboundMessageTimeout: v.GetDuration(NetworkMaximumInboundTimeoutKey),
 
 		RequireValidatorToConnect: v.GetBool(NetworkRequireValidatorToConnectKey),
+		AllowlistEnabled:          v.GetBool(NetworkAllowlistEnabledKey),
 		PeerReadBufferSize:        int(v.GetUint(NetworkPeerReadBufferSizeKey)),
 		PeerWriteBufferSize:       int(v.GetUint(NetworkPeerWriteBufferSizeKey)),
+
+		RequireRegisteredNodeToConnect: v.GetBool(NetworkRequireRegisteredNodeToConnectKey),
+		RegisteredNodeAllowlist:        registeredNodeAllowlist,
+	}
+	if config.RequireRegisteredNodeToConnect {
+		// shared with the P-chain, which keeps it up to date
+		config.RegisteredNodes = validators.NewRegisteredNodes()
 	}
 
 	switch {
@@ -470,12 +513,31 @@ func getBenchlistConfig(v *viper.Viper, alpha, k int) (benchlist.Config, error)
 		Duration:               v.GetDuration(BenchlistDurationKey),
 		MinimumFailingDuration: v.GetDuration(BenchlistMinFailingDurationKey),
 		MaxPortion:             (1.0 - (float64(alpha) / float64(k))) / 3.0,
+		MinReputationScore:     v.GetFloat64(BenchlistMinReputationScoreKey),
 	}
 	switch {
 	case config.Duration < 0:
 		return benchlist.Config{}, fmt.Errorf("%q must be >= 0", BenchlistDurationKey)
 	case config.MinimumFailingDuration < 0:
 		return benchlist.Config{}, fmt.Errorf("%q must be >= 0", BenchlistMinFailingDurationKey)
+	case config.MinReputationScore < 0 || config.MinReputationScore > reputation.MaxScore:
+		return benchlist.Config{}, fmt.Errorf("%q must be in [0, %d]", BenchlistMinReputationScoreKey, reputation.MaxScore)
+	}
+	return config, nil
+}
+
+func getReputationConfig(v *viper.Viper) (reputation.Config, error) {
+	config := reputation.Config{
+		Halflife:             v.GetDuration(ReputationHalflifeKey),
+		MaxLatency:           v.GetDuration(ReputationMaxLatencyKey),
+		MaxBandwidth:         v.GetFloat64(ReputationMaxBandwidthKey),
+		LatencyWeight:        v.GetFloat64(ReputationLatencyWeightKey),
+		InvalidMessageWeight: v.GetFloat64(ReputationInvalidMessageWeightKey),
+		BandwidthWeight:      v.GetFloat64(ReputationBandwidthWeightKey),
+		UptimeWeight:         v.GetFloat64(ReputationUptimeWeightKey),
+	}
+	if err := config.Verify(); err != nil {
+		return reputation.Config{}, fmt.Errorf("invalid reputation config: %w", err)
 	}
 	return config, nil
 }
@@ -750,19 +812,25 @@ func getStakingSigner(v *viper.Viper) (*bls.SecretKey, error) {
 
 	signingKeyPath := GetExpandedArg(v, StakingSignerKeyPathKey)
 	_, err := os.Stat(signingKeyPath)
-	if !errors.Is(err, fs.ErrNotExist) {
+	switch {
+	case !errors.Is(err, fs.ErrNotExist):
 		signingKeyBytes, err := os.ReadFile(signingKeyPath)
 		if err != nil {
 			return nil, err
 		}
-		key, err := bls.SecretKeyFromBytes(signingKeyBytes)
-		if err != nil {
-			return nil, fmt.Errorf("couldn't parse signing key: %w", err)
+		// Empty signing keys were written, while BLS keys were dummies. They
+		// are replaced with a new key.
+		if len(signingKeyBytes) != 0 {
+			key, err := bls.SecretKeyFromBytes(signingKeyBytes)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't parse signing key: %w", err)
+			}
+			return key, nil
 		}
-		return key, nil
-	}
-
-	if v.IsSet(StakingSignerKeyPathKey) {
+		if err := os.Remove(signingKeyPath); err != nil {
+			return nil, fmt.Errorf("couldn't remove empty signing key at %s: %w", signingKeyPath, err)
+		}
+	case v.IsSet(StakingSignerKeyPathKey):
 		return nil, errors.New("missing staking signing key file")
 	}
 
@@ -822,7 +890,6 @@ func getStakingConfig(v *viper.Viper, networkID uint32) (node.StakingConfig, err
 		config.RewardConfig.MintingPeriod = v.GetDuration(StakeMintingPeriodKey)
 		config.RewardConfig.SupplyCap = v.GetUint64(StakeSupplyCapKey)
 		config.MinDelegationFee = v.GetUint32(MinDelegatorFeeKey)
-		config.CaminoConfig = getCaminoPlatformConfig(v)
 		switch {
 		case config.UptimeRequirement < 0 || config.UptimeRequirement > 1:
 			return node.StakingConfig{}, errInvalidUptimeRequirement
@@ -919,16 +986,60 @@ func getDatabaseConfig(v *viper.Viper, networkID uint32) (node.DatabaseConfig, e
 		}
 	}
 
+	encryptionKey, err := getDatabaseEncryptionKey(v, DBEncryptionKeyFileKey, DBEncryptionKeyEnvKey)
+	if err != nil {
+		return node.DatabaseConfig{}, err
+	}
+	previousEncryptionKey, err := getDatabaseEncryptionKey(v, DBEncryptionPreviousKeyFileKey, DBEncryptionPreviousKeyEnvKey)
+	if err != nil {
+		return node.DatabaseConfig{}, err
+	}
+	if encryptionKey == nil && previousEncryptionKey != nil {
+		return node.DatabaseConfig{}, errDBEncryptionKeyUnset
+	}
+
 	return node.DatabaseConfig{
 		Name: v.GetString(DBTypeKey),
 		Path: filepath.Join(
 			GetExpandedArg(v, DBPathKey),
 			constants.NetworkName(networkID),
 		),
-		Config: configBytes,
+		Config:         configBytes,
+		RestoreArchive: GetExpandedArg(v, DBRestoreArchiveKey),
+
+		EncryptionKey:         encryptionKey,
+		PreviousEncryptionKey: previousEncryptionKey,
 	}, nil
 }
 
+// getDatabaseEncryptionKey returns the key read from the environment variable
+// named by [envKey] or, if it's not set, from the file at [fileKey]. Returns
+// nil if neither is set.
+func getDatabaseEncryptionKey(v *viper.Viper, fileKey, envKey string) ([]byte, error) {
+	var key []byte
+	switch {
+	case v.IsSet(envKey):
+		envName := v.GetString(envKey)
+		envValue, ok := os.LookupEnv(envName)
+		if !ok {
+			return nil, fmt.Errorf("environment variable %q, specified by %s, is not set", envName, envKey)
+		}
+		key = []byte(strings.TrimSpace(envValue))
+	case v.IsSet(fileKey):
+		keyBytes, err := os.ReadFile(GetExpandedArg(v, fileKey))
+		if err != nil {
+			return nil, fmt.Errorf("couldn't read database encryption key: %w", err)
+		}
+		key = bytes.TrimSpace(keyBytes)
+	default:
+		return nil, nil
+	}
+	if len(key) < minDBEncryptionKeyLen {
+		return nil, errDBEncryptionKeyTooShort
+	}
+	return key, nil
+}
+
 func getAliases(v *viper.Viper, name string, contentKey string, fileKey string) (map[ids.ID][]string, error) {
 	var fileBytes []byte
 	if v.IsSet(contentKey) {
@@ -965,6 +1076,45 @@ func getAliases(v *viper.Viper, name string, contentKey string, fileKey string)
 	return aliasMap, nil
 }
 
+func getIndexerConfig(v *viper.Viper) (node.APIIndexerConfig, error) {
+	config := node.APIIndexerConfig{
+		IndexAPIEnabled:      v.GetBool(IndexEnabledKey),
+		IndexAllowIncomplete: v.GetBool(IndexAllowIncompleteKey),
+	}
+	if v.IsSet(IndexChainsKey) {
+		config.IndexChains = strings.Split(v.GetString(IndexChainsKey), ",")
+	}
+	if v.IsSet(IndexExcludedChainsKey) {
+		config.IndexExcludedChains = strings.Split(v.GetString(IndexExcludedChainsKey), ",")
+	}
+
+	var configBytes []byte
+	switch {
+	case v.IsSet(IndexChainConfigsContentKey):
+		var err error
+		configBytes, err = base64.StdEncoding.DecodeString(v.GetString(IndexChainConfigsContentKey))
+		if err != nil {
+			return node.APIIndexerConfig{}, fmt.Errorf("unable to decode base64 content for index chain configs: %w", err)
+		}
+	case v.IsSet(IndexChainConfigsFileKey):
+		var err error
+		configBytes, err = os.ReadFile(filepath.Clean(GetExpandedArg(v, IndexChainConfigsFileKey)))
+		if err != nil {
+			return node.APIIndexerConfig{}, err
+		}
+	}
+	if len(configBytes) != 0 {
+		if err := json.Unmarshal(configBytes, &config.IndexChainConfigs); err != nil {
+			return node.APIIndexerConfig{}, fmt.Errorf("problem unmarshaling index chain configs: %w", err)
+		}
+	}
+
+	if err := indexer.VerifyChainsConfig(config.IndexChains, config.IndexExcludedChains, config.IndexChainConfigs); err != nil {
+		return node.APIIndexerConfig{}, err
+	}
+	return config, nil
+}
+
 func getVMAliases(v *viper.Viper) (map[ids.ID][]string, error) {
 	return getAliases(v, "vm aliases", VMAliasesContentKey, VMAliasesFileKey)
 }
@@ -1365,6 +1515,12 @@ func GetNodeConfig(v *viper.Viper, buildDir string) (node.Config, error) {
 		return node.Config{}, err
 	}
 
+	// Reputation
+	nodeConfig.ReputationConfig, err = getReputationConfig(v)
+	if err != nil {
+		return node.Config{}, err
+	}
+
 	// File Descriptor Limit
 	nodeConfig.FdLimit = v.GetUint64(FdLimitKey)
 
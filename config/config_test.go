@@ -19,6 +19,8 @@ import (
 
 	"github.com/ava-labs/avalanchego/chains"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 )
 
 func TestGetChainConfigsFromFiles(t *testing.T) {
@@ -392,6 +394,27 @@ func TestGetVMAliasesDirNotExists(t *testing.T) {
 	require.NoError(err)
 }
 
+func TestGetStakingSignerReplacesEmptyKey(t *testing.T) {
+	require := require.New(t)
+	root := t.TempDir()
+	keyPath := filepath.Join(root, "signer.key")
+	require.NoError(os.WriteFile(keyPath, nil, 0o400))
+	configJSON := fmt.Sprintf(`{%q: %q}`, StakingSignerKeyPathKey, keyPath)
+	configFilePath := setupConfigJSON(t, root, configJSON)
+	v := setupViper(configFilePath)
+
+	key, err := getStakingSigner(v)
+	require.NoError(err)
+	keyBytes, err := os.ReadFile(keyPath)
+	require.NoError(err)
+	require.Equal(bls.SecretKeyToBytes(key), keyBytes)
+
+	// replaced key is loaded again
+	loadedKey, err := getStakingSigner(v)
+	require.NoError(err)
+	require.Equal(keyBytes, bls.SecretKeyToBytes(loadedKey))
+}
+
 func TestGetSubnetConfigsFromFile(t *testing.T) {
 	tests := map[string]struct {
 		givenJSON  string
@@ -586,6 +609,66 @@ func TestCalcMinConnectedStake(t *testing.T) {
 	require.Equal(t, defaultExpectedMinStake, minStake)
 }
 
+func TestGetDatabaseEncryptionKeys(t *testing.T) {
+	key := "0123456789abcdef0123456789abcdef"
+	previousKey := "fedcba9876543210fedcba9876543210"
+
+	tests := map[string]struct {
+		setup               func(t *testing.T, v *viper.Viper)
+		expectedKey         []byte
+		expectedPreviousKey []byte
+		expectedErr         error
+	}{
+		"no encryption": {
+			setup: func(*testing.T, *viper.Viper) {},
+		},
+		"key from file": {
+			setup: func(t *testing.T, v *viper.Viper) {
+				dir := t.TempDir()
+				setupFile(t, dir, "key", key+"\n")
+				v.Set(DBEncryptionKeyFileKey, filepath.Join(dir, "key"))
+			},
+			expectedKey: []byte(key),
+		},
+		"keys from env": {
+			setup: func(t *testing.T, v *viper.Viper) {
+				t.Setenv("TEST_DB_KEY", key)
+				t.Setenv("TEST_DB_PREVIOUS_KEY", previousKey)
+				v.Set(DBEncryptionKeyEnvKey, "TEST_DB_KEY")
+				v.Set(DBEncryptionPreviousKeyEnvKey, "TEST_DB_PREVIOUS_KEY")
+			},
+			expectedKey:         []byte(key),
+			expectedPreviousKey: []byte(previousKey),
+		},
+		"key too short": {
+			setup: func(t *testing.T, v *viper.Viper) {
+				t.Setenv("TEST_DB_KEY", "short")
+				v.Set(DBEncryptionKeyEnvKey, "TEST_DB_KEY")
+			},
+			expectedErr: errDBEncryptionKeyTooShort,
+		},
+		"previous key without key": {
+			setup: func(t *testing.T, v *viper.Viper) {
+				t.Setenv("TEST_DB_PREVIOUS_KEY", previousKey)
+				v.Set(DBEncryptionPreviousKeyEnvKey, "TEST_DB_PREVIOUS_KEY")
+			},
+			expectedErr: errDBEncryptionKeyUnset,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			v := setupViperFlags()
+			test.setup(t, v)
+
+			dbConfig, err := getDatabaseConfig(v, constants.MainnetID)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedKey, dbConfig.EncryptionKey)
+			require.Equal(test.expectedPreviousKey, dbConfig.PreviousEncryptionKey)
+		})
+	}
+}
+
 // setups config json file and writes content
 func setupConfigJSON(t *testing.T, rootPath string, value string) string {
 	configFilePath := filepath.Join(rootPath, "config.json")
@@ -28,8 +28,10 @@ import (
 
 	"github.com/ava-labs/avalanchego/database/leveldb"
 	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/database/pebbledb"
 	"github.com/ava-labs/avalanchego/genesis"
 	"github.com/ava-labs/avalanchego/trace"
+	"github.com/ava-labs/avalanchego/utils/compression"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/ulimit"
 	"github.com/ava-labs/avalanchego/utils/units"
@@ -125,10 +127,15 @@ func addNodeFlags(fs *flag.FlagSet) {
 	fs.Uint64(AddSubnetDelegatorFeeKey, genesis.LocalParams.AddSubnetDelegatorFee, "Transaction fee, in nAVAX, for transactions that add new subnet delegators")
 
 	// Database
-	fs.String(DBTypeKey, leveldb.Name, fmt.Sprintf("Database type to use. Should be one of {%s, %s}", leveldb.Name, memdb.Name))
+	fs.String(DBTypeKey, leveldb.Name, fmt.Sprintf("Database type to use. Should be one of {%s, %s, %s}", leveldb.Name, pebbledb.Name, memdb.Name))
 	fs.String(DBPathKey, defaultDBDir, "Path to database directory")
 	fs.String(DBConfigFileKey, "", fmt.Sprintf("Path to database config file. Ignored if %s is specified", DBConfigContentKey))
 	fs.String(DBConfigContentKey, "", "Specifies base64 encoded database config content")
+	fs.String(DBRestoreArchiveKey, "", "Path to a database snapshot archive, created by admin.snapshotDB. If specified, the archive is restored into the empty database directory before the node starts")
+	fs.String(DBEncryptionKeyFileKey, "", fmt.Sprintf("Path to the file with the key, that the database is encrypted at rest with. Ignored if %s is specified", DBEncryptionKeyEnvKey))
+	fs.String(DBEncryptionKeyEnvKey, "", "Name of the environment variable with the key, that the database is encrypted at rest with")
+	fs.String(DBEncryptionPreviousKeyFileKey, "", fmt.Sprintf("Path to the file with the key, that the database was previously encrypted with. If specified, the database is re-encrypted with the new key on startup. Ignored if %s is specified", DBEncryptionPreviousKeyEnvKey))
+	fs.String(DBEncryptionPreviousKeyEnvKey, "", "Name of the environment variable with the key, that the database was previously encrypted with. If specified, the database is re-encrypted with the new key on startup")
 
 	// Logging
 	fs.String(LogsDirKey, defaultLogDir, "Logging directory for Avalanche")
@@ -179,9 +186,13 @@ func addNodeFlags(fs *flag.FlagSet) {
 	fs.Duration(NetworkPingFrequencyKey, constants.DefaultPingFrequency, "Frequency of pinging other peers")
 
 	fs.Bool(NetworkCompressionEnabledKey, true, "If true, compress certain outbound messages. This node will be able to parse compressed inbound messages regardless of this flag's value")
+	fs.String(NetworkCompressionTypeKey, compression.TypeGzip.String(), fmt.Sprintf("Compression type for outbound messages. Must be one of [%s, %s, %s]. Peers, which don't support zstd, are sent gzip compressed messages. This node will be able to parse compressed inbound messages regardless of this flag's value", compression.TypeGzip, compression.TypeZstd, compression.TypeNone))
 	fs.Duration(NetworkMaxClockDifferenceKey, time.Minute, "Max allowed clock difference value between this node and peers")
 	fs.Bool(NetworkAllowPrivateIPsKey, true, "Allows the node to initiate outbound connection attempts to peers with private IPs")
 	fs.Bool(NetworkRequireValidatorToConnectKey, false, "If true, this node will only maintain a connection with another node if this node is a validator, the other node is a validator, or the other node is a beacon")
+	fs.Bool(NetworkAllowlistEnabledKey, false, "If true, this node will only maintain a connection with another node if the other node is a beacon or was added to the allowlist via the admin API")
+	fs.Bool(NetworkRequireRegisteredNodeToConnectKey, false, "If true, this node will only maintain a connection with another node if the other node is registered to a consortium member on the P-chain, is a beacon or is allowlisted")
+	fs.String(NetworkRegisteredNodeAllowlistKey, "", fmt.Sprintf("Comma separated list of node IDs, which this node will maintain a connection with, even if they aren't registered to a consortium member. Only used if %s is set", NetworkRequireRegisteredNodeToConnectKey))
 	fs.Uint(NetworkPeerReadBufferSizeKey, 8*units.KiB, "Size, in bytes, of the buffer that we read peer messages into (there is one buffer per peer)")
 	fs.Uint(NetworkPeerWriteBufferSizeKey, 8*units.KiB, "Size, in bytes, of the buffer that we write peer messages into (there is one buffer per peer)")
 
@@ -191,6 +202,16 @@ func addNodeFlags(fs *flag.FlagSet) {
 	fs.Int(BenchlistFailThresholdKey, 10, "Number of consecutive failed queries before benchlisting a node")
 	fs.Duration(BenchlistDurationKey, 15*time.Minute, "Max amount of time a peer is benchlisted after surpassing the threshold")
 	fs.Duration(BenchlistMinFailingDurationKey, 2*time.Minute+30*time.Second, "Minimum amount of time messages to a peer must be failing before the peer is benched")
+	fs.Float64(BenchlistMinReputationScoreKey, 0.25, "Reputation score, below which a peer is benched regardless of its consecutive failed queries. Must be in [0, 1], 0 disables benching by reputation")
+
+	// Reputation
+	fs.Duration(ReputationHalflifeKey, 5*time.Minute, "Halflife of the impact of observed peer behavior on the peer's reputation score")
+	fs.Duration(ReputationMaxLatencyKey, 10*time.Second, "Average response latency, at which a peer's latency score is 0. Timed out requests are observed as this latency")
+	fs.Float64(ReputationMaxBandwidthKey, float64(2*units.MiB), "Average inbound bandwidth, in bytes per second, at which a peer's bandwidth score is 0")
+	fs.Float64(ReputationLatencyWeightKey, 1, "Weight of the response latency in a peer's reputation score")
+	fs.Float64(ReputationInvalidMessageWeightKey, 1, "Weight of the number of invalid messages in a peer's reputation score")
+	fs.Float64(ReputationBandwidthWeightKey, 1, "Weight of the inbound bandwidth usage in a peer's reputation score")
+	fs.Float64(ReputationUptimeWeightKey, 1, "Weight of the peer's uptime, as observed by this node, in a peer's reputation score")
 
 	// Router
 	fs.Duration(ConsensusGossipFrequencyKey, 10*time.Second, "Frequency of gossiping accepted frontiers")
@@ -335,6 +356,10 @@ func addNodeFlags(fs *flag.FlagSet) {
 	// Indexer
 	fs.Bool(IndexEnabledKey, false, "If true, index all accepted containers and transactions and expose them via an API")
 	fs.Bool(IndexAllowIncompleteKey, false, "If true, allow running the node in such a way that could cause an index to miss transactions. Ignored if index is disabled")
+	fs.String(IndexChainsKey, "", "Comma separated list of chain IDs or aliases to index, including subnet chains. If empty, all primary network chains are indexed")
+	fs.String(IndexExcludedChainsKey, "", "Comma separated list of chain IDs or aliases, that aren't indexed")
+	fs.String(IndexChainConfigsFileKey, "", fmt.Sprintf("Specifies a JSON file that maps chain IDs or aliases to their index configs. Ignored if %s is specified", IndexChainConfigsContentKey))
+	fs.String(IndexChainConfigsContentKey, "", "Specifies base64 encoded map from chain IDs or aliases to their index configs")
 
 	// Config Directories
 	fs.String(ChainConfigDirKey, defaultChainConfigDir, fmt.Sprintf("Chain specific configurations parent directory. Ignored if %s is specified", ChainConfigContentKey))
@@ -395,7 +420,6 @@ func BuildFlagSet() *flag.FlagSet {
 	fs := flag.NewFlagSet(constants.AppName, flag.ContinueOnError)
 	addProcessFlags(fs)
 	addNodeFlags(fs)
-	addCaminoFlags(fs)
 	return fs
 }
 
@@ -37,6 +37,11 @@ const (
 	DBPathKey                                          = "db-dir"
 	DBConfigFileKey                                    = "db-config-file"
 	DBConfigContentKey                                 = "db-config-file-content"
+	DBRestoreArchiveKey                                = "db-restore-archive"
+	DBEncryptionKeyFileKey                             = "db-encryption-key-file"
+	DBEncryptionKeyEnvKey                              = "db-encryption-key-env"
+	DBEncryptionPreviousKeyFileKey                     = "db-encryption-previous-key-file"
+	DBEncryptionPreviousKeyEnvKey                      = "db-encryption-previous-key-env"
 	PublicIPKey                                        = "public-ip"
 	PublicIPResolutionFreqKey                          = "public-ip-resolution-frequency"
 	PublicIPResolutionServiceKey                       = "public-ip-resolution-service"
@@ -95,15 +100,27 @@ const (
 	NetworkPingFrequencyKey                            = "network-ping-frequency"
 	NetworkMaxReconnectDelayKey                        = "network-max-reconnect-delay"
 	NetworkCompressionEnabledKey                       = "network-compression-enabled"
+	NetworkCompressionTypeKey                          = "network-compression-type"
 	NetworkMaxClockDifferenceKey                       = "network-max-clock-difference"
 	NetworkAllowPrivateIPsKey                          = "network-allow-private-ips"
 	NetworkRequireValidatorToConnectKey                = "network-require-validator-to-connect"
+	NetworkAllowlistEnabledKey                         = "network-allowlist-enabled"
+	NetworkRequireRegisteredNodeToConnectKey           = "network-require-registered-node-to-connect"
+	NetworkRegisteredNodeAllowlistKey                  = "network-registered-node-allowlist"
 	NetworkPeerReadBufferSizeKey                       = "network-peer-read-buffer-size"
 	NetworkPeerWriteBufferSizeKey                      = "network-peer-write-buffer-size"
 	NetworkTLSKeyLogFileKey                            = "network-tls-key-log-file-unsafe"
 	BenchlistFailThresholdKey                          = "benchlist-fail-threshold"
 	BenchlistDurationKey                               = "benchlist-duration"
 	BenchlistMinFailingDurationKey                     = "benchlist-min-failing-duration"
+	BenchlistMinReputationScoreKey                     = "benchlist-min-reputation-score"
+	ReputationHalflifeKey                              = "reputation-halflife"
+	ReputationMaxLatencyKey                            = "reputation-max-latency"
+	ReputationMaxBandwidthKey                          = "reputation-max-bandwidth"
+	ReputationLatencyWeightKey                         = "reputation-latency-weight"
+	ReputationInvalidMessageWeightKey                  = "reputation-invalid-message-weight"
+	ReputationBandwidthWeightKey                       = "reputation-bandwidth-weight"
+	ReputationUptimeWeightKey                          = "reputation-uptime-weight"
 	BuildDirKey                                        = "build-dir"
 	LogsDirKey                                         = "log-dir"
 	LogLevelKey                                        = "log-level"
@@ -151,6 +168,10 @@ const (
 	FdLimitKey                                         = "fd-limit"
 	IndexEnabledKey                                    = "index-enabled"
 	IndexAllowIncompleteKey                            = "index-allow-incomplete"
+	IndexChainsKey                                     = "index-chains"
+	IndexExcludedChainsKey                             = "index-excluded-chains"
+	IndexChainConfigsFileKey                           = "index-chain-configs-file"
+	IndexChainConfigsContentKey                        = "index-chain-configs-file-content"
 	RouterHealthMaxDropRateKey                         = "router-health-max-drop-rate"
 	RouterHealthMaxOutstandingRequestsKey              = "router-health-max-outstanding-requests"
 	HealthCheckFreqKey                                 = "health-check-frequency"
@@ -0,0 +1,515 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package encdb
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20"
+	"golang.org/x/crypto/chacha20poly1305"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/nodb"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+const (
+	// migrateBatchSize is the size of the batch after which migrated entries
+	// are written into the database
+	migrateBatchSize = 4 * units.MiB
+
+	// Parameters of argon2id, that derives the encryption keys from the
+	// password, as recommended by RFC 9106
+	kdfTime    = 1
+	kdfMemory  = 64 * 1024 // KiB
+	kdfThreads = 4
+	saltLen    = 16
+)
+
+var (
+	_ database.Database = (*atRestDatabase)(nil)
+	_ database.Batch    = (*atRestBatch)(nil)
+	_ database.Iterator = (*atRestIterator)(nil)
+
+	// markerKey stores the KDF salt and the encryption status of the
+	// database. Its length is odd, so it never collides with encoded keys.
+	markerKey         = []byte("encdb")
+	statusMigrating   = []byte{0}
+	statusEncrypted   = []byte{1}
+	keyEncodingDomain = []byte("encdb key encoding")
+
+	errWrongKey      = errors.New("database isn't encrypted with the given key")
+	errInvalidKey    = errors.New("invalid encoded key")
+	errInvalidStatus = errors.New("invalid encryption status")
+)
+
+// atRestDatabase encrypts values with [Database] and encodes keys with
+// [keyEncoding]. Values are authenticated together with their encoded keys.
+// It owns the underlying database and closes it on Close.
+type atRestDatabase struct {
+	*Database
+	keys *keyEncoding
+	salt []byte
+}
+
+// NewAtRest returns a new encrypted database, that takes ownership over [db]
+// and encrypts all of its values with the key derived from [password] by
+// argon2id. Keys are encoded with the keyed order-preserving encoding derived
+// from [password], so the iteration order and prefixes of [db] are preserved.
+// See keyEncoding for the key metadata, that isn't hidden by the encoding.
+//
+// If [db] has never been encrypted, all of its entries are encrypted before
+// the database is returned. If [previousPassword] is not nil, all entries of
+// [db], that are encrypted with [previousPassword], are re-encrypted with
+// [password]. Both migrations can be safely restarted, if they were
+// interrupted.
+func NewAtRest(password, previousPassword []byte, db database.Database) (database.Database, error) {
+	salt, err := getSalt(db)
+	if err != nil {
+		return nil, err
+	}
+	encDB, err := newAtRestDatabase(password, salt, db)
+	if err != nil {
+		return nil, err
+	}
+
+	var previousEncDB *atRestDatabase
+	if previousPassword != nil {
+		previousEncDB, err = newAtRestDatabase(previousPassword, salt, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := migrate(db, previousEncDB, encDB); err != nil {
+		return nil, err
+	}
+	return encDB, nil
+}
+
+func newAtRestDatabase(password, salt []byte, db database.Database) (*atRestDatabase, error) {
+	keys := argon2.IDKey(password, salt, kdfTime, kdfMemory, kdfThreads, 2*chacha20poly1305.KeySize)
+	encDB, err := newDatabase(keys[:chacha20poly1305.KeySize], true, db)
+	if err != nil {
+		return nil, err
+	}
+	return &atRestDatabase{
+		Database: encDB,
+		keys:     newKeyEncoding(keys[chacha20poly1305.KeySize:]),
+		salt:     salt,
+	}, nil
+}
+
+// getSalt returns the KDF salt stored in [db] or a new random salt, if [db]
+// has never been encrypted. Salt is shared by all keys, that [db] is encrypted
+// with.
+func getSalt(db database.KeyValueReader) ([]byte, error) {
+	markerValue, err := db.Get(markerKey)
+	switch {
+	case err == database.ErrNotFound:
+		salt := make([]byte, saltLen)
+		_, err := rand.Read(salt)
+		return salt, err
+	case err != nil:
+		return nil, err
+	case len(markerValue) < saltLen:
+		return nil, fmt.Errorf("%w: length %d", errInvalidStatus, len(markerValue))
+	}
+	return markerValue[:saltLen], nil
+}
+
+// marker returns the value of [markerKey] with [status] of the encryption
+// with this database: the KDF salt followed by the encrypted status.
+func (db *atRestDatabase) marker(status []byte) ([]byte, error) {
+	encStatus, err := db.encrypt(markerKey, status)
+	if err != nil {
+		return nil, err
+	}
+	markerValue := make([]byte, 0, len(db.salt)+len(encStatus))
+	markerValue = append(markerValue, db.salt...)
+	return append(markerValue, encStatus...), nil
+}
+
+// status returns the encryption status stored in [markerValue]. It fails if
+// the status isn't encrypted with this database.
+func (db *atRestDatabase) status(markerValue []byte) ([]byte, error) {
+	if len(markerValue) < saltLen {
+		return nil, fmt.Errorf("%w: length %d", errInvalidStatus, len(markerValue))
+	}
+	return db.decrypt(markerKey, markerValue[saltLen:])
+}
+
+func (db *atRestDatabase) Has(key []byte) (bool, error) {
+	return db.Database.Has(db.keys.encode(key))
+}
+
+func (db *atRestDatabase) Get(key []byte) ([]byte, error) {
+	return db.Database.Get(db.keys.encode(key))
+}
+
+func (db *atRestDatabase) Put(key, value []byte) error {
+	return db.Database.Put(db.keys.encode(key), value)
+}
+
+func (db *atRestDatabase) Delete(key []byte) error {
+	return db.Database.Delete(db.keys.encode(key))
+}
+
+func (db *atRestDatabase) NewBatch() database.Batch {
+	return &atRestBatch{
+		Batch: db.Database.NewBatch(),
+		keys:  db.keys,
+	}
+}
+
+func (db *atRestDatabase) NewIterator() database.Iterator {
+	return db.NewIteratorWithStartAndPrefix(nil, nil)
+}
+
+func (db *atRestDatabase) NewIteratorWithStart(start []byte) database.Iterator {
+	return db.NewIteratorWithStartAndPrefix(start, nil)
+}
+
+func (db *atRestDatabase) NewIteratorWithPrefix(prefix []byte) database.Iterator {
+	return db.NewIteratorWithStartAndPrefix(nil, prefix)
+}
+
+func (db *atRestDatabase) NewIteratorWithStartAndPrefix(start, prefix []byte) database.Iterator {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return &nodb.Iterator{Err: database.ErrClosed}
+	}
+	// Values are decrypted by atRestIterator, because the value of
+	// [markerKey] isn't encrypted in the same way as other values.
+	return &atRestIterator{
+		Iterator: db.db.NewIteratorWithStartAndPrefix(
+			db.keys.encode(start),
+			db.keys.encode(prefix),
+		),
+		db: db,
+	}
+}
+
+func (db *atRestDatabase) Compact(start, limit []byte) error {
+	return db.Database.Compact(db.keys.encode(start), db.keys.encode(limit))
+}
+
+func (db *atRestDatabase) Close() error {
+	errs := wrappers.Errs{}
+	errs.Add(
+		db.Database.Close(),
+		db.Database.db.Close(),
+	)
+	return errs.Err
+}
+
+type atRestBatch struct {
+	database.Batch
+	keys *keyEncoding
+}
+
+func (b *atRestBatch) Put(key, value []byte) error {
+	return b.Batch.Put(b.keys.encode(key), value)
+}
+
+func (b *atRestBatch) Delete(key []byte) error {
+	return b.Batch.Delete(b.keys.encode(key))
+}
+
+func (b *atRestBatch) Replay(w database.KeyValueWriterDeleter) error {
+	return b.Batch.Replay(&keyDecodingWriter{
+		KeyValueWriterDeleter: w,
+		keys:                  b.keys,
+	})
+}
+
+// keyDecodingWriter decodes keys before passing them to the wrapped writer.
+type keyDecodingWriter struct {
+	database.KeyValueWriterDeleter
+	keys *keyEncoding
+}
+
+func (w *keyDecodingWriter) Put(encKey, value []byte) error {
+	key, err := w.keys.decode(encKey)
+	if err != nil {
+		return err
+	}
+	return w.KeyValueWriterDeleter.Put(key, value)
+}
+
+func (w *keyDecodingWriter) Delete(encKey []byte) error {
+	key, err := w.keys.decode(encKey)
+	if err != nil {
+		return err
+	}
+	return w.KeyValueWriterDeleter.Delete(key)
+}
+
+type atRestIterator struct {
+	database.Iterator
+	db *atRestDatabase
+
+	key, value []byte
+	err        error
+}
+
+func (it *atRestIterator) Next() bool {
+	// Short-circuit and set an error if the database has been closed.
+	if it.db.isClosed() {
+		it.key = nil
+		it.value = nil
+		it.err = database.ErrClosed
+		return false
+	}
+
+	for it.Iterator.Next() {
+		encKey := it.Iterator.Key()
+		if bytes.Equal(encKey, markerKey) {
+			continue
+		}
+		key, value, err := decryptEntry(it.db, encKey, it.Iterator.Value())
+		if err != nil {
+			it.key = nil
+			it.value = nil
+			it.err = err
+			return false
+		}
+		it.key = key
+		it.value = value
+		return true
+	}
+	it.key = nil
+	it.value = nil
+	return false
+}
+
+func (it *atRestIterator) Error() error {
+	if it.err != nil {
+		return it.err
+	}
+	return it.Iterator.Error()
+}
+
+func (it *atRestIterator) Key() []byte {
+	return it.key
+}
+
+func (it *atRestIterator) Value() []byte {
+	return it.value
+}
+
+// keyEncoding is a keyed order-preserving encoding of keys. Every byte of a
+// key is mapped to a uint16 value by a strictly increasing table. The table
+// is derived from the encoding key and all preceding bytes of the key, so the
+// same byte is mapped to unrelated values after different prefixes.
+//
+// Encoded keys keep the lexicographic order and prefixes of plaintext keys,
+// so they can be iterated over. Therefore the encoding doesn't hide:
+//   - the length of every key and whether two keys are equal
+//   - the length of the common prefix of any two keys
+//   - the order of keys, so the order of the first different bytes of keys
+//     with a common prefix. If many keys share a prefix, these orders reveal
+//     the approximate values of their next bytes.
+type keyEncoding struct {
+	// state of the empty prefix
+	initialState []byte
+}
+
+func newKeyEncoding(key []byte) *keyEncoding {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write(keyEncodingDomain)
+	return &keyEncoding{initialState: mac.Sum(nil)}
+}
+
+// nextState returns the state of the prefix with the state [state], that is
+// extended by [b]
+func nextState(state []byte, b byte) []byte {
+	mac := hmac.New(sha256.New, state)
+	_, _ = mac.Write([]byte{b})
+	return mac.Sum(nil)
+}
+
+// byteTable returns the table of the byte, that follows the prefix with the
+// state [state]
+func byteTable(state []byte) *[256]uint16 {
+	// 256 gaps, each of them a byte, are taken from the keyed stream, so the
+	// last value of the table is at most 255*256+255, which fits into uint16
+	gaps := make([]byte, 256)
+	// state and nonce have valid lengths, so this never fails
+	stream, _ := chacha20.NewUnauthenticatedCipher(state, make([]byte, chacha20.NonceSize))
+	stream.XORKeyStream(gaps, gaps)
+
+	table := &[256]uint16{}
+	next := uint16(0)
+	for i := range table {
+		table[i] = next + uint16(gaps[i])
+		next = table[i] + 1
+	}
+	return table
+}
+
+func (e *keyEncoding) encode(key []byte) []byte {
+	if key == nil {
+		return nil
+	}
+	encKey := make([]byte, 2*len(key))
+	state := e.initialState
+	for i, b := range key {
+		binary.BigEndian.PutUint16(encKey[2*i:], byteTable(state)[b])
+		state = nextState(state, b)
+	}
+	return encKey
+}
+
+func (e *keyEncoding) decode(encKey []byte) ([]byte, error) {
+	if len(encKey)%2 != 0 {
+		return nil, fmt.Errorf("%w: odd length %d", errInvalidKey, len(encKey))
+	}
+	key := make([]byte, len(encKey)/2)
+	state := e.initialState
+	for i := range key {
+		v := binary.BigEndian.Uint16(encKey[2*i:])
+		table := byteTable(state)
+		b := sort.Search(len(table), func(j int) bool {
+			return table[j] >= v
+		})
+		if b == len(table) || table[b] != v {
+			return nil, fmt.Errorf("%w: unknown value %d", errInvalidKey, v)
+		}
+		key[i] = byte(b)
+		state = nextState(state, key[i])
+	}
+	return key, nil
+}
+
+// migrate encrypts all entries of [db] with [encDB], according to the
+// encryption status stored in [db]. Entries, that are encrypted with
+// [previousEncDB], are re-encrypted. [previousEncDB] may be nil.
+func migrate(db database.Database, previousEncDB, encDB *atRestDatabase) error {
+	markerValue, err := db.Get(markerKey)
+	switch {
+	case err == database.ErrNotFound:
+		// [db] has never been encrypted, so all of its entries are plaintext.
+		// Status is stored first, so this migration could be resumed with the
+		// same key.
+		markerValue, err := encDB.marker(statusMigrating)
+		if err != nil {
+			return err
+		}
+		if err := db.Put(markerKey, markerValue); err != nil {
+			return err
+		}
+		return encryptEntries(db, nil, encDB, true)
+	case err != nil:
+		return err
+	}
+
+	if status, err := encDB.status(markerValue); err == nil {
+		if bytes.Equal(status, statusEncrypted) {
+			return nil
+		}
+		// encryption of plaintext entries was interrupted
+		return encryptEntries(db, nil, encDB, true)
+	}
+
+	if previousEncDB == nil {
+		return errWrongKey
+	}
+	// Status stays encrypted with the previous key until all entries are
+	// re-encrypted, so re-encryption could be resumed with the same keys.
+	status, err := previousEncDB.status(markerValue)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errWrongKey, err)
+	}
+	return encryptEntries(db, previousEncDB, encDB, !bytes.Equal(status, statusEncrypted))
+}
+
+// encryptEntries encrypts all entries of [db], that aren't yet encrypted with
+// [encDB], and marks [db] as encrypted with [encDB] afterwards. Entries, that
+// can be decrypted with [previousEncDB], are re-encrypted. Other entries are
+// encrypted only if [allowPlaintext] is true, otherwise an error is returned.
+func encryptEntries(db database.Database, previousEncDB, encDB *atRestDatabase, allowPlaintext bool) error {
+	it := db.NewIterator()
+	defer it.Release()
+
+	batch := db.NewBatch()
+	for it.Next() {
+		encKey := it.Key()
+		if bytes.Equal(encKey, markerKey) {
+			continue
+		}
+		encValue := it.Value()
+		if _, err := encDB.decrypt(encKey, encValue); err == nil {
+			continue
+		}
+
+		key, value, err := decryptEntry(previousEncDB, encKey, encValue)
+		if err != nil {
+			if !allowPlaintext {
+				return fmt.Errorf("%w: %s", errWrongKey, err)
+			}
+			key = encKey
+			value = encValue
+		}
+
+		newEncKey := encDB.keys.encode(key)
+		newEncValue, err := encDB.encrypt(newEncKey, value)
+		if err != nil {
+			return err
+		}
+		if err := batch.Delete(encKey); err != nil {
+			return err
+		}
+		if err := batch.Put(newEncKey, newEncValue); err != nil {
+			return err
+		}
+
+		if batch.Size() < migrateBatchSize {
+			continue
+		}
+		if err := batch.Write(); err != nil {
+			return err
+		}
+		batch.Reset()
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	markerValue, err := encDB.marker(statusEncrypted)
+	if err != nil {
+		return err
+	}
+	if err := batch.Put(markerKey, markerValue); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// decryptEntry returns the plaintext key and value of the entry, that is
+// encrypted with [encDB]. [encDB] may be nil.
+func decryptEntry(encDB *atRestDatabase, encKey, encValue []byte) ([]byte, []byte, error) {
+	if encDB == nil {
+		return nil, nil, fmt.Errorf("couldn't decrypt value of key 0x%x", encKey)
+	}
+	value, err := encDB.decrypt(encKey, encValue)
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := encDB.keys.decode(encKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, value, nil
+}
@@ -0,0 +1,244 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package encdb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+)
+
+const testNewPassword = "lol totally a new secure password" //nolint:gosec
+
+var testSalt = []byte("totally a salt!!")
+
+func TestAtRestInterface(t *testing.T) {
+	for _, test := range database.Tests {
+		db, err := NewAtRest([]byte(testPassword), nil, memdb.New())
+		require.NoError(t, err)
+
+		test(t, db)
+	}
+}
+
+func TestAtRestClosesUnderlyingDB(t *testing.T) {
+	require := require.New(t)
+
+	baseDB := memdb.New()
+	db, err := NewAtRest([]byte(testPassword), nil, baseDB)
+	require.NoError(err)
+
+	require.NoError(db.Close())
+	_, err = baseDB.Has([]byte{})
+	require.ErrorIs(err, database.ErrClosed)
+}
+
+func TestAtRestKeyEncoding(t *testing.T) {
+	require := require.New(t)
+
+	keys, err := newAtRestDatabase([]byte(testPassword), testSalt, memdb.New())
+	require.NoError(err)
+	newKeys, err := newAtRestDatabase([]byte(testNewPassword), testSalt, memdb.New())
+	require.NoError(err)
+	require.NotEqual(keys.keys.encode([]byte("key")), newKeys.keys.encode([]byte("key")))
+
+	// the same byte is encoded differently after different prefixes
+	require.NotEqual(
+		keys.keys.encode([]byte{0, 1})[2:],
+		keys.keys.encode([]byte{1, 1})[2:],
+	)
+
+	plaintextKeys := [][]byte{{}, {0}, {0, 0}, {0, 255}, {1}, []byte("key"), {255}, {255, 0}}
+	for i, key := range plaintextKeys {
+		encKey := keys.keys.encode(key)
+		require.Len(encKey, 2*len(key))
+		decodedKey, err := keys.keys.decode(encKey)
+		require.NoError(err)
+		require.Equal(key, decodedKey)
+		if i > 0 {
+			// order and prefixes are preserved
+			previousEncKey := keys.keys.encode(plaintextKeys[i-1])
+			require.Equal(-1, bytes.Compare(previousEncKey, encKey))
+			require.Equal(
+				bytes.HasPrefix(key, plaintextKeys[i-1]),
+				bytes.HasPrefix(encKey, previousEncKey),
+			)
+		}
+	}
+
+	_, err = keys.keys.decode([]byte{1, 2, 3})
+	require.ErrorIs(err, errInvalidKey)
+}
+
+func TestAtRestKeysAreEncoded(t *testing.T) {
+	require := require.New(t)
+
+	baseDB := memdb.New()
+	db, err := NewAtRest([]byte(testPassword), nil, baseDB)
+	require.NoError(err)
+	require.NoError(db.Put([]byte("key"), []byte("value")))
+
+	has, err := baseDB.Has([]byte("key"))
+	require.NoError(err)
+	require.False(has)
+
+	it := baseDB.NewIterator()
+	defer it.Release()
+	for it.Next() {
+		require.False(bytes.Contains(it.Key(), []byte("key")))
+		require.False(bytes.Contains(it.Value(), []byte("value")))
+	}
+	require.NoError(it.Error())
+}
+
+func TestAtRestValuesAreBoundToKeys(t *testing.T) {
+	require := require.New(t)
+
+	baseDB := memdb.New()
+	db, err := newAtRestDatabase([]byte(testPassword), testSalt, baseDB)
+	require.NoError(err)
+	require.NoError(db.Put([]byte("key1"), []byte("value1")))
+	require.NoError(db.Put([]byte("key2"), []byte("value2")))
+
+	// value moved to another key mustn't be decrypted
+	encValue, err := baseDB.Get(db.keys.encode([]byte("key1")))
+	require.NoError(err)
+	require.NoError(baseDB.Put(db.keys.encode([]byte("key2")), encValue))
+	_, err = db.Get([]byte("key2"))
+	require.Error(err)
+}
+
+func TestAtRestWrongKey(t *testing.T) {
+	require := require.New(t)
+
+	baseDB := memdb.New()
+	db, err := newAtRestDatabase([]byte(testPassword), testSalt, baseDB)
+	require.NoError(err)
+	require.NoError(migrate(baseDB, nil, db))
+	require.NoError(db.Put([]byte("key"), []byte("value")))
+
+	_, err = NewAtRest([]byte(testNewPassword), nil, baseDB)
+	require.ErrorIs(err, errWrongKey)
+
+	_, err = NewAtRest([]byte(testPassword), nil, baseDB)
+	require.NoError(err)
+}
+
+func TestAtRestUnencryptedDB(t *testing.T) {
+	require := require.New(t)
+
+	keys := [][]byte{[]byte("key1"), []byte("key2"), []byte("key3")}
+	values := [][]byte{[]byte("value1"), []byte("value2"), []byte("value3")}
+
+	baseDB := memdb.New()
+	for i := range keys {
+		require.NoError(baseDB.Put(keys[i], values[i]))
+	}
+
+	db, err := NewAtRest([]byte(testPassword), nil, baseDB)
+	require.NoError(err)
+	requireEntries(t, db, keys, values)
+
+	// plaintext entries mustn't be left
+	for _, key := range keys {
+		has, err := baseDB.Has(key)
+		require.NoError(err)
+		require.False(has)
+	}
+
+	// encrypted database must be opened without migration
+	db, err = NewAtRest([]byte(testPassword), nil, baseDB)
+	require.NoError(err)
+	requireEntries(t, db, keys, values)
+
+	_, err = NewAtRest([]byte(testNewPassword), nil, baseDB)
+	require.ErrorIs(err, errWrongKey)
+}
+
+func TestAtRestInterruptedEncryption(t *testing.T) {
+	require := require.New(t)
+
+	keys := [][]byte{[]byte("key1"), []byte("key2")}
+	values := [][]byte{[]byte("value1"), []byte("value2")}
+
+	// simulate an interrupted encryption: the first entry is already
+	// encrypted, the second one is still plaintext
+	baseDB := memdb.New()
+	encDB, err := newAtRestDatabase([]byte(testPassword), testSalt, baseDB)
+	require.NoError(err)
+	markerValue, err := encDB.marker(statusMigrating)
+	require.NoError(err)
+	require.NoError(baseDB.Put(markerKey, markerValue))
+	require.NoError(encDB.Put(keys[0], values[0]))
+	require.NoError(baseDB.Put(keys[1], values[1]))
+
+	// encryption can't be resumed with another key
+	_, err = NewAtRest([]byte(testNewPassword), nil, baseDB)
+	require.ErrorIs(err, errWrongKey)
+
+	db, err := NewAtRest([]byte(testPassword), nil, baseDB)
+	require.NoError(err)
+	requireEntries(t, db, keys, values)
+}
+
+func TestAtRestKeyRotation(t *testing.T) {
+	require := require.New(t)
+
+	keys := [][]byte{[]byte("key1"), []byte("key2"), []byte("key3")}
+	values := [][]byte{[]byte("value1"), []byte("value2"), []byte("value3")}
+
+	baseDB := memdb.New()
+	oldDB, err := newAtRestDatabase([]byte(testPassword), testSalt, baseDB)
+	require.NoError(err)
+	newDB, err := newAtRestDatabase([]byte(testNewPassword), testSalt, baseDB)
+	require.NoError(err)
+
+	// simulate an interrupted rotation: the last entry is already
+	// re-encrypted with the new key
+	require.NoError(migrate(baseDB, nil, oldDB))
+	require.NoError(oldDB.Put(keys[0], values[0]))
+	require.NoError(oldDB.Put(keys[1], values[1]))
+	require.NoError(newDB.Put(keys[2], values[2]))
+
+	// rotation can't be resumed without the previous key
+	_, err = NewAtRest([]byte(testNewPassword), nil, baseDB)
+	require.ErrorIs(err, errWrongKey)
+
+	// rotation from a key, that doesn't match, must fail
+	_, err = NewAtRest([]byte(testNewPassword), []byte("wrong password"), baseDB)
+	require.ErrorIs(err, errWrongKey)
+
+	db, err := NewAtRest([]byte(testNewPassword), []byte(testPassword), baseDB)
+	require.NoError(err)
+	requireEntries(t, db, keys, values)
+
+	// entries mustn't be readable with the old key anymore
+	_, err = oldDB.Get(keys[0])
+	require.ErrorIs(err, database.ErrNotFound)
+	_, err = NewAtRest([]byte(testPassword), nil, baseDB)
+	require.ErrorIs(err, errWrongKey)
+
+	// rotated database must be opened without the previous key
+	db, err = NewAtRest([]byte(testNewPassword), nil, baseDB)
+	require.NoError(err)
+	requireEntries(t, db, keys, values)
+}
+
+func requireEntries(t *testing.T, db database.Database, keys, values [][]byte) {
+	require := require.New(t)
+
+	it := db.NewIterator()
+	defer it.Release()
+	for i := range keys {
+		require.True(it.Next())
+		require.Equal(keys[i], it.Key())
+		require.Equal(values[i], it.Value())
+	}
+	require.False(it.Next())
+	require.NoError(it.Error())
+}
@@ -1,3 +1,13 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+//
+// This file is a derived work, based on ava-labs code whose
+// original notices appear below.
+//
+// It is distributed under the same license conditions as the
+// original code from which it is derived.
+//
+// Much love to the original authors for their work.
+// **********************************************************
 // Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
 // See the file LICENSE for licensing terms.
 
@@ -36,21 +46,30 @@ type Database struct {
 	cipher cipher.AEAD
 	db     database.Database
 	closed bool
+	// if true, values are authenticated together with their keys
+	bindKeys bool
 }
 
 // New returns a new encrypted database
 func New(password []byte, db database.Database) (*Database, error) {
-	h := hashing.ComputeHash256(password)
-	aead, err := chacha20poly1305.NewX(h)
+	return newDatabase(hashing.ComputeHash256(password), false, db)
+}
+
+// newDatabase returns a new encrypted database, that encrypts values with
+// [key]. If [bindKeys] is true, values are authenticated together with their
+// keys, so they can't be moved to other keys.
+func newDatabase(key []byte, bindKeys bool, db database.Database) (*Database, error) {
+	aead, err := chacha20poly1305.NewX(key)
 	if err != nil {
 		return nil, err
 	}
 	c := linearcodec.NewDefault()
 	manager := codec.NewDefaultManager()
 	return &Database{
-		codec:  manager,
-		cipher: aead,
-		db:     db,
+		codec:    manager,
+		cipher:   aead,
+		db:       db,
+		bindKeys: bindKeys,
 	}, manager.RegisterCodec(codecVersion, c)
 }
 
@@ -75,7 +94,7 @@ func (db *Database) Get(key []byte) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	return db.decrypt(encVal)
+	return db.decrypt(key, encVal)
 }
 
 func (db *Database) Put(key, value []byte) error {
@@ -86,7 +105,7 @@ func (db *Database) Put(key, value []byte) error {
 		return database.ErrClosed
 	}
 
-	encValue, err := db.encrypt(value)
+	encValue, err := db.encrypt(key, value)
 	if err != nil {
 		return err
 	}
@@ -188,7 +207,7 @@ type batch struct {
 
 func (b *batch) Put(key, value []byte) error {
 	b.writes = append(b.writes, keyValue{utils.CopyBytes(key), utils.CopyBytes(value), false})
-	encValue, err := b.db.encrypt(value)
+	encValue, err := b.db.encrypt(key, value)
 	if err != nil {
 		return err
 	}
@@ -255,7 +274,7 @@ func (it *iterator) Next() bool {
 	next := it.Iterator.Next()
 	if next {
 		encVal := it.Iterator.Value()
-		val, err := it.db.decrypt(encVal)
+		val, err := it.db.decrypt(it.Iterator.Key(), encVal)
 		if err != nil {
 			it.err = err
 			return false
@@ -289,22 +308,31 @@ type encryptedValue struct {
 	Nonce      []byte `serialize:"true"`
 }
 
-func (db *Database) encrypt(plaintext []byte) ([]byte, error) {
+func (db *Database) encrypt(key, plaintext []byte) ([]byte, error) {
 	nonce := make([]byte, chacha20poly1305.NonceSizeX)
 	if _, err := rand.Read(nonce); err != nil {
 		return nil, err
 	}
-	ciphertext := db.cipher.Seal(nil, nonce, plaintext, nil)
+	ciphertext := db.cipher.Seal(nil, nonce, plaintext, db.additionalData(key))
 	return db.codec.Marshal(codecVersion, &encryptedValue{
 		Ciphertext: ciphertext,
 		Nonce:      nonce,
 	})
 }
 
-func (db *Database) decrypt(ciphertext []byte) ([]byte, error) {
+func (db *Database) decrypt(key, ciphertext []byte) ([]byte, error) {
 	val := encryptedValue{}
 	if _, err := db.codec.Unmarshal(ciphertext, &val); err != nil {
 		return nil, err
 	}
-	return db.cipher.Open(nil, val.Nonce, val.Ciphertext, nil)
+	return db.cipher.Open(nil, val.Nonce, val.Ciphertext, db.additionalData(key))
+}
+
+// additionalData returns the data, that is authenticated together with the
+// value of [key]
+func (db *Database) additionalData(key []byte) []byte {
+	if db.bindKeys {
+		return key
+	}
+	return nil
 }
@@ -1,3 +1,13 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+//
+// This file is a derived work, based on ava-labs code whose
+// original notices appear below.
+//
+// It is distributed under the same license conditions as the
+// original code from which it is derived.
+//
+// Much love to the original authors for their work.
+// **********************************************************
 // Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
 // See the file LICENSE for licensing terms.
 
@@ -14,9 +24,11 @@ import (
 
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/database/corruptabledb"
+	"github.com/ava-labs/avalanchego/database/encdb"
 	"github.com/ava-labs/avalanchego/database/leveldb"
 	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/database/meterdb"
+	"github.com/ava-labs/avalanchego/database/pebbledb"
 	"github.com/ava-labs/avalanchego/database/prefixdb"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/logging"
@@ -64,6 +76,12 @@ type Manager interface {
 	// Note: calling this more than once with the same [namespace] will cause a
 	// conflict error for the [registerer].
 	NewCompleteMeterDBManager(namespace string, registerer prometheus.Registerer) (Manager, error)
+
+	// NewEncryptedDBManager returns a new database manager with every
+	// database version encrypted at rest with [password]. If
+	// [previousPassword] is not nil, the databases are re-encrypted from
+	// [previousPassword] to [password] first.
+	NewEncryptedDBManager(password, previousPassword []byte) (Manager, error)
 }
 
 type manager struct {
@@ -96,6 +114,29 @@ func NewLevelDB(
 	)
 }
 
+// NewPebbleDB creates a database manager of pebbleDBs at [filePath] by creating
+// a database instance from each directory with a version <= [currentVersion].
+// If [includePreviousVersions], opens previous database versions and includes
+// them in the returned Manager.
+func NewPebbleDB(
+	dbDirPath string,
+	dbConfig []byte,
+	log logging.Logger,
+	currentVersion *version.Semantic,
+	namespace string,
+	reg prometheus.Registerer,
+) (Manager, error) {
+	return new(
+		pebbledb.New,
+		dbDirPath,
+		dbConfig,
+		log,
+		currentVersion,
+		namespace,
+		reg,
+	)
+}
+
 // new creates a database manager at [filePath] by creating a database instance
 // from each directory with a version <= [currentVersion]. If
 // [includePreviousVersions], opens previous database versions and includes them
@@ -303,6 +344,26 @@ func (m *manager) NewCompleteMeterDBManager(namespace string, registerer prometh
 	})
 }
 
+// NewEncryptedDBManager wraps every database instance with an encdb instance,
+// that owns it. Database versions that are still stored in plaintext are
+// encrypted before the manager is returned.
+func (m *manager) NewEncryptedDBManager(password, previousPassword []byte) (Manager, error) {
+	newManager := &manager{
+		databases: make([]*VersionedDatabase, len(m.databases)),
+	}
+	for i, vdb := range m.databases {
+		encDB, err := encdb.NewAtRest(password, previousPassword, vdb.Database)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't encrypt database version %s: %w", vdb.Version, err)
+		}
+		newManager.databases[i] = &VersionedDatabase{
+			Database: encDB,
+			Version:  vdb.Version,
+		}
+	}
+	return newManager, nil
+}
+
 // wrapManager returns a new database manager with each managed database wrapped
 // by the [wrap] function. If an error is returned by wrap, the error is
 // returned immediately. If [wrap] never returns an error, then wrapManager is
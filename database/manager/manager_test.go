@@ -366,6 +366,59 @@ func TestCompleteMeterDBManager(t *testing.T) {
 	require.Error(err)
 }
 
+func TestEncryptedDBManager(t *testing.T) {
+	require := require.New(t)
+
+	currentDB := memdb.New()
+	previousDB := memdb.New()
+	m := &manager{databases: []*VersionedDatabase{
+		{
+			Database: currentDB,
+			Version: &version.Semantic{
+				Major: 2,
+				Minor: 0,
+				Patch: 0,
+			},
+		},
+		{
+			Database: previousDB,
+			Version:  version.Semantic1_0_0,
+		},
+	}}
+
+	manager, err := m.NewEncryptedDBManager([]byte("password"), nil)
+	require.NoError(err)
+
+	dbs := manager.GetDatabases()
+	require.Len(dbs, 2)
+
+	key := []byte("key")
+	value := []byte("value")
+	require.NoError(dbs[0].Database.Put(key, value))
+	has, err := currentDB.Has(key)
+	require.NoError(err)
+	require.False(has)
+
+	// Confirm that previous versions are encrypted as well
+	require.NoError(dbs[1].Database.Put(key, value))
+	has, err = previousDB.Has(key)
+	require.NoError(err)
+	require.False(has)
+
+	// Confirm that the database can't be opened with another password
+	_, err = m.NewEncryptedDBManager([]byte("other password"), nil)
+	require.Error(err)
+
+	// Confirm that the database can be re-encrypted with another password
+	manager, err = m.NewEncryptedDBManager([]byte("other password"), []byte("password"))
+	require.NoError(err)
+	for _, vdb := range manager.GetDatabases() {
+		readValue, err := vdb.Database.Get(key)
+		require.NoError(err)
+		require.Equal(value, readValue)
+	}
+}
+
 func TestNewManagerFromDBs(t *testing.T) {
 	require := require.New(t)
 
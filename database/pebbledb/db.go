@@ -0,0 +1,619 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package pebbledb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/units"
+)
+
+const (
+	// Name is the name of this database for database switches
+	Name = "pebbledb"
+
+	// DefaultCacheSize is the number of bytes to use for block caching in
+	// pebble.
+	DefaultCacheSize = 512 * units.MiB
+
+	// DefaultMemTableSize is the number of bytes to use for a single memtable
+	// in pebble.
+	DefaultMemTableSize = 64 * units.MiB
+
+	// DefaultMemTableStopWritesThreshold is the number of queued memtables, at
+	// which writes are stopped.
+	DefaultMemTableStopWritesThreshold = 8
+
+	// DefaultMaxOpenFiles is the number of files descriptors to cap pebble to
+	// use.
+	DefaultMaxOpenFiles = 4096
+
+	// DefaultMaxConcurrentCompactions is the number of compactions pebble is
+	// allowed to run concurrently.
+	DefaultMaxConcurrentCompactions = 1
+
+	// DefaultBytesPerSync is the number of bytes, after which sstable writes
+	// are synced to disk in the background.
+	DefaultBytesPerSync = 512 * units.KiB
+
+	// DefaultWALBytesPerSync is the number of bytes, after which WAL writes
+	// are synced to disk in the background.
+	DefaultWALBytesPerSync = 0
+
+	// DefaultMetricUpdateFrequency is the frequency to poll the pebble
+	// metrics.
+	DefaultMetricUpdateFrequency = 10 * time.Second
+
+	// pebbleByteOverhead is the number of bytes of constant overhead that
+	// should be added to a batch size per operation.
+	pebbleByteOverhead = 8
+)
+
+var (
+	errUnexpectedBatchOp = errors.New("unexpected batch operation")
+
+	_ database.Database = (*Database)(nil)
+	_ database.Batch    = (*batch)(nil)
+	_ database.Iterator = (*iter)(nil)
+)
+
+// Database is a persistent key-value store backed by pebble. Apart from basic
+// data storage functionality it also supports batch writes and iterating over
+// the keyspace in binary-alphabetical order.
+type Database struct {
+	// lock guards [closed] and [openIterators]. Operations on the underlying
+	// pebble database are performed while holding the read lock, because
+	// pebble panics, if it's used after being closed.
+	lock          sync.RWMutex
+	db            *pebble.DB
+	closed        bool
+	openIterators set.Set[*iter]
+
+	// metrics is only initialized and used when [MetricUpdateFrequency] is > 0
+	// in the config
+	metrics metrics
+	// closeCh is closed when Close() is called.
+	closeCh chan struct{}
+	// closeWg is used to wait for all goroutines created by New() to exit.
+	closeWg sync.WaitGroup
+}
+
+type config struct {
+	// CacheSize is the size in bytes of the block cache.
+	//
+	// The default value is 512MiB.
+	CacheSize int64 `json:"cacheSize"`
+	// BytesPerSync defines the number of bytes written to sstables after
+	// which a background sync is issued.
+	//
+	// The default value is 512KiB.
+	BytesPerSync int `json:"bytesPerSync"`
+	// WALBytesPerSync defines the number of bytes written to the WAL after
+	// which a background sync is issued. Zero disables background syncing.
+	//
+	// The default value is 0.
+	WALBytesPerSync int `json:"walBytesPerSync"`
+	// MemTableSize is the size in bytes of a single memtable.
+	//
+	// The default value is 64MiB.
+	MemTableSize int `json:"memTableSize"`
+	// MemTableStopWritesThreshold is the number of queued memtables at which
+	// writes are stopped until flushes catch up.
+	//
+	// The default value is 8.
+	MemTableStopWritesThreshold int `json:"memTableStopWritesThreshold"`
+	// MaxOpenFiles defines the capacity of the open files caching.
+	//
+	// The default value is 4096.
+	MaxOpenFiles int `json:"maxOpenFiles"`
+	// MaxConcurrentCompactions is the maximum number of concurrent
+	// compactions.
+	//
+	// The default value is 1.
+	MaxConcurrentCompactions int `json:"maxConcurrentCompactions"`
+	// L0CompactionThreshold is the amount of L0 read-amplification necessary
+	// to trigger an L0 compaction. Zero keeps pebble default.
+	L0CompactionThreshold int `json:"l0CompactionThreshold"`
+	// L0StopWritesThreshold is the amount of L0 read-amplification at which
+	// writes are stopped. Zero keeps pebble default.
+	L0StopWritesThreshold int `json:"l0StopWritesThreshold"`
+	// LBaseMaxBytes is the maximum number of bytes for LBase. Zero keeps
+	// pebble default.
+	LBaseMaxBytes int64 `json:"lBaseMaxBytes"`
+
+	// MetricUpdateFrequency is the frequency to poll pebble metrics.
+	// If <= 0, pebble metrics aren't polled.
+	MetricUpdateFrequency time.Duration `json:"metricUpdateFrequency"`
+}
+
+// New returns a wrapped pebble database.
+func New(file string, configBytes []byte, log logging.Logger, namespace string, reg prometheus.Registerer) (database.Database, error) {
+	parsedConfig := config{
+		CacheSize:                   DefaultCacheSize,
+		BytesPerSync:                DefaultBytesPerSync,
+		WALBytesPerSync:             DefaultWALBytesPerSync,
+		MemTableSize:                DefaultMemTableSize,
+		MemTableStopWritesThreshold: DefaultMemTableStopWritesThreshold,
+		MaxOpenFiles:                DefaultMaxOpenFiles,
+		MaxConcurrentCompactions:    DefaultMaxConcurrentCompactions,
+		MetricUpdateFrequency:       DefaultMetricUpdateFrequency,
+	}
+	if len(configBytes) > 0 {
+		if err := json.Unmarshal(configBytes, &parsedConfig); err != nil {
+			return nil, fmt.Errorf("failed to parse db config: %w", err)
+		}
+	}
+
+	log.Info("creating pebbledb",
+		zap.Reflect("config", parsedConfig),
+	)
+
+	wrappedDB := &Database{
+		openIterators: set.Set[*iter]{},
+		closeCh:       make(chan struct{}),
+	}
+
+	metricsEnabled := parsedConfig.MetricUpdateFrequency > 0
+	if metricsEnabled {
+		metrics, err := newMetrics(namespace, reg)
+		if err != nil {
+			return nil, err
+		}
+		wrappedDB.metrics = metrics
+	}
+
+	cache := pebble.NewCache(parsedConfig.CacheSize)
+	defer cache.Unref()
+
+	opts := &pebble.Options{
+		Cache:                       cache,
+		BytesPerSync:                parsedConfig.BytesPerSync,
+		WALBytesPerSync:             parsedConfig.WALBytesPerSync,
+		MemTableSize:                parsedConfig.MemTableSize,
+		MemTableStopWritesThreshold: parsedConfig.MemTableStopWritesThreshold,
+		MaxOpenFiles:                parsedConfig.MaxOpenFiles,
+		MaxConcurrentCompactions: func() int {
+			return parsedConfig.MaxConcurrentCompactions
+		},
+		L0CompactionThreshold: parsedConfig.L0CompactionThreshold,
+		L0StopWritesThreshold: parsedConfig.L0StopWritesThreshold,
+		LBaseMaxBytes:         parsedConfig.LBaseMaxBytes,
+		Logger:                &logger{log: log},
+	}
+	if metricsEnabled {
+		opts.EventListener = wrappedDB.metrics.eventListener()
+	}
+
+	db, err := pebble.Open(file, opts)
+	if err != nil {
+		return nil, err
+	}
+	wrappedDB.db = db
+
+	if metricsEnabled {
+		wrappedDB.closeWg.Add(1)
+		go func() {
+			t := time.NewTicker(parsedConfig.MetricUpdateFrequency)
+			defer func() {
+				t.Stop()
+				wrappedDB.closeWg.Done()
+			}()
+
+			for {
+				wrappedDB.updateMetrics()
+
+				select {
+				case <-t.C:
+				case <-wrappedDB.closeCh:
+					return
+				}
+			}
+		}()
+	}
+	return wrappedDB, nil
+}
+
+// Has returns if the key is set in the database
+func (db *Database) Has(key []byte) (bool, error) {
+	_, err := db.Get(key)
+	switch err {
+	case nil:
+		return true, nil
+	case database.ErrNotFound:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// Get returns the value the key maps to in the database
+func (db *Database) Get(key []byte) ([]byte, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return nil, database.ErrClosed
+	}
+
+	value, closer, err := db.db.Get(key)
+	if err != nil {
+		return nil, updateError(err)
+	}
+	defer closer.Close()
+	return utils.CopyBytes(value), nil
+}
+
+// Put sets the value of the provided key to the provided value
+func (db *Database) Put(key []byte, value []byte) error {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return database.ErrClosed
+	}
+	return updateError(db.db.Set(key, value, pebble.Sync))
+}
+
+// Delete removes the key from the database
+func (db *Database) Delete(key []byte) error {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return database.ErrClosed
+	}
+	return updateError(db.db.Delete(key, pebble.Sync))
+}
+
+// NewBatch creates a write/delete-only buffer that is atomically committed to
+// the database when write is called
+func (db *Database) NewBatch() database.Batch {
+	return &batch{
+		db:    db,
+		batch: new(pebble.Batch),
+	}
+}
+
+// NewIterator creates a lexicographically ordered iterator over the database
+func (db *Database) NewIterator() database.Iterator {
+	return db.newIter(&pebble.IterOptions{})
+}
+
+// NewIteratorWithStart creates a lexicographically ordered iterator over the
+// database starting at the provided key
+func (db *Database) NewIteratorWithStart(start []byte) database.Iterator {
+	return db.newIter(&pebble.IterOptions{
+		LowerBound: start,
+	})
+}
+
+// NewIteratorWithPrefix creates a lexicographically ordered iterator over the
+// database ignoring keys that do not start with the provided prefix
+func (db *Database) NewIteratorWithPrefix(prefix []byte) database.Iterator {
+	return db.newIter(prefixBounds(prefix))
+}
+
+// NewIteratorWithStartAndPrefix creates a lexicographically ordered iterator
+// over the database starting at start and ignoring keys that do not start with
+// the provided prefix
+func (db *Database) NewIteratorWithStartAndPrefix(start, prefix []byte) database.Iterator {
+	iterRange := prefixBounds(prefix)
+	if bytes.Compare(start, prefix) == 1 {
+		iterRange.LowerBound = start
+	}
+	return db.newIter(iterRange)
+}
+
+func (db *Database) newIter(opts *pebble.IterOptions) database.Iterator {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if db.closed {
+		return &iter{
+			db:     db,
+			closed: true,
+			err:    database.ErrClosed,
+		}
+	}
+
+	// Bounds must not be modified while the iterator is in use
+	opts.LowerBound = utils.CopyBytes(opts.LowerBound)
+	opts.UpperBound = utils.CopyBytes(opts.UpperBound)
+
+	it := &iter{
+		db:   db,
+		iter: db.db.NewIter(opts),
+	}
+	db.openIterators.Add(it)
+	return it
+}
+
+// Compact the underlying DB for the given key range.
+// Specifically, deleted and overwritten versions are discarded,
+// and the data is rearranged to reduce the cost of operations
+// needed to access the data. This operation should typically only
+// be invoked by users who understand the underlying implementation.
+//
+// A nil start is treated as a key before all keys in the DB.
+// And a nil limit is treated as a key after all keys in the DB.
+// Therefore if both are nil then it will compact entire DB.
+func (db *Database) Compact(start []byte, limit []byte) error {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return database.ErrClosed
+	}
+
+	if limit == nil {
+		// Pebble requires an explicit upper bound, so the key right after the
+		// last key in the DB is used.
+		it := db.db.NewIter(&pebble.IterOptions{})
+		if it.Last() {
+			limit = append(utils.CopyBytes(it.Key()), 0)
+		}
+		if err := it.Close(); err != nil {
+			return updateError(err)
+		}
+		if limit == nil {
+			// The DB is empty
+			return nil
+		}
+	}
+
+	if bytes.Compare(start, limit) >= 0 {
+		return nil
+	}
+	return updateError(db.db.Compact(start, limit, true /*=parallelize*/))
+}
+
+func (db *Database) Close() error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if db.closed {
+		return database.ErrClosed
+	}
+	db.closed = true
+
+	close(db.closeCh)
+	db.closeWg.Wait()
+
+	for it := range db.openIterators {
+		it.release()
+	}
+	db.openIterators.Clear()
+
+	return updateError(db.db.Close())
+}
+
+func (db *Database) HealthCheck(context.Context) (interface{}, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return nil, database.ErrClosed
+	}
+	return nil, nil
+}
+
+// batch is a wrapper around a pebble batch to contain sizes.
+type batch struct {
+	db    *Database
+	batch *pebble.Batch
+	size  int
+}
+
+// Put the value into the batch for later writing
+func (b *batch) Put(key, value []byte) error {
+	b.size += len(key) + len(value) + pebbleByteOverhead
+	return b.batch.Set(key, value, nil)
+}
+
+// Delete the key during writing
+func (b *batch) Delete(key []byte) error {
+	b.size += len(key) + pebbleByteOverhead
+	return b.batch.Delete(key, nil)
+}
+
+// Size retrieves the amount of data queued up for writing.
+func (b *batch) Size() int {
+	return b.size
+}
+
+// Write flushes any accumulated data to disk.
+func (b *batch) Write() error {
+	b.db.lock.RLock()
+	defer b.db.lock.RUnlock()
+
+	if b.db.closed {
+		return database.ErrClosed
+	}
+
+	// Pebble doesn't allow applying the same batch twice and could keep
+	// referencing the data of the applied batch, so the copy of the batch is
+	// applied instead.
+	toApply := new(pebble.Batch)
+	if err := toApply.SetRepr(utils.CopyBytes(b.batch.Repr())); err != nil {
+		return err
+	}
+	return updateError(b.db.db.Apply(toApply, pebble.Sync))
+}
+
+// Reset resets the batch for reuse.
+func (b *batch) Reset() {
+	b.batch.Reset()
+	b.size = 0
+}
+
+// Replay the batch contents.
+func (b *batch) Replay(w database.KeyValueWriterDeleter) error {
+	reader := b.batch.Reader()
+	for {
+		kind, key, value, ok := reader.Next()
+		if !ok {
+			return nil
+		}
+		switch kind {
+		case pebble.InternalKeyKindSet:
+			if err := w.Put(key, value); err != nil {
+				return err
+			}
+		case pebble.InternalKeyKindDelete:
+			if err := w.Delete(key); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("%w: %v", errUnexpectedBatchOp, kind)
+		}
+	}
+}
+
+// Inner returns itself
+func (b *batch) Inner() database.Batch {
+	return b
+}
+
+type iter struct {
+	db   *Database
+	iter *pebble.Iterator
+
+	// lock guards all fields below, because iterator could be released
+	// concurrently by closing the database.
+	lock       sync.Mutex
+	nextCalled bool
+	closed     bool
+
+	key, val []byte
+	err      error
+}
+
+func (it *iter) Next() bool {
+	it.db.lock.RLock()
+	defer it.db.lock.RUnlock()
+
+	it.lock.Lock()
+	defer it.lock.Unlock()
+
+	switch {
+	case it.db.closed:
+		// Short-circuit and set an error if the underlying database has been
+		// closed.
+		it.key = nil
+		it.val = nil
+		it.err = database.ErrClosed
+		return false
+	case it.closed || it.err != nil:
+		it.key = nil
+		it.val = nil
+		return false
+	}
+
+	var hasNext bool
+	if it.nextCalled {
+		hasNext = it.iter.Next()
+	} else {
+		hasNext = it.iter.First()
+		it.nextCalled = true
+	}
+	if hasNext {
+		it.key = utils.CopyBytes(it.iter.Key())
+		it.val = utils.CopyBytes(it.iter.Value())
+	} else {
+		it.key = nil
+		it.val = nil
+		it.err = updateError(it.iter.Error())
+	}
+	return hasNext
+}
+
+func (it *iter) Error() error {
+	it.lock.Lock()
+	defer it.lock.Unlock()
+
+	return it.err
+}
+
+func (it *iter) Key() []byte {
+	it.lock.Lock()
+	defer it.lock.Unlock()
+
+	return it.key
+}
+
+func (it *iter) Value() []byte {
+	it.lock.Lock()
+	defer it.lock.Unlock()
+
+	return it.val
+}
+
+func (it *iter) Release() {
+	it.db.lock.Lock()
+	defer it.db.lock.Unlock()
+
+	it.db.openIterators.Remove(it)
+	it.release()
+}
+
+// release closes the underlying pebble iterator. Assumes the database lock is
+// held.
+func (it *iter) release() {
+	it.lock.Lock()
+	defer it.lock.Unlock()
+
+	if it.closed {
+		return
+	}
+	it.closed = true
+	if err := it.iter.Close(); err != nil && it.err == nil {
+		it.err = updateError(err)
+	}
+}
+
+// prefixBounds returns iterator options, that limit iteration to keys with
+// [prefix].
+func prefixBounds(prefix []byte) *pebble.IterOptions {
+	var upperBound []byte
+	for i := len(prefix) - 1; i >= 0; i-- {
+		if prefix[i] != 0xFF {
+			upperBound = utils.CopyBytes(prefix[:i+1])
+			upperBound[i]++
+			break
+		}
+	}
+	return &pebble.IterOptions{
+		LowerBound: prefix,
+		UpperBound: upperBound,
+	}
+}
+
+func updateError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, pebble.ErrClosed):
+		return database.ErrClosed
+	case errors.Is(err, pebble.ErrNotFound):
+		return database.ErrNotFound
+	default:
+		return err
+	}
+}
@@ -0,0 +1,300 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package pebbledb
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var levelLabels = []string{"level"}
+
+// metrics are the same metrics, that are reported by leveldb, so both
+// databases could be monitored with the same dashboards.
+type metrics struct {
+	// total number of writes that have been delayed due to compaction
+	writesDelayedCount prometheus.Counter
+	// total amount of time (in ns) that writes that have been delayed due to
+	// compaction
+	writesDelayedDuration prometheus.Counter
+	// set to 1 if there is currently at least one write that is being delayed
+	// due to compaction
+	writeIsDelayed prometheus.Gauge
+
+	// number of currently alive snapshots
+	aliveSnapshots prometheus.Gauge
+	// number of currently alive iterators
+	aliveIterators prometheus.Gauge
+
+	// total amount of data written
+	ioWrite prometheus.Counter
+	// total amount of data read
+	ioRead prometheus.Counter
+
+	// total number of bytes of cached data blocks
+	blockCacheSize prometheus.Gauge
+	// current number of open tables
+	openTables prometheus.Gauge
+
+	// number of tables per level
+	levelTableCount *prometheus.GaugeVec
+	// size of each level
+	levelSize *prometheus.GaugeVec
+	// amount of time spent compacting each level
+	levelDuration *prometheus.CounterVec
+	// amount of bytes read while compacting each level
+	levelReads *prometheus.CounterVec
+	// amount of bytes written while compacting each level
+	levelWrites *prometheus.CounterVec
+
+	// total number memory compactions performed
+	memCompactions prometheus.Counter
+	// total number of level 0 compactions performed
+	level0Compactions prometheus.Counter
+	// total number of non-level 0 compactions performed
+	nonLevel0Compactions prometheus.Counter
+	// total number of seek compactions performed
+	seekCompactions prometheus.Counter
+
+	// start of the current write stall. Write stall events are reported by
+	// pebble sequentially, while holding its database lock.
+	writeStallStart time.Time
+
+	priorStats *pebble.Metrics
+}
+
+func newMetrics(namespace string, reg prometheus.Registerer) (metrics, error) {
+	m := metrics{
+		writesDelayedCount: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "writes_delayed",
+			Help:      "number of cumulative writes that have been delayed due to compaction",
+		}),
+		writesDelayedDuration: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "writes_delayed_duration",
+			Help:      "amount of time (in ns) that writes have been delayed due to compaction",
+		}),
+		writeIsDelayed: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "write_delayed",
+			Help:      "1 if there is currently a write that is being delayed due to compaction",
+		}),
+
+		aliveSnapshots: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "alive_snapshots",
+			Help:      "number of currently alive snapshots",
+		}),
+		aliveIterators: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "alive_iterators",
+			Help:      "number of currently alive iterators",
+		}),
+
+		ioWrite: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "io_write",
+			Help:      "cumulative amount of io write during compaction",
+		}),
+		ioRead: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "io_read",
+			Help:      "cumulative amount of io read during compaction",
+		}),
+
+		blockCacheSize: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "block_cache_size",
+			Help:      "total size of cached blocks",
+		}),
+		openTables: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "open_tables",
+			Help:      "number of currently opened tables",
+		}),
+
+		levelTableCount: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "table_count",
+				Help:      "number of tables allocated by level",
+			},
+			levelLabels,
+		),
+		levelSize: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "size",
+				Help:      "amount of bytes allocated by level",
+			},
+			levelLabels,
+		),
+		levelDuration: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "duration",
+				Help:      "amount of time (in ns) spent in compaction by level",
+			},
+			levelLabels,
+		),
+		levelReads: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "reads",
+				Help:      "amount of bytes read during compaction by level",
+			},
+			levelLabels,
+		),
+		levelWrites: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "writes",
+				Help:      "amount of bytes written during compaction by level",
+			},
+			levelLabels,
+		),
+
+		memCompactions: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "mem_comps",
+			Help:      "total number of memory compactions performed",
+		}),
+		level0Compactions: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "level_0_comps",
+			Help:      "total number of level 0 compactions performed",
+		}),
+		nonLevel0Compactions: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "non_level_0_comps",
+			Help:      "total number of non-level 0 compactions performed",
+		}),
+		seekCompactions: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "seek_comps",
+			Help:      "total number of seek compactions performed",
+		}),
+
+		priorStats: &pebble.Metrics{},
+	}
+
+	errs := wrappers.Errs{}
+	errs.Add(
+		reg.Register(m.writesDelayedCount),
+		reg.Register(m.writesDelayedDuration),
+		reg.Register(m.writeIsDelayed),
+
+		reg.Register(m.aliveSnapshots),
+		reg.Register(m.aliveIterators),
+
+		reg.Register(m.ioWrite),
+		reg.Register(m.ioRead),
+
+		reg.Register(m.blockCacheSize),
+		reg.Register(m.openTables),
+
+		reg.Register(m.levelTableCount),
+		reg.Register(m.levelSize),
+		reg.Register(m.levelDuration),
+		reg.Register(m.levelReads),
+		reg.Register(m.levelWrites),
+
+		reg.Register(m.memCompactions),
+		reg.Register(m.level0Compactions),
+		reg.Register(m.nonLevel0Compactions),
+		reg.Register(m.seekCompactions),
+	)
+	return m, errs.Err
+}
+
+// eventListener returns pebble event listener, that reports metrics, which
+// aren't provided by pebble stats: write stalls and compaction durations.
+func (m *metrics) eventListener() *pebble.EventListener {
+	return &pebble.EventListener{
+		WriteStallBegin: func(pebble.WriteStallBeginInfo) {
+			m.writeStallStart = time.Now()
+			m.writesDelayedCount.Inc()
+			m.writeIsDelayed.Set(1)
+		},
+		WriteStallEnd: func() {
+			m.writesDelayedDuration.Add(float64(time.Since(m.writeStallStart)))
+			m.writeIsDelayed.Set(0)
+		},
+		CompactionEnd: func(info pebble.CompactionInfo) {
+			if info.Err != nil || len(info.Input) == 0 {
+				return
+			}
+			if info.Input[0].Level == 0 {
+				m.level0Compactions.Inc()
+			} else {
+				m.nonLevel0Compactions.Inc()
+			}
+			levelStr := strconv.Itoa(info.Output.Level)
+			m.levelDuration.WithLabelValues(levelStr).Add(float64(info.TotalDuration))
+		},
+	}
+}
+
+func (db *Database) updateMetrics() {
+	metrics := &db.metrics
+
+	priorStats := metrics.priorStats
+	currentStats := db.db.Metrics()
+
+	metrics.aliveSnapshots.Set(float64(currentStats.Snapshots.Count))
+	metrics.aliveIterators.Set(float64(currentStats.TableIters))
+
+	priorTotal := priorStats.Total()
+	currentTotal := currentStats.Total()
+	metrics.ioWrite.Add(float64(
+		currentTotal.BytesFlushed + currentTotal.BytesCompacted -
+			priorTotal.BytesFlushed - priorTotal.BytesCompacted,
+	))
+	metrics.ioRead.Add(float64(currentTotal.BytesRead - priorTotal.BytesRead))
+
+	metrics.blockCacheSize.Set(float64(currentStats.BlockCache.Size))
+	metrics.openTables.Set(float64(currentStats.TableCache.Count))
+
+	for level, levelStats := range currentStats.Levels {
+		priorLevelStats := priorStats.Levels[level]
+		levelStr := strconv.Itoa(level)
+		metrics.levelTableCount.WithLabelValues(levelStr).Set(float64(levelStats.NumFiles))
+		metrics.levelSize.WithLabelValues(levelStr).Set(float64(levelStats.Size))
+		metrics.levelReads.WithLabelValues(levelStr).Add(float64(levelStats.BytesRead - priorLevelStats.BytesRead))
+		metrics.levelWrites.WithLabelValues(levelStr).Add(float64(
+			levelStats.BytesFlushed + levelStats.BytesCompacted -
+				priorLevelStats.BytesFlushed - priorLevelStats.BytesCompacted,
+		))
+	}
+
+	metrics.memCompactions.Add(float64(currentStats.Flush.Count - priorStats.Flush.Count))
+	metrics.seekCompactions.Add(float64(currentStats.Compact.ReadCount - priorStats.Compact.ReadCount))
+
+	// update the priorStats to update the counters correctly next time this
+	// method is called
+	metrics.priorStats = currentStats
+}
+
+// logger redirects pebble logs to the node logger.
+type logger struct {
+	log logging.Logger
+}
+
+func (l *logger) Infof(format string, args ...interface{}) {
+	l.log.Debug(fmt.Sprintf(format, args...))
+}
+
+func (l *logger) Fatalf(format string, args ...interface{}) {
+	l.log.Fatal(fmt.Sprintf(format, args...))
+	panic(fmt.Sprintf(format, args...))
+}
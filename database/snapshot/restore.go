@@ -0,0 +1,80 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package snapshot
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/perms"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"github.com/ava-labs/avalanchego/version"
+)
+
+var errDBDirNotEmpty = errors.New("database directory is not empty")
+
+// DatabaseFactory creates a database at the given path, e.g. leveldb.New.
+type DatabaseFactory func(
+	path string,
+	config []byte,
+	log logging.Logger,
+	namespace string,
+	reg prometheus.Registerer,
+) (database.Database, error)
+
+// RestoreDir restores the archive read from [r] into a fresh database
+// directory [dbDirPath], that is laid out in the same way, as it is expected by
+// database/manager. [dbDirPath] must either not exist or be empty.
+//
+// This must only be called while the node is not running.
+func RestoreDir(
+	r io.Reader,
+	dbDirPath string,
+	newDB DatabaseFactory,
+	dbConfig []byte,
+	log logging.Logger,
+) (*Manifest, uint64, error) {
+	entries, err := os.ReadDir(dbDirPath)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return nil, 0, err
+	case len(entries) > 0:
+		return nil, 0, fmt.Errorf("%w: %s", errDBDirNotEmpty, dbDirPath)
+	}
+
+	reader, err := NewReader(r)
+	if err != nil {
+		return nil, 0, err
+	}
+	manifest := reader.Manifest()
+	dbVersion, err := version.Parse(manifest.DatabaseVersion)
+	if err != nil {
+		return nil, 0, fmt.Errorf("couldn't parse snapshot database version: %w", err)
+	}
+
+	if err := os.MkdirAll(dbDirPath, perms.ReadWriteExecute); err != nil {
+		return nil, 0, err
+	}
+	dbPath := filepath.Join(dbDirPath, dbVersion.String())
+	db, err := newDB(dbPath, dbConfig, log, "", prometheus.NewRegistry())
+	if err != nil {
+		return nil, 0, fmt.Errorf("couldn't create db at %s: %w", dbPath, err)
+	}
+
+	keys, err := reader.Restore(db)
+	errs := wrappers.Errs{}
+	errs.Add(err, db.Close())
+	if errs.Errored() {
+		return nil, 0, fmt.Errorf("couldn't restore db at %s: %w", dbPath, errs.Err)
+	}
+	return manifest, keys, nil
+}
@@ -0,0 +1,253 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package snapshot implements a portable archive format for point-in-time
+// copies of a node database.
+//
+// An archive is a gzip stream that contains, in order:
+//   - the archive header and format version
+//   - the length prefixed json encoded manifest
+//   - the key-value pairs of the database, each preceded by a record marker
+//   - an end marker followed by the number of written key-value pairs
+package snapshot
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/units"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+)
+
+const (
+	formatVersion = 0
+
+	recordMarker byte = 1
+	endMarker    byte = 0
+
+	// maxManifestSize is the maximum size of the manifest, which will be
+	// accepted while reading an archive
+	maxManifestSize = 16 * units.MiB
+	// maxEntrySize is the maximum size of a key or a value, which will be
+	// accepted while reading an archive
+	maxEntrySize = 512 * units.MiB
+	// restoreBatchSize is the size of the batch after which it will be
+	// written into the restored database
+	restoreBatchSize = 4 * units.MiB
+)
+
+var (
+	header = []byte("caminodb")
+
+	errInvalidHeader        = errors.New("invalid snapshot header")
+	errUnknownFormatVersion = errors.New("unknown snapshot format version")
+	errManifestTooLarge     = errors.New("snapshot manifest is too large")
+	errEntryTooLarge        = errors.New("snapshot entry is too large")
+	errUnknownMarker        = errors.New("unknown snapshot record marker")
+	errWrongKeyCount        = errors.New("snapshot key count mismatch")
+)
+
+// Manifest describes the content of a snapshot archive.
+type Manifest struct {
+	// Version of the database/manager database the snapshot was taken from
+	DatabaseVersion string `json:"databaseVersion"`
+	// Time when the snapshot was taken
+	Timestamp time.Time `json:"timestamp"`
+	// Chains, that had their state stored in the snapshotted database
+	Chains []Chain `json:"chains"`
+}
+
+// Chain describes the state of a chain at the moment the snapshot was taken.
+type Chain struct {
+	ChainID ids.ID `json:"chainID"`
+	Name    string `json:"name"`
+	// Last accepted block of the chain. Empty for chains, that aren't linear.
+	LastAccepted ids.ID `json:"lastAccepted"`
+	// Height of the last accepted block. Zero for chains, that aren't linear.
+	Height avajson.Uint64 `json:"height"`
+}
+
+// Write writes the archive with [manifest] and all key-value pairs of [it]
+// into [w]. Returns the number of written key-value pairs.
+//
+// Consistency of the snapshot is guaranteed by the iterator: all database
+// iterators are reading from the state of the database at the moment of
+// their creation.
+//
+// Invariant: Write doesn't release [it].
+func Write(w io.Writer, manifest *Manifest, it database.Iterator) (uint64, error) {
+	manifestBytes, err := json.Marshal(manifest)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't marshal manifest: %w", err)
+	}
+
+	gw := gzip.NewWriter(w)
+	bw := bufio.NewWriter(gw)
+	aw := &writer{w: bw}
+
+	aw.writeBytes(header)
+	aw.writeUvarint(formatVersion)
+	aw.writeSized(manifestBytes)
+
+	keys := uint64(0)
+	for aw.err == nil && it.Next() {
+		aw.writeBytes([]byte{recordMarker})
+		aw.writeSized(it.Key())
+		aw.writeSized(it.Value())
+		keys++
+	}
+	if err := it.Error(); err != nil {
+		return 0, fmt.Errorf("couldn't iterate over database: %w", err)
+	}
+
+	aw.writeBytes([]byte{endMarker})
+	aw.writeUvarint(keys)
+	if aw.err != nil {
+		return 0, aw.err
+	}
+	if err := bw.Flush(); err != nil {
+		return 0, err
+	}
+	return keys, gw.Close()
+}
+
+// Reader reads snapshot archives.
+type Reader struct {
+	r        *bufio.Reader
+	manifest Manifest
+}
+
+// NewReader reads the header and the manifest of the archive from [r].
+func NewReader(r io.Reader) (*Reader, error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't open snapshot: %w", err)
+	}
+	reader := &Reader{r: bufio.NewReader(gr)}
+
+	headerBytes := make([]byte, len(header))
+	if _, err := io.ReadFull(reader.r, headerBytes); err != nil {
+		return nil, err
+	}
+	if string(headerBytes) != string(header) {
+		return nil, errInvalidHeader
+	}
+
+	version, err := binary.ReadUvarint(reader.r)
+	if err != nil {
+		return nil, err
+	}
+	if version != formatVersion {
+		return nil, fmt.Errorf("%w: %d", errUnknownFormatVersion, version)
+	}
+
+	manifestBytes, err := reader.readSized(maxManifestSize, errManifestTooLarge)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(manifestBytes, &reader.manifest); err != nil {
+		return nil, fmt.Errorf("couldn't unmarshal manifest: %w", err)
+	}
+	return reader, nil
+}
+
+// Manifest returns the manifest of the archive.
+func (r *Reader) Manifest() *Manifest {
+	return &r.manifest
+}
+
+// Restore writes all key-value pairs of the archive into [db]. Returns the
+// number of restored key-value pairs.
+//
+// Invariant: Restore must be called at most once.
+func (r *Reader) Restore(db database.Database) (uint64, error) {
+	batch := db.NewBatch()
+	keys := uint64(0)
+	for {
+		marker, err := r.r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		switch marker {
+		case recordMarker:
+		case endMarker:
+			expectedKeys, err := binary.ReadUvarint(r.r)
+			if err != nil {
+				return 0, err
+			}
+			if keys != expectedKeys {
+				return 0, fmt.Errorf("%w: expected %d, read %d", errWrongKeyCount, expectedKeys, keys)
+			}
+			return keys, batch.Write()
+		default:
+			return 0, fmt.Errorf("%w: %d", errUnknownMarker, marker)
+		}
+
+		key, err := r.readSized(maxEntrySize, errEntryTooLarge)
+		if err != nil {
+			return 0, err
+		}
+		value, err := r.readSized(maxEntrySize, errEntryTooLarge)
+		if err != nil {
+			return 0, err
+		}
+		if err := batch.Put(key, value); err != nil {
+			return 0, err
+		}
+		keys++
+
+		if batch.Size() < restoreBatchSize {
+			continue
+		}
+		if err := batch.Write(); err != nil {
+			return 0, err
+		}
+		batch.Reset()
+	}
+}
+
+func (r *Reader) readSized(maxSize uint64, errTooLarge error) ([]byte, error) {
+	size, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return nil, err
+	}
+	if size > maxSize {
+		return nil, fmt.Errorf("%w: %d > %d", errTooLarge, size, maxSize)
+	}
+	bytes := make([]byte, size)
+	_, err = io.ReadFull(r.r, bytes)
+	return bytes, err
+}
+
+// writer remembers the first write error, so it only has to be checked once.
+type writer struct {
+	w   io.Writer
+	buf [binary.MaxVarintLen64]byte
+	err error
+}
+
+func (w *writer) writeBytes(bytes []byte) {
+	if w.err != nil {
+		return
+	}
+	_, w.err = w.w.Write(bytes)
+}
+
+func (w *writer) writeUvarint(v uint64) {
+	n := binary.PutUvarint(w.buf[:], v)
+	w.writeBytes(w.buf[:n])
+}
+
+func (w *writer) writeSized(bytes []byte) {
+	w.writeUvarint(uint64(len(bytes)))
+	w.writeBytes(bytes)
+}
@@ -0,0 +1,162 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package snapshot
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/leveldb"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/version"
+)
+
+func testManifest() *Manifest {
+	return &Manifest{
+		DatabaseVersion: version.CurrentDatabase.String(),
+		Timestamp:       time.Unix(1_000_000, 0).UTC(),
+		Chains: []Chain{{
+			ChainID:      ids.GenerateTestID(),
+			Name:         "P",
+			LastAccepted: ids.GenerateTestID(),
+			Height:       42,
+		}},
+	}
+}
+
+func testDB(t *testing.T) database.Database {
+	db := memdb.New()
+	require.NoError(t, db.Put([]byte{}, []byte("empty key")))
+	require.NoError(t, db.Put([]byte("key1"), []byte{}))
+	require.NoError(t, db.Put([]byte("key2"), bytes.Repeat([]byte{1}, 1024)))
+	return db
+}
+
+func requireEqualDBs(t *testing.T, expected, actual database.Database) {
+	expectedIt := expected.NewIterator()
+	defer expectedIt.Release()
+	actualIt := actual.NewIterator()
+	defer actualIt.Release()
+
+	for expectedIt.Next() {
+		require.True(t, actualIt.Next())
+		require.Equal(t, expectedIt.Key(), actualIt.Key())
+		require.Equal(t, expectedIt.Value(), actualIt.Value())
+	}
+	require.False(t, actualIt.Next())
+	require.NoError(t, expectedIt.Error())
+	require.NoError(t, actualIt.Error())
+}
+
+func TestWriteRestore(t *testing.T) {
+	require := require.New(t)
+
+	manifest := testManifest()
+	db := testDB(t)
+	archive := &bytes.Buffer{}
+
+	it := db.NewIterator()
+	written, err := Write(archive, manifest, it)
+	it.Release()
+	require.NoError(err)
+	require.EqualValues(3, written)
+
+	reader, err := NewReader(archive)
+	require.NoError(err)
+	require.Equal(manifest, reader.Manifest())
+
+	restoredDB := memdb.New()
+	restored, err := reader.Restore(restoredDB)
+	require.NoError(err)
+	require.Equal(written, restored)
+	requireEqualDBs(t, db, restoredDB)
+}
+
+func TestNewReaderInvalidArchive(t *testing.T) {
+	require := require.New(t)
+
+	_, err := NewReader(bytes.NewReader([]byte("not a snapshot")))
+	require.Error(err)
+
+	invalidArchive := &bytes.Buffer{}
+	gw := gzip.NewWriter(invalidArchive)
+	_, err = gw.Write([]byte("notcaminodb"))
+	require.NoError(err)
+	require.NoError(gw.Close())
+	_, err = NewReader(invalidArchive)
+	require.ErrorIs(err, errInvalidHeader)
+}
+
+func TestRestoreDir(t *testing.T) {
+	require := require.New(t)
+
+	manifest := testManifest()
+	db := testDB(t)
+	archive := &bytes.Buffer{}
+
+	it := db.NewIterator()
+	written, err := Write(archive, manifest, it)
+	it.Release()
+	require.NoError(err)
+
+	dbDirPath := filepath.Join(t.TempDir(), "db")
+	restoredManifest, restored, err := RestoreDir(
+		bytes.NewReader(archive.Bytes()),
+		dbDirPath,
+		leveldb.New,
+		nil,
+		logging.NoLog{},
+	)
+	require.NoError(err)
+	require.Equal(manifest, restoredManifest)
+	require.Equal(written, restored)
+
+	restoredDB, err := leveldb.New(
+		filepath.Join(dbDirPath, manifest.DatabaseVersion),
+		nil,
+		logging.NoLog{},
+		"",
+		prometheus.NewRegistry(),
+	)
+	require.NoError(err)
+	requireEqualDBs(t, db, restoredDB)
+	require.NoError(restoredDB.Close())
+
+	// restoring into a used directory must fail
+	_, _, err = RestoreDir(
+		bytes.NewReader(archive.Bytes()),
+		dbDirPath,
+		leveldb.New,
+		nil,
+		logging.NoLog{},
+	)
+	require.ErrorIs(err, errDBDirNotEmpty)
+}
+
+func TestRestoreDirNotEmpty(t *testing.T) {
+	require := require.New(t)
+
+	dbDirPath := t.TempDir()
+	require.NoError(os.WriteFile(filepath.Join(dbDirPath, "file"), nil, 0o600))
+
+	_, _, err := RestoreDir(
+		bytes.NewReader(nil),
+		dbDirPath,
+		leveldb.New,
+		nil,
+		logging.NoLog{},
+	)
+	require.ErrorIs(err, errDBDirNotEmpty)
+}
@@ -21,6 +21,9 @@ type Camino struct {
 	DepositOffers            []genesis.DepositOffer  `json:"depositOffers"`
 	Allocations              []CaminoAllocation      `json:"allocations"`
 	InitialMultisigAddresses []genesis.MultisigAlias `json:"initialMultisigAddresses"`
+	ValidatorRewardRate      uint64                  `json:"validatorRewardRate"`
+	DaoProposalBondAmount    uint64                  `json:"daoProposalBondAmount"`
+	DaoProposalQuorum        uint64                  `json:"daoProposalQuorum"`
 }
 
 func (c Camino) Unparse(networkID uint32, starttime uint64) (UnparsedCamino, error) {
@@ -30,6 +33,9 @@ func (c Camino) Unparse(networkID uint32, starttime uint64) (UnparsedCamino, err
 		DepositOffers:            make([]UnparsedDepositOffer, len(c.DepositOffers)),
 		Allocations:              make([]UnparsedCaminoAllocation, len(c.Allocations)),
 		InitialMultisigAddresses: make([]UnparsedMultisigAlias, len(c.InitialMultisigAddresses)),
+		ValidatorRewardRate:      c.ValidatorRewardRate,
+		DaoProposalBondAmount:    c.DaoProposalBondAmount,
+		DaoProposalQuorum:        c.DaoProposalQuorum,
 	}
 
 	avaxAddr, err := address.Format(
@@ -19,6 +19,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/nftfx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/api"
 	"github.com/ava-labs/avalanchego/vms/platformvm/genesis"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/propertyfx"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
@@ -61,6 +62,14 @@ func validateCaminoConfig(config *Config) error {
 		return errors.New("config.Allocations != 0")
 	}
 
+	if config.Camino.ValidatorRewardRate > reward.PercentDenominator {
+		return fmt.Errorf(
+			"validator reward rate (%d) is greater than %d",
+			config.Camino.ValidatorRewardRate,
+			reward.PercentDenominator,
+		)
+	}
+
 	// validation deposit offers
 	offers := make(map[ids.ID]genesis.DepositOffer, len(config.Camino.DepositOffers))
 	for _, offer := range config.Camino.DepositOffers {
@@ -149,6 +158,9 @@ func caminoArgFromConfig(config *Config) api.Camino {
 		InitialAdmin:             config.Camino.InitialAdmin,
 		DepositOffers:            config.Camino.DepositOffers,
 		InitialMultisigAddresses: config.Camino.InitialMultisigAddresses,
+		ValidatorRewardRate:      config.Camino.ValidatorRewardRate,
+		DaoProposalBondAmount:    config.Camino.DaoProposalBondAmount,
+		DaoProposalQuorum:        config.Camino.DaoProposalQuorum,
 	}
 }
 
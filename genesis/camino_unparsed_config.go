@@ -24,6 +24,9 @@ type UnparsedCamino struct {
 	DepositOffers            []UnparsedDepositOffer     `json:"depositOffers"`
 	Allocations              []UnparsedCaminoAllocation `json:"allocations"`
 	InitialMultisigAddresses []UnparsedMultisigAlias    `json:"initialMultisigAddresses"`
+	ValidatorRewardRate      uint64                     `json:"validatorRewardRate"`
+	DaoProposalBondAmount    uint64                     `json:"daoProposalBondAmount"`
+	DaoProposalQuorum        uint64                     `json:"daoProposalQuorum"`
 }
 
 func (uc UnparsedCamino) Parse(startTime uint64) (Camino, error) {
@@ -33,6 +36,9 @@ func (uc UnparsedCamino) Parse(startTime uint64) (Camino, error) {
 		DepositOffers:            make([]genesis.DepositOffer, len(uc.DepositOffers)),
 		Allocations:              make([]CaminoAllocation, len(uc.Allocations)),
 		InitialMultisigAddresses: make([]genesis.MultisigAlias, len(uc.InitialMultisigAddresses)),
+		ValidatorRewardRate:      uc.ValidatorRewardRate,
+		DaoProposalBondAmount:    uc.DaoProposalBondAmount,
+		DaoProposalQuorum:        uc.DaoProposalQuorum,
 	}
 
 	_, _, avaxAddrBytes, err := address.Parse(uc.InitialAdmin)
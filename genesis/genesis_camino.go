@@ -9,7 +9,6 @@ import (
 	_ "embed"
 
 	"github.com/ava-labs/avalanchego/utils/units"
-	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 )
 
@@ -39,9 +38,6 @@ var (
 				MintingPeriod:      365 * 24 * time.Hour,
 				SupplyCap:          1000 * units.MegaAvax,
 			},
-			CaminoConfig: config.CaminoConfig{
-				DaoProposalBondAmount: 1 * units.KiloAvax,
-			},
 		},
 	}
 )
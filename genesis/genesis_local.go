@@ -22,7 +22,6 @@ import (
 	"github.com/ava-labs/avalanchego/utils/crypto"
 	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/utils/wrappers"
-	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 )
 
@@ -72,9 +71,6 @@ var (
 				MintingPeriod:      365 * 24 * time.Hour,
 				SupplyCap:          720 * units.MegaAvax,
 			},
-			CaminoConfig: config.CaminoConfig{
-				DaoProposalBondAmount: 100 * units.Avax,
-			},
 		},
 	}
 )
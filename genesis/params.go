@@ -17,7 +17,6 @@ import (
 	"time"
 
 	"github.com/ava-labs/avalanchego/utils/constants"
-	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 )
 
@@ -42,8 +41,6 @@ type StakingConfig struct {
 	MaxStakeDuration time.Duration `json:"maxStakeDuration"`
 	// RewardConfig is the config for the reward function.
 	RewardConfig reward.Config `json:"rewardConfig"`
-	// CaminoConfig is the config for camino related features.
-	CaminoConfig config.CaminoConfig `json:"caminoConfig"`
 }
 
 type TxFeeConfig struct {
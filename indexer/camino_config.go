@@ -0,0 +1,198 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/engine/avalanche"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman"
+	"github.com/ava-labs/avalanchego/utils"
+)
+
+const (
+	blockContainers = "block"
+	vtxContainers   = "vtx"
+	txContainers    = "tx"
+)
+
+var (
+	errUnknownContainerType  = errors.New("unknown container type")
+	errNoContainerTypes      = errors.New("no container types to index")
+	errChainIncludedExcluded = errors.New("chain is both included and excluded")
+
+	_ snow.Acceptor = (*heightFilteredAcceptor)(nil)
+)
+
+// ChainConfig is the indexing configuration of a single chain
+type ChainConfig struct {
+	// Container types, that are indexed: "block", "vtx" and "tx".
+	// Defaults to all types, that the chain's engine accepts.
+	Containers []string `json:"containers"`
+	// Containers below this height aren't indexed. Txs of DAG chains are
+	// indexed once the first vertex at or above this height was accepted.
+	StartHeight uint64 `json:"startHeight"`
+	// If non-zero, only the most recent [RetainedContainers] of each indexed
+	// container type are kept.
+	RetainedContainers uint64 `json:"retainedContainers"`
+}
+
+// Verify returns an error if [c] isn't a valid config
+func (c *ChainConfig) Verify() error {
+	if c.Containers != nil && len(c.Containers) == 0 {
+		return errNoContainerTypes
+	}
+	for _, containerType := range c.Containers {
+		switch containerType {
+		case blockContainers, vtxContainers, txContainers:
+		default:
+			return fmt.Errorf("%w: %q", errUnknownContainerType, containerType)
+		}
+	}
+	return nil
+}
+
+// indexes returns true if containers of [containerType] should be indexed
+func (c *ChainConfig) indexes(containerType string) bool {
+	if len(c.Containers) == 0 {
+		return true
+	}
+	for _, t := range c.Containers {
+		if t == containerType {
+			return true
+		}
+	}
+	return false
+}
+
+// isPartial returns true if indexing with this config could miss accepted
+// containers of the indexed container types.
+func (c *ChainConfig) isPartial(engine common.Engine) bool {
+	if c.StartHeight != 0 || c.RetainedContainers != 0 {
+		return true
+	}
+	switch engine.(type) {
+	case snowman.Engine:
+		return !c.indexes(blockContainers)
+	case avalanche.Engine:
+		return !c.indexes(vtxContainers) || !c.indexes(txContainers)
+	}
+	return false
+}
+
+// VerifyChainsConfig returns an error if any chain config is invalid or if
+// a chain is both in [included] and [excluded]
+func VerifyChainsConfig(included, excluded []string, configs map[string]ChainConfig) error {
+	for chain, config := range configs {
+		if err := config.Verify(); err != nil {
+			return fmt.Errorf("invalid index config of chain %s: %w", chain, err)
+		}
+	}
+	excludedSet := make(map[string]struct{}, len(excluded))
+	for _, chain := range excluded {
+		excludedSet[chain] = struct{}{}
+	}
+	for _, chain := range included {
+		if _, ok := excludedSet[chain]; ok {
+			return fmt.Errorf("%w: %s", errChainIncludedExcluded, chain)
+		}
+	}
+	return nil
+}
+
+// chainKeys returns the chain ID, the chain name and the chain aliases,
+// that could be used to refer to the chain in the indexer config.
+func chainKeys(ctx *snow.ConsensusContext, name string) []string {
+	keys := []string{ctx.ChainID.String(), name}
+	if aliases, err := ctx.BCLookup.Aliases(ctx.ChainID); err == nil {
+		keys = append(keys, aliases...)
+	}
+	return keys
+}
+
+// containsAny returns true if any of [keys] is in [list]
+func containsAny(list []string, keys []string) bool {
+	for _, s := range list {
+		for _, key := range keys {
+			if s == key {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// heightFilteredAcceptor passes accepted containers to [Acceptor], once the
+// first container at or above [startHeight] was accepted.
+type heightFilteredAcceptor struct {
+	snow.Acceptor
+	startHeight uint64
+	// Returns the height of the accepted container. If nil, containers are
+	// passed once [reached] is set by another acceptor.
+	height func(ctx context.Context, containerID ids.ID) (uint64, error)
+	// Set once the first container at or above [startHeight] was accepted
+	reached *utils.AtomicBool
+}
+
+func (a *heightFilteredAcceptor) Accept(ctx *snow.ConsensusContext, containerID ids.ID, container []byte) error {
+	if !a.reached.GetValue() {
+		if a.height == nil {
+			return nil
+		}
+		height, err := a.height(context.TODO(), containerID)
+		if err != nil {
+			return fmt.Errorf("couldn't get height of container %s: %w", containerID, err)
+		}
+		if height < a.startHeight {
+			return nil
+		}
+		a.reached.SetValue(true)
+	}
+	return a.Acceptor.Accept(ctx, containerID, container)
+}
+
+// heightFilter returns a wrapper of acceptors, that skips containers
+// below [c.StartHeight], or nil if all containers are indexed.
+// See heightFilteredAcceptor for [height] and [reached].
+func (c *ChainConfig) heightFilter(
+	height func(context.Context, ids.ID) (uint64, error),
+	reached *utils.AtomicBool,
+) func(snow.Acceptor) snow.Acceptor {
+	if c.StartHeight == 0 {
+		return nil
+	}
+	return func(acceptor snow.Acceptor) snow.Acceptor {
+		return &heightFilteredAcceptor{
+			Acceptor:    acceptor,
+			startHeight: c.StartHeight,
+			height:      height,
+			reached:     reached,
+		}
+	}
+}
+
+func blockHeight(engine snowman.Engine) func(context.Context, ids.ID) (uint64, error) {
+	return func(ctx context.Context, blkID ids.ID) (uint64, error) {
+		blk, err := engine.GetBlock(ctx, blkID)
+		if err != nil {
+			return 0, err
+		}
+		return blk.Height(), nil
+	}
+}
+
+func vtxHeight(engine avalanche.Engine) func(context.Context, ids.ID) (uint64, error) {
+	return func(ctx context.Context, vtxID ids.ID) (uint64, error) {
+		vtx, err := engine.GetVtx(ctx, vtxID)
+		if err != nil {
+			return 0, err
+		}
+		return vtx.Height()
+	}
+}
@@ -0,0 +1,345 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/codec/linearcodec"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/database/versiondb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/choices"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+
+	smcon "github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	smblockmocks "github.com/ava-labs/avalanchego/snow/engine/snowman/block/mocks"
+)
+
+func newTestIndex(t *testing.T, retainedContainers uint64) *index {
+	codec := codec.NewDefaultManager()
+	require.NoError(t, codec.RegisterCodec(codecVersion, linearcodec.NewDefault()))
+	idx, err := newIndex(memdb.New(), logging.NoLog{}, codec, mockable.Clock{}, retainedContainers)
+	require.NoError(t, err)
+	return idx.(*index)
+}
+
+func TestIndexPruning(t *testing.T) {
+	require := require.New(t)
+	ctx := snow.DefaultConsensusContextTest()
+	codec := codec.NewDefaultManager()
+	require.NoError(codec.RegisterCodec(codecVersion, linearcodec.NewDefault()))
+	baseDB := memdb.New()
+	db := versiondb.New(baseDB)
+
+	idxIntf, err := newIndex(db, logging.NoLog{}, codec, mockable.Clock{}, 3)
+	require.NoError(err)
+
+	containerIDs := make([]ids.ID, 5)
+	for i := range containerIDs {
+		containerIDs[i] = ids.GenerateTestID()
+		require.NoError(idxIntf.Accept(ctx, containerIDs[i], []byte{byte(i)}))
+	}
+
+	for i, containerID := range containerIDs[:2] {
+		_, err := idxIntf.GetContainerByIndex(uint64(i))
+		require.ErrorIs(err, errPruned)
+		_, err = idxIntf.GetContainerByID(containerID)
+		require.Error(err)
+	}
+	_, err = idxIntf.GetContainerRange(1, 2)
+	require.ErrorIs(err, errPruned)
+
+	containers, err := idxIntf.GetContainerRange(2, 5)
+	require.NoError(err)
+	require.Len(containers, 3)
+	for i, container := range containers {
+		require.Equal(containerIDs[i+2], container.ID)
+	}
+
+	// First accepted index must be restored after restart
+	require.NoError(db.Commit())
+	require.NoError(idxIntf.Close())
+	db = versiondb.New(baseDB)
+	idxIntf, err = newIndex(db, logging.NoLog{}, codec, mockable.Clock{}, 3)
+	require.NoError(err)
+	idx := idxIntf.(*index)
+	require.EqualValues(2, idx.firstAcceptedIndex)
+	require.EqualValues(5, idx.nextAcceptedIndex)
+}
+
+func TestGetContainersByTimestampRange(t *testing.T) {
+	ctx := snow.DefaultConsensusContextTest()
+	idx := newTestIndex(t, 0)
+
+	_, err := idx.GetContainersByTimestampRange(time.Unix(0, 0), time.Unix(1, 0), 1)
+	require.ErrorIs(t, err, errNoneAccepted)
+
+	// Accept containers at seconds 10, 20, ..., 100
+	containerIDs := make([]ids.ID, 10)
+	for i := range containerIDs {
+		containerIDs[i] = ids.GenerateTestID()
+		idx.clock.Set(time.Unix(int64(10*(i+1)), 0))
+		require.NoError(t, idx.Accept(ctx, containerIDs[i], []byte{byte(i)}))
+	}
+
+	tests := map[string]struct {
+		start, end  int64
+		numToFetch  uint64
+		expectedIDs []ids.ID
+		expectedErr error
+	}{
+		"zero numToFetch": {
+			start:       0,
+			end:         100,
+			numToFetch:  0,
+			expectedErr: errNumToFetchZero,
+		},
+		"end before start": {
+			start:       50,
+			end:         40,
+			numToFetch:  1,
+			expectedErr: errInvalidTimeRange,
+		},
+		"all": {
+			start:       0,
+			end:         1000,
+			numToFetch:  MaxFetchedByRange,
+			expectedIDs: containerIDs,
+		},
+		"inclusive bounds": {
+			start:       30,
+			end:         50,
+			numToFetch:  MaxFetchedByRange,
+			expectedIDs: containerIDs[2:5],
+		},
+		"limited": {
+			start:       25,
+			end:         100,
+			numToFetch:  2,
+			expectedIDs: containerIDs[2:4],
+		},
+		"after last": {
+			start:       101,
+			end:         200,
+			numToFetch:  1,
+			expectedIDs: []ids.ID{},
+		},
+		"between containers": {
+			start:       41,
+			end:         49,
+			numToFetch:  1,
+			expectedIDs: []ids.ID{},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			containers, err := idx.GetContainersByTimestampRange(time.Unix(tt.start, 0), time.Unix(tt.end, 0), tt.numToFetch)
+			require.ErrorIs(err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+			gotIDs := make([]ids.ID, len(containers))
+			for i, container := range containers {
+				gotIDs[i] = container.ID
+			}
+			require.Equal(tt.expectedIDs, gotIDs)
+		})
+	}
+}
+
+func TestChainsConfigVerify(t *testing.T) {
+	tests := map[string]struct {
+		included    []string
+		excluded    []string
+		configs     map[string]ChainConfig
+		expectedErr error
+	}{
+		"valid": {
+			included: []string{"X", "C"},
+			excluded: []string{"P"},
+			configs: map[string]ChainConfig{
+				"X": {Containers: []string{txContainers}, StartHeight: 10},
+			},
+		},
+		"included and excluded": {
+			included:    []string{"X", "C"},
+			excluded:    []string{"C"},
+			expectedErr: errChainIncludedExcluded,
+		},
+		"unknown container type": {
+			configs: map[string]ChainConfig{
+				"X": {Containers: []string{"utxo"}},
+			},
+			expectedErr: errUnknownContainerType,
+		},
+		"empty container types": {
+			configs: map[string]ChainConfig{
+				"X": {Containers: []string{}},
+			},
+			expectedErr: errNoContainerTypes,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := VerifyChainsConfig(tt.included, tt.excluded, tt.configs)
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestIndexIncludedSubnetChain(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	chainCtx := snow.DefaultConsensusContextTest()
+	chainCtx.ChainID = ids.GenerateTestID()
+	chainCtx.SubnetID = ids.GenerateTestID()
+
+	consensusAcceptorGroup := snow.NewAcceptorGroup(logging.NoLog{})
+	server := &apiServerMock{}
+	config := Config{
+		IndexingEnabled:        true,
+		AllowIncompleteIndex:   true,
+		Log:                    logging.NoLog{},
+		DB:                     versiondb.New(memdb.New()),
+		DecisionAcceptorGroup:  snow.NewAcceptorGroup(logging.NoLog{}),
+		ConsensusAcceptorGroup: consensusAcceptorGroup,
+		APIServer:              server,
+		ShutdownF:              func() {},
+		IndexedChains:          []string{"chain1"},
+		ChainConfigs: map[string]ChainConfig{
+			chainCtx.ChainID.String(): {StartHeight: 2},
+		},
+	}
+	idxrIntf, err := NewIndexer(config)
+	require.NoError(err)
+	idxr := idxrIntf.(*indexer)
+
+	blks := make([]*smcon.TestBlock, 4)
+	for i := range blks {
+		blks[i] = &smcon.TestBlock{
+			TestDecidable: choices.TestDecidable{IDV: ids.GenerateTestID()},
+			HeightV:       uint64(i),
+			BytesV:        []byte{byte(i)},
+		}
+	}
+
+	chainVM := smblockmocks.NewMockChainVM(ctrl)
+	chainEngine := snowman.NewMockEngine(ctrl)
+	chainEngine.EXPECT().Context().AnyTimes().Return(chainCtx)
+	chainEngine.EXPECT().GetVM().AnyTimes().Return(chainVM)
+	chainEngine.EXPECT().GetBlock(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+		func(_ interface{}, blkID ids.ID) (smcon.Block, error) {
+			for _, blk := range blks {
+				if blk.ID() == blkID {
+					return blk, nil
+				}
+			}
+			return nil, errUnknownContainerType
+		},
+	)
+	idxr.RegisterChain("chain1", chainEngine)
+	require.False(idxr.closed)
+	require.Contains(idxr.blockIndices, chainCtx.ChainID)
+	require.Equal([]string{"index/chain1"}, server.bases)
+
+	// Partial index config marks chain as incomplete
+	isIncomplete, err := idxr.isIncomplete(chainCtx.ChainID)
+	require.NoError(err)
+	require.True(isIncomplete)
+
+	for _, blk := range blks {
+		require.NoError(consensusAcceptorGroup.Accept(chainCtx, blk.ID(), blk.Bytes()))
+	}
+
+	// Blocks below start height aren't indexed
+	blkIdx := idxr.blockIndices[chainCtx.ChainID]
+	containers, err := blkIdx.GetContainerRange(0, MaxFetchedByRange)
+	require.NoError(err)
+	require.Len(containers, 2)
+	require.Equal(blks[2].ID(), containers[0].ID)
+	require.Equal(blks[3].ID(), containers[1].ID)
+}
+
+func TestIndexExcludedChain(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	chainCtx := snow.DefaultConsensusContextTest()
+	chainCtx.ChainID = ids.GenerateTestID()
+
+	config := Config{
+		IndexingEnabled:        true,
+		AllowIncompleteIndex:   true,
+		Log:                    logging.NoLog{},
+		DB:                     versiondb.New(memdb.New()),
+		DecisionAcceptorGroup:  snow.NewAcceptorGroup(logging.NoLog{}),
+		ConsensusAcceptorGroup: snow.NewAcceptorGroup(logging.NoLog{}),
+		APIServer:              &apiServerMock{},
+		ShutdownF:              func() {},
+		ExcludedChains:         []string{chainCtx.ChainID.String()},
+	}
+	idxrIntf, err := NewIndexer(config)
+	require.NoError(err)
+	idxr := idxrIntf.(*indexer)
+
+	chainVM := smblockmocks.NewMockChainVM(ctrl)
+	chainEngine := snowman.NewMockEngine(ctrl)
+	chainEngine.EXPECT().Context().AnyTimes().Return(chainCtx)
+	chainEngine.EXPECT().GetVM().AnyTimes().Return(chainVM)
+	idxr.RegisterChain("chain1", chainEngine)
+	require.False(idxr.closed)
+	require.Len(idxr.blockIndices, 0)
+
+	isIncomplete, err := idxr.isIncomplete(chainCtx.ChainID)
+	require.NoError(err)
+	require.True(isIncomplete)
+}
+
+func TestPartialIndexRequiresAllowIncomplete(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	chainCtx := snow.DefaultConsensusContextTest()
+	chainCtx.ChainID = ids.GenerateTestID()
+
+	config := Config{
+		IndexingEnabled:        true,
+		AllowIncompleteIndex:   false,
+		Log:                    logging.NoLog{},
+		DB:                     versiondb.New(memdb.New()),
+		DecisionAcceptorGroup:  snow.NewAcceptorGroup(logging.NoLog{}),
+		ConsensusAcceptorGroup: snow.NewAcceptorGroup(logging.NoLog{}),
+		APIServer:              &apiServerMock{},
+		ShutdownF:              func() {},
+		ChainConfigs: map[string]ChainConfig{
+			"chain1": {RetainedContainers: 100},
+		},
+	}
+	idxrIntf, err := NewIndexer(config)
+	require.NoError(err)
+	idxr := idxrIntf.(*indexer)
+
+	chainVM := smblockmocks.NewMockChainVM(ctrl)
+	chainEngine := snowman.NewMockEngine(ctrl)
+	chainEngine.EXPECT().Context().AnyTimes().Return(chainCtx)
+	chainEngine.EXPECT().GetVM().AnyTimes().Return(chainVM)
+	idxr.RegisterChain("chain1", chainEngine)
+	require.True(idxr.closed)
+	require.Len(idxr.blockIndices, 0)
+}
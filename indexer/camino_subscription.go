@@ -0,0 +1,329 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+const (
+	// Maximum number of containers, that are fetched from the index at once
+	// when a subscriber is behind
+	subscriptionBatchSize = 64
+
+	// Period of heartbeat messages. Heartbeats are also websocket pings.
+	heartbeatPeriod = 30 * time.Second
+
+	// Time allowed to write a message to the subscriber
+	subscriptionWriteWait = 10 * time.Second
+
+	// Time allowed to read the next pong message from the subscriber.
+	// Must be greater than [heartbeatPeriod].
+	subscriptionPongWait = 2 * heartbeatPeriod
+
+	// Time allowed to send the subscribe command after connecting
+	subscribeWait = 10 * time.Second
+
+	// Maximum size of a command sent by the subscriber
+	maxCommandSize = units.KiB
+
+	// Size of the ws read and write buffers
+	subscriptionBufferSize = units.KiB
+)
+
+var (
+	errNotSubscribed     = errors.New("first command must be subscribe")
+	errAlreadySubscribed = errors.New("already subscribed")
+
+	_ subscribableIndex = (*index)(nil)
+
+	subscriptionUpgrader = websocket.Upgrader{
+		ReadBufferSize:  subscriptionBufferSize,
+		WriteBufferSize: subscriptionBufferSize,
+		CheckOrigin: func(*http.Request) bool {
+			return true
+		},
+	}
+)
+
+// SubscribeCommand starts streaming accepted containers to the subscriber
+type SubscribeCommand struct {
+	// Index of the first streamed container. Containers, that are already in
+	// the index, are streamed first. If nil, only containers accepted after
+	// subscribing are streamed.
+	StartIndex *json.Uint64 `json:"startIndex,omitempty"`
+	// Encoding of the container bytes in JSON messages
+	Encoding formatting.Encoding `json:"encoding"`
+	// If true, containers are sent as binary messages. See
+	// MarshalBinaryContainer for the format.
+	Binary bool `json:"binary"`
+}
+
+// SubscriptionCommand is a command sent by the subscriber
+type SubscriptionCommand struct {
+	Subscribe *SubscribeCommand `json:"subscribe,omitempty"`
+}
+
+// Heartbeat is periodically sent to the subscriber
+type Heartbeat struct {
+	// Index of the next container, that will be accepted
+	NextAcceptedIndex json.Uint64 `json:"nextAcceptedIndex"`
+	Timestamp         time.Time   `json:"timestamp"`
+}
+
+// SubscriptionMessage is a JSON message sent to the subscriber. Exactly one
+// of the fields is set.
+type SubscriptionMessage struct {
+	Container *FormattedContainer `json:"container,omitempty"`
+	Heartbeat *Heartbeat          `json:"heartbeat,omitempty"`
+	Error     string              `json:"error,omitempty"`
+}
+
+// MarshalBinaryContainer returns the binary message of the container at
+// [index]: index (8 bytes) | timestamp in nanoseconds (8 bytes) |
+// container ID (32 bytes) | container bytes
+func MarshalBinaryContainer(container Container, index uint64) []byte {
+	p := wrappers.Packer{
+		Bytes: make([]byte, 2*wrappers.LongLen+len(container.ID)+len(container.Bytes)),
+	}
+	p.PackLong(index)
+	p.PackLong(uint64(container.Timestamp))
+	p.PackFixedBytes(container.ID[:])
+	p.PackFixedBytes(container.Bytes)
+	return p.Bytes
+}
+
+// subscribableIndex is an index, whose accepted containers can be streamed
+type subscribableIndex interface {
+	Index
+	subscriptionState() (firstAcceptedIndex, nextAcceptedIndex uint64, accepted <-chan struct{}, closed bool)
+}
+
+// subscriptionHandler serves websocket subscriptions to accepted containers
+// of [index]. Other requests are served by [apiHandler].
+type subscriptionHandler struct {
+	index      subscribableIndex
+	log        logging.Logger
+	apiHandler http.Handler
+}
+
+func (h *subscriptionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !websocket.IsWebSocketUpgrade(r) {
+		h.apiHandler.ServeHTTP(w, r)
+		return
+	}
+
+	conn, err := subscriptionUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		h.log.Debug("failed to upgrade",
+			zap.Error(err),
+		)
+		return
+	}
+	s := &subscription{
+		index:      h.index,
+		log:        h.log,
+		conn:       conn,
+		subscribed: make(chan *SubscribeCommand, 1),
+		done:       make(chan struct{}),
+	}
+	go s.readPump()
+	go s.writePump()
+}
+
+// subscription streams accepted containers to a single subscriber. Because
+// only accepted containers are streamed, each container is streamed exactly
+// once and in the order of acceptance.
+type subscription struct {
+	index subscribableIndex
+	log   logging.Logger
+	conn  *websocket.Conn
+
+	// Receives the subscribe command
+	subscribed chan *SubscribeCommand
+	// Closed when the connection can't be read anymore
+	done chan struct{}
+}
+
+// readPump reads commands of the subscriber. Only the first command may be
+// the subscribe command.
+func (s *subscription) readPump() {
+	defer func() {
+		close(s.done)
+		_ = s.conn.Close()
+	}()
+
+	s.conn.SetReadLimit(maxCommandSize)
+	// SetReadDeadline returns an error if the connection is corrupted
+	if err := s.conn.SetReadDeadline(time.Now().Add(subscribeWait)); err != nil {
+		return
+	}
+	s.conn.SetPongHandler(func(string) error {
+		return s.conn.SetReadDeadline(time.Now().Add(subscriptionPongWait))
+	})
+
+	subscribed := false
+	for {
+		cmd := &SubscriptionCommand{}
+		if err := s.conn.ReadJSON(cmd); err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
+				s.log.Debug("unexpected close in websockets",
+					zap.Error(err),
+				)
+			}
+			return
+		}
+
+		switch {
+		case cmd.Subscribe == nil:
+			s.log.Debug("invalid subscription command",
+				zap.Error(errNotSubscribed),
+			)
+			return
+		case subscribed:
+			s.log.Debug("invalid subscription command",
+				zap.Error(errAlreadySubscribed),
+			)
+			return
+		}
+		subscribed = true
+		if err := s.conn.SetReadDeadline(time.Now().Add(subscriptionPongWait)); err != nil {
+			return
+		}
+		s.subscribed <- cmd.Subscribe
+	}
+}
+
+// writePump streams containers to the subscriber, once it subscribed.
+// Containers already in the index are streamed first, then newly accepted
+// ones.
+func (s *subscription) writePump() {
+	defer func() {
+		_ = s.conn.Close()
+	}()
+
+	var cmd *SubscribeCommand
+	select {
+	case cmd = <-s.subscribed:
+	case <-s.done:
+		return
+	}
+	if _, err := formatting.Encode(cmd.Encoding, nil); !cmd.Binary && err != nil {
+		s.writeError(fmt.Errorf("invalid encoding %s: %w", cmd.Encoding, err))
+		return
+	}
+
+	_, nextIndex, _, _ := s.index.subscriptionState()
+	if cmd.StartIndex != nil {
+		nextIndex = uint64(*cmd.StartIndex)
+	}
+
+	ticker := time.NewTicker(heartbeatPeriod)
+	defer ticker.Stop()
+
+	for {
+		firstAcceptedIndex, nextAcceptedIndex, accepted, closed := s.index.subscriptionState()
+		switch {
+		case closed:
+			s.writeClose()
+			return
+		case nextIndex < firstAcceptedIndex:
+			// The subscriber started or fell behind the pruned containers
+			s.writeError(fmt.Errorf("%w: next index (%d) < first accepted index (%d)", errPruned, nextIndex, firstAcceptedIndex))
+			return
+		case nextIndex < nextAcceptedIndex:
+			numToFetch := nextAcceptedIndex - nextIndex
+			if numToFetch > subscriptionBatchSize {
+				numToFetch = subscriptionBatchSize
+			}
+			containers, err := s.index.GetContainerRange(nextIndex, numToFetch)
+			if err != nil {
+				s.writeError(fmt.Errorf("couldn't get containers: %w", err))
+				return
+			}
+			for _, container := range containers {
+				if err := s.writeContainer(cmd, container, nextIndex); err != nil {
+					return
+				}
+				nextIndex++
+			}
+			continue
+		}
+
+		select {
+		case <-accepted:
+		case <-ticker.C:
+			heartbeat := &Heartbeat{
+				NextAcceptedIndex: json.Uint64(nextAcceptedIndex),
+				Timestamp:         time.Now(),
+			}
+			if err := s.writeJSON(&SubscriptionMessage{Heartbeat: heartbeat}); err != nil {
+				return
+			}
+			if err := s.write(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		case <-s.done:
+			return
+		}
+	}
+}
+
+func (s *subscription) writeContainer(cmd *SubscribeCommand, container Container, index uint64) error {
+	if cmd.Binary {
+		return s.write(websocket.BinaryMessage, MarshalBinaryContainer(container, index))
+	}
+	fc, err := newFormattedContainer(container, index, cmd.Encoding)
+	if err != nil {
+		return err
+	}
+	return s.writeJSON(&SubscriptionMessage{Container: &fc})
+}
+
+func (s *subscription) writeError(err error) {
+	_ = s.writeJSON(&SubscriptionMessage{Error: err.Error()})
+	s.writeClose()
+}
+
+func (s *subscription) writeClose() {
+	_ = s.write(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+}
+
+func (s *subscription) writeJSON(msg *SubscriptionMessage) error {
+	if err := s.setWriteDeadline(); err != nil {
+		return err
+	}
+	return s.conn.WriteJSON(msg)
+}
+
+func (s *subscription) write(messageType int, data []byte) error {
+	if err := s.setWriteDeadline(); err != nil {
+		return err
+	}
+	return s.conn.WriteMessage(messageType, data)
+}
+
+func (s *subscription) setWriteDeadline() error {
+	err := s.conn.SetWriteDeadline(time.Now().Add(subscriptionWriteWait))
+	if err != nil {
+		s.log.Debug("closing the connection",
+			zap.String("reason", "failed to set the write deadline"),
+			zap.Error(err),
+		)
+	}
+	return err
+}
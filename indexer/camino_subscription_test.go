@@ -0,0 +1,139 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+func newTestSubscription(t *testing.T, idx *index, cmd *SubscribeCommand) *websocket.Conn {
+	handler := &subscriptionHandler{
+		index:      idx,
+		log:        logging.NoLog{},
+		apiHandler: http.NotFoundHandler(),
+	}
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	require.NoError(t, conn.WriteJSON(&SubscriptionCommand{Subscribe: cmd}))
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	return conn
+}
+
+func TestSubscriptionBackfillAndLive(t *testing.T) {
+	require := require.New(t)
+	ctx := snow.DefaultConsensusContextTest()
+	idx := newTestIndex(t, 0)
+
+	containerIDs := make([]ids.ID, 4)
+	for i := range containerIDs {
+		containerIDs[i] = ids.GenerateTestID()
+	}
+	for i, containerID := range containerIDs[:2] {
+		require.NoError(idx.Accept(ctx, containerID, []byte{byte(i)}))
+	}
+
+	startIndex := json.Uint64(1)
+	conn := newTestSubscription(t, idx, &SubscribeCommand{
+		StartIndex: &startIndex,
+		Encoding:   formatting.Hex,
+	})
+
+	// Backfilled container
+	msg := &SubscriptionMessage{}
+	require.NoError(conn.ReadJSON(msg))
+	require.NotNil(msg.Container)
+	require.Equal(containerIDs[1], msg.Container.ID)
+	require.EqualValues(1, msg.Container.Index)
+
+	// Live containers
+	for i, containerID := range containerIDs[2:] {
+		require.NoError(idx.Accept(ctx, containerID, []byte{byte(i + 2)}))
+	}
+	for i, containerID := range containerIDs[2:] {
+		msg := &SubscriptionMessage{}
+		require.NoError(conn.ReadJSON(msg))
+		require.NotNil(msg.Container)
+		require.Equal(containerID, msg.Container.ID)
+		require.EqualValues(i+2, msg.Container.Index)
+		bytes, err := formatting.Decode(formatting.Hex, msg.Container.Bytes)
+		require.NoError(err)
+		require.Equal([]byte{byte(i + 2)}, bytes)
+	}
+
+	// Subscription is closed with the index
+	require.NoError(idx.Close())
+	_, _, err := conn.ReadMessage()
+	require.True(websocket.IsCloseError(err, websocket.CloseNormalClosure))
+}
+
+func TestSubscriptionBinary(t *testing.T) {
+	require := require.New(t)
+	ctx := snow.DefaultConsensusContextTest()
+	idx := newTestIndex(t, 0)
+
+	startIndex := json.Uint64(0)
+	conn := newTestSubscription(t, idx, &SubscribeCommand{
+		StartIndex: &startIndex,
+		Binary:     true,
+	})
+
+	containerID := ids.GenerateTestID()
+	require.NoError(idx.Accept(ctx, containerID, []byte{1, 2, 3}))
+	container, err := idx.GetContainerByIndex(0)
+	require.NoError(err)
+
+	messageType, bytes, err := conn.ReadMessage()
+	require.NoError(err)
+	require.Equal(websocket.BinaryMessage, messageType)
+	require.Equal(MarshalBinaryContainer(container, 0), bytes)
+}
+
+func TestSubscriptionPruned(t *testing.T) {
+	require := require.New(t)
+	ctx := snow.DefaultConsensusContextTest()
+	idx := newTestIndex(t, 1)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(idx.Accept(ctx, ids.GenerateTestID(), []byte{byte(i)}))
+	}
+
+	startIndex := json.Uint64(0)
+	conn := newTestSubscription(t, idx, &SubscribeCommand{StartIndex: &startIndex})
+
+	msg := &SubscriptionMessage{}
+	require.NoError(conn.ReadJSON(msg))
+	require.Contains(msg.Error, errPruned.Error())
+}
+
+func TestSubscriptionInvalidEncoding(t *testing.T) {
+	require := require.New(t)
+	idx := newTestIndex(t, 0)
+
+	conn := newTestSubscription(t, idx, &SubscribeCommand{Encoding: formatting.JSON})
+
+	msg := &SubscriptionMessage{}
+	require.NoError(conn.ReadJSON(msg))
+	require.Contains(msg.Error, "invalid encoding")
+}
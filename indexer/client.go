@@ -6,6 +6,7 @@ package indexer
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/formatting"
@@ -22,6 +23,9 @@ type Client interface {
 	// If [startIndex] > the last accepted index, returns an error (unless the above apply.)
 	// If we run out of transactions, returns the ones fetched before running out.
 	GetContainerRange(ctx context.Context, startIndex uint64, numToFetch int, options ...rpc.Option) ([]Container, error)
+	// GetContainersByTimestampRange returns up to [numToFetch] containers accepted
+	// at or after [startTime] and at or before [endTime], ordered by index.
+	GetContainersByTimestampRange(ctx context.Context, startTime, endTime time.Time, numToFetch int, options ...rpc.Option) ([]Container, error)
 	// Get a container by its index
 	GetContainerByIndex(ctx context.Context, index uint64, options ...rpc.Option) (Container, error)
 	// Get the most recently accepted container and its index
@@ -77,6 +81,33 @@ func (c *client) GetContainerRange(ctx context.Context, startIndex uint64, numTo
 	return response, nil
 }
 
+func (c *client) GetContainersByTimestampRange(ctx context.Context, startTime, endTime time.Time, numToFetch int, options ...rpc.Option) ([]Container, error) {
+	var fcs GetContainerRangeResponse
+	err := c.requester.SendRequest(ctx, "index.getContainersByTimestampRange", &GetContainersByTimestampRangeArgs{
+		StartTime:  startTime,
+		EndTime:    endTime,
+		NumToFetch: json.Uint64(numToFetch),
+		Encoding:   formatting.Hex,
+	}, &fcs, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]Container, len(fcs.Containers))
+	for i, resp := range fcs.Containers {
+		containerBytes, err := formatting.Decode(resp.Encoding, resp.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't decode container %s: %w", resp.ID, err)
+		}
+		response[i] = Container{
+			ID:        resp.ID,
+			Timestamp: resp.Timestamp.Unix(),
+			Bytes:     containerBytes,
+		}
+	}
+	return response, nil
+}
+
 func (c *client) GetContainerByIndex(ctx context.Context, index uint64, options ...rpc.Option) (Container, error) {
 	var fc FormattedContainer
 	err := c.requester.SendRequest(ctx, "index.getContainerByIndex", &GetContainerByIndexArgs{
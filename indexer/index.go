@@ -7,7 +7,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -27,6 +29,10 @@ const (
 	// Maximum number of containers IDs that can be fetched at a time
 	// in a call to GetContainerRange
 	MaxFetchedByRange = 1024
+
+	// Maximum number of containers that are pruned from the index, when a
+	// new container is accepted
+	maxPrunedPerAccept = MaxFetchedByRange
 )
 
 var (
@@ -34,7 +40,10 @@ var (
 	nextAcceptedIndexKey   = []byte{0x00}
 	indexToContainerPrefix = []byte{0x01}
 	containerToIDPrefix    = []byte{0x02}
+	firstAcceptedIndexKey  = []byte{0x03} // Maps to the first index, that wasn't pruned
 	errNoneAccepted        = errors.New("no containers have been accepted")
+	errPruned              = errors.New("container was pruned")
+	errInvalidTimeRange    = errors.New("end time is before start time")
 	errNumToFetchZero      = fmt.Errorf("numToFetch must be in [1,%d]", MaxFetchedByRange)
 
 	_ Index = (*index)(nil)
@@ -48,6 +57,7 @@ type Index interface {
 	snow.Acceptor
 	GetContainerByIndex(index uint64) (Container, error)
 	GetContainerRange(startIndex uint64, numToFetch uint64) ([]Container, error)
+	GetContainersByTimestampRange(startTime, endTime time.Time, numToFetch uint64) ([]Container, error)
 	GetLastAccepted() (Container, error)
 	GetIndex(id ids.ID) (uint64, error)
 	GetContainerByID(id ids.ID) (Container, error)
@@ -61,6 +71,10 @@ type index struct {
 	lock  sync.RWMutex
 	// The index of the next accepted transaction
 	nextAcceptedIndex uint64
+	// The index of the oldest container, that wasn't pruned
+	firstAcceptedIndex uint64
+	// If non-zero, only the most recent [retainedContainers] are kept
+	retainedContainers uint64
 	// When [baseDB] is committed, writes to [baseDB]
 	vDB    *versiondb.Database
 	baseDB database.Database
@@ -70,28 +84,43 @@ type index struct {
 	// Container ID --> Index
 	containerToIndex database.Database
 	log              logging.Logger
+	// Closed and replaced when a container is accepted or the index is closed
+	acceptedCh chan struct{}
+	closed     bool
 }
 
 // Returns a new, thread-safe Index.
+// If [retainedContainers] is non-zero, older containers are pruned.
 // Closes [baseDB] on close.
 func newIndex(
 	baseDB database.Database,
 	log logging.Logger,
 	codec codec.Manager,
 	clock mockable.Clock,
+	retainedContainers uint64,
 ) (Index, error) {
 	vDB := versiondb.New(baseDB)
 	indexToContainer := prefixdb.New(indexToContainerPrefix, vDB)
 	containerToIndex := prefixdb.New(containerToIDPrefix, vDB)
 
 	i := &index{
-		clock:            clock,
-		codec:            codec,
-		baseDB:           baseDB,
-		vDB:              vDB,
-		indexToContainer: indexToContainer,
-		containerToIndex: containerToIndex,
-		log:              log,
+		clock:              clock,
+		codec:              codec,
+		baseDB:             baseDB,
+		vDB:                vDB,
+		indexToContainer:   indexToContainer,
+		containerToIndex:   containerToIndex,
+		log:                log,
+		retainedContainers: retainedContainers,
+		acceptedCh:         make(chan struct{}),
+	}
+
+	firstAcceptedIndex, err := database.GetUInt64(i.vDB, firstAcceptedIndexKey)
+	switch {
+	case err == nil:
+		i.firstAcceptedIndex = firstAcceptedIndex
+	case err != database.ErrNotFound:
+		return nil, fmt.Errorf("couldn't get first accepted index from database: %w", err)
 	}
 
 	// Get next accepted index from db
@@ -115,6 +144,13 @@ func newIndex(
 
 // Close this index
 func (i *index) Close() error {
+	i.lock.Lock()
+	if !i.closed {
+		i.closed = true
+		close(i.acceptedCh)
+	}
+	i.lock.Unlock()
+
 	errs := wrappers.Errs{}
 	errs.Add(
 		i.indexToContainer.Close(),
@@ -176,8 +212,19 @@ func (i *index) Accept(ctx *snow.ConsensusContext, containerID ids.ID, container
 		return fmt.Errorf("couldn't put accepted container %s into index: %w", containerID, err)
 	}
 
+	if err := i.prune(); err != nil {
+		return fmt.Errorf("couldn't prune index: %w", err)
+	}
+
 	// Atomically commit [i.vDB], [i.indexToContainer], [i.containerToIndex] to [i.baseDB]
-	return i.vDB.Commit()
+	if err := i.vDB.Commit(); err != nil {
+		return err
+	}
+
+	// Notify subscribers about the accepted container
+	close(i.acceptedCh)
+	i.acceptedCh = make(chan struct{})
+	return nil
 }
 
 // Returns the ID of the [index]th accepted container and the container itself.
@@ -197,6 +244,9 @@ func (i *index) getContainerByIndex(index uint64) (Container, error) {
 	if !ok || index > lastAcceptedIndex {
 		return Container{}, fmt.Errorf("no container at index %d", index)
 	}
+	if index < i.firstAcceptedIndex {
+		return Container{}, fmt.Errorf("%w: index %d", errPruned, index)
+	}
 	indexBytes := database.PackUInt64(index)
 	return i.getContainerByIndexBytes(indexBytes)
 }
@@ -238,6 +288,8 @@ func (i *index) GetContainerRange(startIndex, numToFetch uint64) ([]Container, e
 		return nil, errNoneAccepted
 	} else if startIndex > lastAcceptedIndex {
 		return nil, fmt.Errorf("start index (%d) > last accepted index (%d)", startIndex, lastAcceptedIndex)
+	} else if startIndex < i.firstAcceptedIndex {
+		return nil, fmt.Errorf("%w: start index (%d) < first accepted index (%d)", errPruned, startIndex, i.firstAcceptedIndex)
 	}
 
 	// Calculate the last index we will fetch
@@ -299,3 +351,99 @@ func (i *index) GetLastAccepted() (Container, error) {
 func (i *index) lastAcceptedIndex() (uint64, bool) {
 	return i.nextAcceptedIndex - 1, i.nextAcceptedIndex != 0
 }
+
+// GetContainersByTimestampRange returns up to [numToFetch] containers, that
+// were accepted at or after [startTime] and at or before [endTime], in the
+// order of acceptance. Assumes that containers are accepted in the order of
+// their timestamps.
+// [numToFetch] should be in [1, MaxFetchedByRange]
+func (i *index) GetContainersByTimestampRange(startTime, endTime time.Time, numToFetch uint64) ([]Container, error) {
+	// Check arguments for validity
+	if numToFetch == 0 {
+		return nil, errNumToFetchZero
+	} else if numToFetch > MaxFetchedByRange {
+		return nil, fmt.Errorf("requested %d but maximum page size is %d", numToFetch, MaxFetchedByRange)
+	} else if endTime.Before(startTime) {
+		return nil, errInvalidTimeRange
+	}
+
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	lastAcceptedIndex, ok := i.lastAcceptedIndex()
+	if !ok {
+		return nil, errNoneAccepted
+	}
+
+	// Find the first container accepted at or after [startTime]
+	start := startTime.UnixNano()
+	var searchErr error
+	numAccepted := int(lastAcceptedIndex - i.firstAcceptedIndex + 1)
+	offset := sort.Search(numAccepted, func(j int) bool {
+		if searchErr != nil {
+			return true
+		}
+		container, err := i.getContainerByIndex(i.firstAcceptedIndex + uint64(j))
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		return container.Timestamp >= start
+	})
+	if searchErr != nil {
+		return nil, searchErr
+	}
+
+	end := endTime.UnixNano()
+	containers := []Container{}
+	for j := i.firstAcceptedIndex + uint64(offset); j <= lastAcceptedIndex && uint64(len(containers)) < numToFetch; j++ {
+		container, err := i.getContainerByIndex(j)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get container at index %d: %w", j, err)
+		}
+		if container.Timestamp > end {
+			break
+		}
+		containers = append(containers, container)
+	}
+	return containers, nil
+}
+
+// subscriptionState returns the index of the oldest container that wasn't
+// pruned, the index of the next accepted container and a channel, that is
+// closed when a container is accepted or the index is closed.
+// If the index is closed, [closed] is true.
+func (i *index) subscriptionState() (firstAcceptedIndex, nextAcceptedIndex uint64, accepted <-chan struct{}, closed bool) {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	return i.firstAcceptedIndex, i.nextAcceptedIndex, i.acceptedCh, i.closed
+}
+
+// prune deletes the oldest containers, so only the most recent
+// [i.retainedContainers] are kept. Doesn't commit [i.vDB].
+// Assumes [i.lock] is held
+func (i *index) prune() error {
+	if i.retainedContainers == 0 || i.nextAcceptedIndex-i.firstAcceptedIndex <= i.retainedContainers {
+		return nil
+	}
+
+	lastPrunedIndex := i.nextAcceptedIndex - i.retainedContainers
+	if lastPrunedIndex-i.firstAcceptedIndex > maxPrunedPerAccept {
+		lastPrunedIndex = i.firstAcceptedIndex + maxPrunedPerAccept
+	}
+	for ; i.firstAcceptedIndex < lastPrunedIndex; i.firstAcceptedIndex++ {
+		indexBytes := database.PackUInt64(i.firstAcceptedIndex)
+		container, err := i.getContainerByIndexBytes(indexBytes)
+		if err != nil {
+			return err
+		}
+		if err := i.containerToIndex.Delete(container.ID[:]); err != nil {
+			return err
+		}
+		if err := i.indexToContainer.Delete(indexBytes); err != nil {
+			return err
+		}
+	}
+	return database.PutUInt64(i.vDB, firstAcceptedIndexKey, i.firstAcceptedIndex)
+}
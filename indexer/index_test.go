@@ -31,7 +31,7 @@ func TestIndex(t *testing.T) {
 	db := versiondb.New(baseDB)
 	ctx := snow.DefaultConsensusContextTest()
 
-	indexIntf, err := newIndex(db, logging.NoLog{}, codec, mockable.Clock{})
+	indexIntf, err := newIndex(db, logging.NoLog{}, codec, mockable.Clock{}, 0)
 	require.NoError(err)
 	idx := indexIntf.(*index)
 
@@ -85,7 +85,7 @@ func TestIndex(t *testing.T) {
 	require.NoError(db.Commit())
 	require.NoError(idx.Close())
 	db = versiondb.New(baseDB)
-	indexIntf, err = newIndex(db, logging.NoLog{}, codec, mockable.Clock{})
+	indexIntf, err = newIndex(db, logging.NoLog{}, codec, mockable.Clock{}, 0)
 	require.NoError(err)
 	idx = indexIntf.(*index)
 
@@ -120,7 +120,7 @@ func TestIndexGetContainerByRangeMaxPageSize(t *testing.T) {
 	require.NoError(err)
 	db := memdb.New()
 	ctx := snow.DefaultConsensusContextTest()
-	indexIntf, err := newIndex(db, logging.NoLog{}, codec, mockable.Clock{})
+	indexIntf, err := newIndex(db, logging.NoLog{}, codec, mockable.Clock{}, 0)
 	require.NoError(err)
 	idx := indexIntf.(*index)
 
@@ -162,7 +162,7 @@ func TestDontIndexSameContainerTwice(t *testing.T) {
 	require.NoError(err)
 	db := memdb.New()
 	ctx := snow.DefaultConsensusContextTest()
-	idx, err := newIndex(db, logging.NoLog{}, codec, mockable.Clock{})
+	idx, err := newIndex(db, logging.NoLog{}, codec, mockable.Clock{}, 0)
 	require.NoError(err)
 
 	// Accept the same container twice
@@ -24,6 +24,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/avalanche"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/hashing"
 	"github.com/ava-labs/avalanchego/utils/json"
@@ -65,6 +66,13 @@ type Config struct {
 	ConsensusAcceptorGroup snow.AcceptorGroup
 	APIServer              server.PathAdder
 	ShutdownF              func()
+	// If non-empty, only these chains are indexed. Chains are referred to by
+	// their ID, name or alias. Otherwise, all primary network chains are indexed.
+	IndexedChains []string
+	// Chains that aren't indexed, even if they are in [IndexedChains]
+	ExcludedChains []string
+	// Chain ID, name or alias --> indexing config of that chain
+	ChainConfigs map[string]ChainConfig
 }
 
 // Indexer causes accepted containers for a given chain
@@ -92,6 +100,13 @@ func NewIndexer(config Config) (Indexer, error) {
 		blockIndices:           map[ids.ID]Index{},
 		pathAdder:              config.APIServer,
 		shutdownF:              config.ShutdownF,
+		indexedChains:          config.IndexedChains,
+		excludedChains:         config.ExcludedChains,
+		chainConfigs:           config.ChainConfigs,
+	}
+
+	if err := VerifyChainsConfig(config.IndexedChains, config.ExcludedChains, config.ChainConfigs); err != nil {
+		return nil, err
 	}
 
 	if err := indexer.codec.RegisterCodec(
@@ -132,6 +147,13 @@ type indexer struct {
 	// If false, don't create index for a chain when RegisterChain is called
 	indexingEnabled bool
 
+	// If non-empty, only these chains are indexed
+	indexedChains []string
+	// Chains that are never indexed
+	excludedChains []string
+	// Chain ID, name or alias --> indexing config of that chain
+	chainConfigs map[string]ChainConfig
+
 	// Chain ID --> index of blocks of that chain (if applicable)
 	blockIndices map[ids.ID]Index
 	// Chain ID --> index of vertices of that chain (if applicable)
@@ -157,12 +179,6 @@ func (i *indexer) RegisterChain(name string, engine common.Engine) {
 			zap.String("chainName", name),
 		)
 		return
-	} else if ctx.SubnetID != constants.PrimaryNetworkID {
-		i.log.Debug("not registering chain to indexer",
-			zap.String("reason", "not in the primary network"),
-			zap.String("chainName", name),
-		)
-		return
 	}
 
 	chainID := ctx.ChainID
@@ -203,7 +219,17 @@ func (i *indexer) RegisterChain(name string, engine common.Engine) {
 		return
 	}
 
-	if !i.indexingEnabled { // Indexing is disabled
+	keys := chainKeys(ctx, name)
+	isPrimaryNetwork := ctx.SubnetID == constants.PrimaryNetworkID
+	if !isPrimaryNetwork && !previouslyIndexed && !containsAny(i.indexedChains, keys) {
+		i.log.Debug("not registering chain to indexer",
+			zap.String("reason", "not in the primary network"),
+			zap.String("chainName", name),
+		)
+		return
+	}
+
+	if !i.indexingEnabled || !i.indexesChain(keys, isPrimaryNetwork) { // Indexing is disabled
 		if previouslyIndexed && !i.allowIncompleteIndex {
 			// We indexed this chain in a previous run but not in this run.
 			// This would create an incomplete index, which is not allowed, so exit.
@@ -247,6 +273,34 @@ func (i *indexer) RegisterChain(name string, engine common.Engine) {
 		return
 	}
 
+	config := i.chainConfig(keys)
+	if config.isPartial(engine) {
+		// This chain's index could miss accepted containers
+		if !i.allowIncompleteIndex {
+			i.log.Fatal("chain index config would cause index to become incomplete but incomplete indices are disabled",
+				zap.String("chainName", name),
+			)
+			if err := i.close(); err != nil {
+				i.log.Error("failed to close indexer",
+					zap.Error(err),
+				)
+			}
+			return
+		}
+		if err := i.markIncomplete(chainID); err != nil {
+			i.log.Fatal("couldn't mark chain as incomplete",
+				zap.String("chainName", name),
+				zap.Error(err),
+			)
+			if err := i.close(); err != nil {
+				i.log.Error("failed to close indexer",
+					zap.Error(err),
+				)
+			}
+			return
+		}
+	}
+
 	// Mark that in this run, this chain was indexed
 	if err := i.markPreviouslyIndexed(chainID); err != nil {
 		i.log.Error("couldn't mark chain as indexed",
@@ -261,9 +315,14 @@ func (i *indexer) RegisterChain(name string, engine common.Engine) {
 		return
 	}
 
-	switch engine.(type) {
+	switch engine := engine.(type) {
 	case snowman.Engine:
-		index, err := i.registerChainHelper(chainID, blockPrefix, name, "block", i.consensusAcceptorGroup)
+		if !config.indexes(blockContainers) {
+			return
+		}
+		reached := &utils.AtomicBool{}
+		filter := config.heightFilter(blockHeight(engine), reached)
+		index, err := i.registerChainHelper(chainID, blockPrefix, name, "block", i.consensusAcceptorGroup, config.RetainedContainers, filter)
 		if err != nil {
 			i.log.Fatal("failed to create block index",
 				zap.String("chainName", name),
@@ -278,11 +337,11 @@ func (i *indexer) RegisterChain(name string, engine common.Engine) {
 		}
 		i.blockIndices[chainID] = index
 	case avalanche.Engine:
-		vtxIndex, err := i.registerChainHelper(chainID, vtxPrefix, name, "vtx", i.consensusAcceptorGroup)
-		if err != nil {
-			i.log.Fatal("couldn't create vertex index",
+		if config.StartHeight != 0 && !config.indexes(vtxContainers) {
+			// Txs don't have heights, so the start of the tx index is
+			// determined by the accepted vertices
+			i.log.Fatal("chain index config with start height must index vertices",
 				zap.String("chainName", name),
-				zap.Error(err),
 			)
 			if err := i.close(); err != nil {
 				i.log.Error("failed to close indexer",
@@ -291,22 +350,42 @@ func (i *indexer) RegisterChain(name string, engine common.Engine) {
 			}
 			return
 		}
-		i.vtxIndices[chainID] = vtxIndex
+		reached := &utils.AtomicBool{}
+		if config.indexes(vtxContainers) {
+			filter := config.heightFilter(vtxHeight(engine), reached)
+			vtxIndex, err := i.registerChainHelper(chainID, vtxPrefix, name, "vtx", i.consensusAcceptorGroup, config.RetainedContainers, filter)
+			if err != nil {
+				i.log.Fatal("couldn't create vertex index",
+					zap.String("chainName", name),
+					zap.Error(err),
+				)
+				if err := i.close(); err != nil {
+					i.log.Error("failed to close indexer",
+						zap.Error(err),
+					)
+				}
+				return
+			}
+			i.vtxIndices[chainID] = vtxIndex
+		}
 
-		txIndex, err := i.registerChainHelper(chainID, txPrefix, name, "tx", i.decisionAcceptorGroup)
-		if err != nil {
-			i.log.Fatal("couldn't create tx index for",
-				zap.String("chainName", name),
-				zap.Error(err),
-			)
-			if err := i.close(); err != nil {
-				i.log.Error("failed to close indexer:",
+		if config.indexes(txContainers) {
+			filter := config.heightFilter(nil, reached)
+			txIndex, err := i.registerChainHelper(chainID, txPrefix, name, "tx", i.decisionAcceptorGroup, config.RetainedContainers, filter)
+			if err != nil {
+				i.log.Fatal("couldn't create tx index for",
+					zap.String("chainName", name),
 					zap.Error(err),
 				)
+				if err := i.close(); err != nil {
+					i.log.Error("failed to close indexer:",
+						zap.Error(err),
+					)
+				}
+				return
 			}
-			return
+			i.txIndices[chainID] = txIndex
 		}
-		i.txIndices[chainID] = txIndex
 	default:
 		engineType := fmt.Sprintf("%T", engine)
 		i.log.Error("got unexpected engine type",
@@ -326,19 +405,26 @@ func (i *indexer) registerChainHelper(
 	prefixEnd byte,
 	name, endpoint string,
 	acceptorGroup snow.AcceptorGroup,
+	retainedContainers uint64,
+	filter func(snow.Acceptor) snow.Acceptor,
 ) (Index, error) {
 	prefix := make([]byte, hashing.HashLen+wrappers.ByteLen)
 	copy(prefix, chainID[:])
 	prefix[hashing.HashLen] = prefixEnd
 	indexDB := prefixdb.New(prefix, i.db)
-	index, err := newIndex(indexDB, i.log, i.codec, i.clock)
+	index, err := newIndex(indexDB, i.log, i.codec, i.clock, retainedContainers)
 	if err != nil {
 		_ = indexDB.Close()
 		return nil, err
 	}
 
+	var acceptor snow.Acceptor = index
+	if filter != nil {
+		acceptor = filter(index)
+	}
+
 	// Register index to learn about new accepted vertices
-	if err := acceptorGroup.RegisterAcceptor(chainID, fmt.Sprintf("%s%s", indexNamePrefix, chainID), index, true); err != nil {
+	if err := acceptorGroup.RegisterAcceptor(chainID, fmt.Sprintf("%s%s", indexNamePrefix, chainID), acceptor, true); err != nil {
 		_ = index.Close()
 		return nil, err
 	}
@@ -352,7 +438,11 @@ func (i *indexer) registerChainHelper(
 		_ = index.Close()
 		return nil, err
 	}
-	handler := &common.HTTPHandler{LockOptions: common.NoLock, Handler: apiServer}
+	handler := &common.HTTPHandler{LockOptions: common.NoLock, Handler: &subscriptionHandler{
+		index:      index.(subscribableIndex),
+		log:        i.log,
+		apiHandler: apiServer,
+	}}
 	if err := i.pathAdder.AddRoute(handler, &sync.RWMutex{}, "index/"+name, "/"+endpoint); err != nil {
 		_ = index.Close()
 		return nil, err
@@ -360,6 +450,28 @@ func (i *indexer) registerChainHelper(
 	return index, nil
 }
 
+// indexesChain returns true if the chain referred to by [keys] should be
+// indexed
+func (i *indexer) indexesChain(keys []string, isPrimaryNetwork bool) bool {
+	if containsAny(i.excludedChains, keys) {
+		return false
+	}
+	if len(i.indexedChains) != 0 {
+		return containsAny(i.indexedChains, keys)
+	}
+	return isPrimaryNetwork
+}
+
+// chainConfig returns the indexing config of the chain referred to by [keys]
+func (i *indexer) chainConfig(keys []string) ChainConfig {
+	for _, key := range keys {
+		if config, ok := i.chainConfigs[key]; ok {
+			return config
+		}
+	}
+	return ChainConfig{}
+}
+
 // Close this indexer. Stops indexing all chains.
 // Closes [i.db]. Assumes Close is only called after
 // the node is done making decisions.
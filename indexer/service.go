@@ -111,6 +111,37 @@ func (s *service) GetContainerRange(_ *http.Request, args *GetContainerRangeArgs
 	return nil
 }
 
+type GetContainersByTimestampRangeArgs struct {
+	StartTime  time.Time           `json:"startTime"`
+	EndTime    time.Time           `json:"endTime"`
+	NumToFetch json.Uint64         `json:"numToFetch"`
+	Encoding   formatting.Encoding `json:"encoding"`
+}
+
+// GetContainersByTimestampRange returns up to [NumToFetch] containers, that were
+// accepted by this node at or after [StartTime] and at or before [EndTime].
+// If [NumToFetch] > [MaxFetchedByRange], returns an error.
+// The next page starts right after the timestamp of the last returned container.
+func (s *service) GetContainersByTimestampRange(_ *http.Request, args *GetContainersByTimestampRangeArgs, reply *GetContainerRangeResponse) error {
+	containers, err := s.Index.GetContainersByTimestampRange(args.StartTime, args.EndTime, uint64(args.NumToFetch))
+	if err != nil {
+		return err
+	}
+
+	reply.Containers = make([]FormattedContainer, len(containers))
+	for i, container := range containers {
+		index, err := s.Index.GetIndex(container.ID)
+		if err != nil {
+			return fmt.Errorf("couldn't get index: %w", err)
+		}
+		reply.Containers[i], err = newFormattedContainer(container, index, args.Encoding)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type GetIndexArgs struct {
 	ID ids.ID `json:"id"`
 }
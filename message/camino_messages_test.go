@@ -0,0 +1,125 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package message
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/compression"
+	"github.com/ava-labs/avalanchego/utils/ips"
+
+	p2ppb "github.com/ava-labs/avalanchego/proto/pb/p2p"
+)
+
+func TestZstdMessage(t *testing.T) {
+	t.Parallel()
+
+	chainID := ids.GenerateTestID()
+	msg := &p2ppb.Message{
+		Message: &p2ppb.Message_AppGossip{
+			AppGossip: &p2ppb.AppGossip{
+				ChainId:  chainID[:],
+				AppBytes: bytes.Repeat([]byte{0}, 100),
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		compress                bool
+		expectedCompressionType compression.Type
+	}{
+		"compressed": {
+			compress:                true,
+			expectedCompressionType: compression.TypeZstd,
+		},
+		"not compressed": {
+			compress:                false,
+			expectedCompressionType: compression.TypeNone,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			mb, err := newMsgBuilder(
+				"test",
+				prometheus.NewRegistry(),
+				compression.TypeZstd,
+				5*time.Second,
+			)
+			require.NoError(err)
+
+			outMsg, err := mb.createOutbound(msg, tt.compress, false)
+			require.NoError(err)
+			require.Equal(tt.expectedCompressionType, outMsg.CompressionType())
+			require.Equal(tt.compress, outMsg.BytesSavedCompression() > 0)
+
+			inMsg, err := mb.parseInbound(outMsg.Bytes(), ids.EmptyNodeID, func() {})
+			require.NoError(err)
+			require.Equal(AppGossipOp, inMsg.Op())
+
+			gzipBytes, err := outMsg.GzipBytes()
+			require.NoError(err)
+			if !tt.compress {
+				require.Equal(outMsg.Bytes(), gzipBytes)
+				return
+			}
+
+			// peers without zstd support must be able to parse the fallback
+			fallbackMsg := &p2ppb.Message{}
+			require.NoError(proto.Unmarshal(gzipBytes, fallbackMsg))
+			require.NotEmpty(fallbackMsg.GetCompressedGzip())
+
+			inMsg, err = mb.parseInbound(gzipBytes, ids.EmptyNodeID, func() {})
+			require.NoError(err)
+			require.Equal(AppGossipOp, inMsg.Op())
+		})
+	}
+}
+
+func TestNewMsgBuilderUnsupportedCompressionType(t *testing.T) {
+	t.Parallel()
+
+	_, err := newMsgBuilder(
+		"test",
+		prometheus.NewRegistry(),
+		compression.Type(0),
+		5*time.Second,
+	)
+	require.ErrorIs(t, err, errUnsupportedCompressionType)
+}
+
+func TestVersionSupportedCompression(t *testing.T) {
+	t.Parallel()
+
+	require := require.New(t)
+
+	mb, err := newMsgBuilder(
+		"test",
+		prometheus.NewRegistry(),
+		compression.TypeNone,
+		5*time.Second,
+	)
+	require.NoError(err)
+	builder := newOutboundBuilder(false /*compress*/, mb)
+
+	outMsg, err := builder.Version(1, 0, ips.IPPort{}, "", 0, nil, nil)
+	require.NoError(err)
+
+	inMsg, err := mb.parseInbound(outMsg.Bytes(), ids.EmptyNodeID, func() {})
+	require.NoError(err)
+
+	versionMsg, ok := inMsg.Message().(*p2ppb.Version)
+	require.True(ok)
+	require.Contains(versionMsg.SupportedCompression, uint32(compression.TypeZstd))
+}
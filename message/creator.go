@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanchego/utils/compression"
 )
 
 var _ Creator = (*creator)(nil)
@@ -25,13 +27,14 @@ type creator struct {
 func NewCreator(
 	metrics prometheus.Registerer,
 	parentNamespace string,
-	compressionEnabled bool,
+	compressionType compression.Type,
 	maxMessageTimeout time.Duration,
 ) (Creator, error) {
 	namespace := fmt.Sprintf("%s_codec", parentNamespace)
 	builder, err := newMsgBuilder(
 		namespace,
 		metrics,
+		compressionType,
 		maxMessageTimeout,
 	)
 	if err != nil {
@@ -39,7 +42,7 @@ func NewCreator(
 	}
 
 	return &creator{
-		OutboundMsgBuilder: newOutboundBuilder(compressionEnabled, builder),
+		OutboundMsgBuilder: newOutboundBuilder(compressionType != compression.TypeNone, builder),
 		InboundMsgBuilder:  newInboundBuilder(builder),
 	}, nil
 }
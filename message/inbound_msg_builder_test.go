@@ -13,6 +13,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/proto/pb/p2p"
+	"github.com/ava-labs/avalanchego/utils/compression"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 )
 
@@ -23,6 +24,7 @@ func Test_newMsgBuilder(t *testing.T) {
 	mb, err := newMsgBuilder(
 		"test",
 		prometheus.NewRegistry(),
+		compression.TypeGzip,
 		10*time.Second,
 	)
 	require.NoError(err)
@@ -4,7 +4,9 @@
 package message
 
 import (
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -25,6 +27,8 @@ import (
 var (
 	_ InboundMessage  = (*inboundMessage)(nil)
 	_ OutboundMessage = (*outboundMessage)(nil)
+
+	errUnsupportedCompressionType = errors.New("unsupported compression type")
 )
 
 // InboundMessage represents a set of fields for an inbound message
@@ -91,6 +95,13 @@ type OutboundMessage interface {
 	Op() Op
 	// Bytes returns the bytes that will be sent
 	Bytes() []byte
+	// CompressionType returns the compression type of the bytes returned by
+	// Bytes
+	CompressionType() compression.Type
+	// GzipBytes returns the bytes that will be sent to peers, which don't
+	// support the compression type of this message. They only differ from
+	// Bytes if the message is zstd compressed.
+	GzipBytes() ([]byte, error)
 	// BytesSavedCompression returns the number of bytes that this message saved
 	// due to being compressed
 	BytesSavedCompression() int
@@ -100,7 +111,17 @@ type outboundMessage struct {
 	bypassThrottling      bool
 	op                    Op
 	bytes                 []byte
+	compressionType       compression.Type
 	bytesSavedCompression int
+
+	// Only set for zstd compressed messages. [gzipBytes] are lazily
+	// compressed from [uncompressedBytes], because most peers are expected
+	// to support zstd.
+	builder           *msgBuilder
+	uncompressedBytes []byte
+	gzipOnce          sync.Once
+	gzipBytes         []byte
+	gzipErr           error
 }
 
 func (m *outboundMessage) BypassThrottling() bool {
@@ -115,13 +136,33 @@ func (m *outboundMessage) Bytes() []byte {
 	return m.bytes
 }
 
+func (m *outboundMessage) CompressionType() compression.Type {
+	return m.compressionType
+}
+
+func (m *outboundMessage) GzipBytes() ([]byte, error) {
+	if m.compressionType != compression.TypeZstd {
+		return m.bytes, nil
+	}
+	m.gzipOnce.Do(func() {
+		var compressTook time.Duration
+		m.gzipBytes, _, compressTook, m.gzipErr = m.builder.compress(m.uncompressedBytes, compression.TypeGzip)
+		if m.gzipErr == nil {
+			m.builder.compressTimeMetrics[m.op].Observe(float64(compressTook))
+		}
+	})
+	return m.gzipBytes, m.gzipErr
+}
+
 func (m *outboundMessage) BytesSavedCompression() int {
 	return m.bytesSavedCompression
 }
 
-// TODO: add other compression algorithms with extended interface
 type msgBuilder struct {
 	gzipCompressor compression.Compressor
+	zstdCompressor compression.Compressor
+	// Compression type of outbound messages, which support compression
+	compressionType compression.Type
 
 	compressTimeMetrics   map[Op]metric.Averager
 	decompressTimeMetrics map[Op]metric.Averager
@@ -132,15 +173,28 @@ type msgBuilder struct {
 func newMsgBuilder(
 	namespace string,
 	metrics prometheus.Registerer,
+	compressionType compression.Type,
 	maxMessageTimeout time.Duration,
 ) (*msgBuilder, error) {
-	cpr, err := compression.NewGzipCompressor(constants.DefaultMaxMessageSize)
+	switch compressionType {
+	case compression.TypeNone, compression.TypeGzip, compression.TypeZstd:
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnsupportedCompressionType, compressionType)
+	}
+
+	gzipCompressor, err := compression.NewGzipCompressor(constants.DefaultMaxMessageSize)
+	if err != nil {
+		return nil, err
+	}
+	zstdCompressor, err := compression.NewZstdCompressor(constants.DefaultMaxMessageSize)
 	if err != nil {
 		return nil, err
 	}
 
 	mb := &msgBuilder{
-		gzipCompressor: cpr,
+		gzipCompressor:  gzipCompressor,
+		zstdCompressor:  zstdCompressor,
+		compressionType: compressionType,
 
 		compressTimeMetrics:   make(map[Op]metric.Averager, len(ExternalOps)),
 		decompressTimeMetrics: make(map[Op]metric.Averager, len(ExternalOps)),
@@ -168,16 +222,13 @@ func newMsgBuilder(
 	return mb, errs.Err
 }
 
-func (mb *msgBuilder) marshal(
-	uncompressedMsg *p2ppb.Message,
-	gzipCompress bool,
+// compress returns the message with [uncompressedMsgBytes] compressed with
+// [compressionType]
+func (mb *msgBuilder) compress(
+	uncompressedMsgBytes []byte,
+	compressionType compression.Type,
 ) ([]byte, int, time.Duration, error) {
-	uncompressedMsgBytes, err := proto.Marshal(uncompressedMsg)
-	if err != nil {
-		return nil, 0, 0, err
-	}
-
-	if !gzipCompress {
+	if compressionType == compression.TypeNone {
 		return uncompressedMsgBytes, 0, 0, nil
 	}
 
@@ -188,16 +239,28 @@ func (mb *msgBuilder) marshal(
 	// This recursive packing allows us to avoid an extra compression on/off
 	// field in the message.
 	startTime := time.Now()
-	compressedBytes, err := mb.gzipCompressor.Compress(uncompressedMsgBytes)
-	if err != nil {
-		return nil, 0, 0, err
-	}
-
-	compressedMsg := p2ppb.Message{
-		Message: &p2ppb.Message_CompressedGzip{
+	var compressedMsg p2ppb.Message
+	switch compressionType {
+	case compression.TypeGzip:
+		compressedBytes, err := mb.gzipCompressor.Compress(uncompressedMsgBytes)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		compressedMsg.Message = &p2ppb.Message_CompressedGzip{
 			CompressedGzip: compressedBytes,
-		},
+		}
+	case compression.TypeZstd:
+		compressedBytes, err := mb.zstdCompressor.Compress(uncompressedMsgBytes)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		compressedMsg.Message = &p2ppb.Message_CompressedZstd{
+			CompressedZstd: compressedBytes,
+		}
+	default:
+		return nil, 0, 0, fmt.Errorf("%w: %s", errUnsupportedCompressionType, compressionType)
 	}
+
 	compressedMsgBytes, err := proto.Marshal(&compressedMsg)
 	if err != nil {
 		return nil, 0, 0, err
@@ -214,14 +277,24 @@ func (mb *msgBuilder) unmarshal(b []byte) (*p2ppb.Message, bool, int, time.Durat
 		return nil, false, 0, 0, err
 	}
 
-	compressed := m.GetCompressedGzip()
-	if len(compressed) == 0 {
+	var (
+		compressed   []byte
+		decompressor compression.Compressor
+	)
+	switch {
+	case len(m.GetCompressedGzip()) != 0:
+		compressed = m.GetCompressedGzip()
+		decompressor = mb.gzipCompressor
+	case len(m.GetCompressedZstd()) != 0:
+		compressed = m.GetCompressedZstd()
+		decompressor = mb.zstdCompressor
+	default:
 		// The message wasn't compressed
 		return m, false, 0, 0, nil
 	}
 
 	startTime := time.Now()
-	decompressed, err := mb.gzipCompressor.Decompress(compressed)
+	decompressed, err := decompressor.Decompress(compressed)
 	if err != nil {
 		return nil, true, 0, 0, err
 	}
@@ -235,8 +308,18 @@ func (mb *msgBuilder) unmarshal(b []byte) (*p2ppb.Message, bool, int, time.Durat
 	return m, true, bytesSavedCompression, decompressTook, nil
 }
 
-func (mb *msgBuilder) createOutbound(m *p2ppb.Message, gzipCompress bool, bypassThrottling bool) (*outboundMessage, error) {
-	b, saved, compressTook, err := mb.marshal(m, gzipCompress)
+func (mb *msgBuilder) createOutbound(m *p2ppb.Message, compress bool, bypassThrottling bool) (*outboundMessage, error) {
+	uncompressedBytes, err := proto.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	compressionType := compression.TypeNone
+	if compress {
+		compressionType = mb.compressionType
+	}
+
+	b, saved, compressTook, err := mb.compress(uncompressedBytes, compressionType)
 	if err != nil {
 		return nil, err
 	}
@@ -246,16 +329,22 @@ func (mb *msgBuilder) createOutbound(m *p2ppb.Message, gzipCompress bool, bypass
 		return nil, err
 	}
 
-	if gzipCompress {
+	if compressionType != compression.TypeNone {
 		mb.compressTimeMetrics[op].Observe(float64(compressTook))
 	}
 
-	return &outboundMessage{
+	msg := &outboundMessage{
 		bypassThrottling:      bypassThrottling,
 		op:                    op,
 		bytes:                 b,
+		compressionType:       compressionType,
 		bytesSavedCompression: saved,
-	}, nil
+	}
+	if compressionType == compression.TypeZstd {
+		msg.builder = mb
+		msg.uncompressedBytes = uncompressedBytes
+	}
+	return msg, nil
 }
 
 func (mb *msgBuilder) parseInbound(
@@ -16,6 +16,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/compression"
 
 	p2ppb "github.com/ava-labs/avalanchego/proto/pb/p2p"
 )
@@ -63,7 +64,7 @@ func BenchmarkMarshalVersion(b *testing.B) {
 
 	useBuilder := os.Getenv("USE_BUILDER") != ""
 
-	codec, err := newMsgBuilder("", prometheus.NewRegistry(), 10*time.Second)
+	codec, err := newMsgBuilder("", prometheus.NewRegistry(), compression.TypeGzip, 10*time.Second)
 	require.NoError(err)
 
 	b.Logf("proto length %d-byte (use builder %v)", msgLen, useBuilder)
@@ -120,7 +121,7 @@ func BenchmarkUnmarshalVersion(b *testing.B) {
 	require.NoError(err)
 
 	useBuilder := os.Getenv("USE_BUILDER") != ""
-	codec, err := newMsgBuilder("", prometheus.NewRegistry(), 10*time.Second)
+	codec, err := newMsgBuilder("", prometheus.NewRegistry(), compression.TypeGzip, 10*time.Second)
 	require.NoError(err)
 
 	b.StartTimer()
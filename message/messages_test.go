@@ -17,6 +17,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/utils/compression"
 
 	p2ppb "github.com/ava-labs/avalanchego/proto/pb/p2p"
 )
@@ -29,6 +30,7 @@ func TestMessage(t *testing.T) {
 	mb, err := newMsgBuilder(
 		"test",
 		prometheus.NewRegistry(),
+		compression.TypeGzip,
 		5*time.Second,
 	)
 	require.NoError(err)
@@ -654,6 +656,7 @@ func TestEmptyInboundMessage(t *testing.T) {
 	mb, err := newMsgBuilder(
 		"test",
 		prometheus.NewRegistry(),
+		compression.TypeGzip,
 		5*time.Second,
 	)
 	require.NoError(err)
@@ -674,6 +677,7 @@ func TestNilInboundMessage(t *testing.T) {
 	mb, err := newMsgBuilder(
 		"test",
 		prometheus.NewRegistry(),
+		compression.TypeGzip,
 		5*time.Second,
 	)
 	require.NoError(err)
@@ -10,6 +10,7 @@ package message
 import (
 	reflect "reflect"
 
+	compression "github.com/ava-labs/avalanchego/utils/compression"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -78,6 +79,35 @@ func (mr *MockOutboundMessageMockRecorder) BytesSavedCompression() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BytesSavedCompression", reflect.TypeOf((*MockOutboundMessage)(nil).BytesSavedCompression))
 }
 
+// CompressionType mocks base method.
+func (m *MockOutboundMessage) CompressionType() compression.Type {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompressionType")
+	ret0, _ := ret[0].(compression.Type)
+	return ret0
+}
+
+// CompressionType indicates an expected call of CompressionType.
+func (mr *MockOutboundMessageMockRecorder) CompressionType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompressionType", reflect.TypeOf((*MockOutboundMessage)(nil).CompressionType))
+}
+
+// GzipBytes mocks base method.
+func (m *MockOutboundMessage) GzipBytes() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GzipBytes")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GzipBytes indicates an expected call of GzipBytes.
+func (mr *MockOutboundMessageMockRecorder) GzipBytes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GzipBytes", reflect.TypeOf((*MockOutboundMessage)(nil).GzipBytes))
+}
+
 // Op mocks base method.
 func (m *MockOutboundMessage) Op() Op {
 	m.ctrl.T.Helper()
@@ -7,12 +7,19 @@ import (
 	"time"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/compression"
 	"github.com/ava-labs/avalanchego/utils/ips"
 
 	p2ppb "github.com/ava-labs/avalanchego/proto/pb/p2p"
 )
 
-var _ OutboundMsgBuilder = (*outMsgBuilder)(nil)
+var (
+	_ OutboundMsgBuilder = (*outMsgBuilder)(nil)
+
+	// Compression types, besides gzip, which are advertised to peers in the
+	// Version message as supported for decompression
+	supportedCompression = []uint32{uint32(compression.TypeZstd)}
+)
 
 // OutboundMsgBuilder builds outbound messages. Outbound messages are returned
 // with a reference count of 1. Once the reference count hits 0, the message
@@ -219,14 +226,15 @@ func (b *outMsgBuilder) Version(
 		&p2ppb.Message{
 			Message: &p2ppb.Message_Version{
 				Version: &p2ppb.Version{
-					NetworkId:      networkID,
-					MyTime:         myTime,
-					IpAddr:         ip.IP.To16(),
-					IpPort:         uint32(ip.Port),
-					MyVersion:      myVersion,
-					MyVersionTime:  myVersionTime,
-					Sig:            sig,
-					TrackedSubnets: subnetIDBytes,
+					NetworkId:            networkID,
+					MyTime:               myTime,
+					IpAddr:               ip.IP.To16(),
+					IpPort:               uint32(ip.Port),
+					MyVersion:            myVersion,
+					MyVersionTime:        myVersionTime,
+					Sig:                  sig,
+					TrackedSubnets:       subnetIDBytes,
+					SupportedCompression: supportedCompression,
 				},
 			},
 		},
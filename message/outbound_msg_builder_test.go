@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/compression"
 )
 
 func Test_newOutboundBuilder(t *testing.T) {
@@ -21,6 +22,7 @@ func Test_newOutboundBuilder(t *testing.T) {
 	mb, err := newMsgBuilder(
 		"test",
 		prometheus.NewRegistry(),
+		compression.TypeGzip,
 		10*time.Second,
 	)
 	require.NoError(err)
@@ -0,0 +1,414 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package banlist
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+// Maximum length of the reason of a ban
+const maxReasonLen = 1024
+
+var (
+	_ List = (*list)(nil)
+	_ List = (*noList)(nil)
+
+	nodeIDPrefix    = []byte("nodeID")
+	ipPrefix        = []byte("ip")
+	allowlistPrefix = []byte("allowlist")
+
+	errInvalidIP       = errors.New("invalid IP")
+	errReasonTooLong   = fmt.Errorf("reason is longer than %d bytes", maxReasonLen)
+	errBanlistDisabled = errors.New("banlist is disabled")
+)
+
+// Ban of a node ID or of an IP
+type Ban struct {
+	// Banned node ID, empty if [IP] is banned
+	NodeID ids.NodeID
+	// Banned IP, nil if [NodeID] is banned
+	IP net.IP
+	// Time, at which the ban expires. Zero if the ban never expires.
+	Expiry time.Time
+	Reason string
+}
+
+// List is a persistent list of banned node IDs and IPs and of allowlisted node
+// IDs. Expired bans are ignored. All the methods are safe for concurrent use.
+type List interface {
+	// BanNodeID bans [nodeID] until [expiry]. If [nodeID] is already banned,
+	// the ban is replaced.
+	BanNodeID(nodeID ids.NodeID, expiry time.Time, reason string) error
+	// BanIP bans [ip] until [expiry]. If [ip] is already banned, the ban is
+	// replaced.
+	BanIP(ip net.IP, expiry time.Time, reason string) error
+	// UnbanNodeID removes the ban of [nodeID], if any
+	UnbanNodeID(nodeID ids.NodeID) error
+	// UnbanIP removes the ban of [ip], if any
+	UnbanIP(ip net.IP) error
+	IsNodeIDBanned(nodeID ids.NodeID) bool
+	IsIPBanned(ip net.IP) bool
+	// Bans returns all the bans, which didn't expire yet
+	Bans() []Ban
+
+	// Allow adds [nodeID] to the allowlist
+	Allow(nodeID ids.NodeID) error
+	// Disallow removes [nodeID] from the allowlist, if it is there
+	Disallow(nodeID ids.NodeID) error
+	IsAllowed(nodeID ids.NodeID) bool
+	// Allowed returns all the node IDs in the allowlist
+	Allowed() []ids.NodeID
+}
+
+type list struct {
+	// Useful for faking time in tests
+	clock mockable.Clock
+
+	lock        sync.RWMutex
+	nodeIDDB    database.Database
+	ipDB        database.Database
+	allowlistDB database.Database
+	// Banned node ID -> ban
+	nodeIDBans map[ids.NodeID]Ban
+	// Banned IP in its 16-byte form -> ban
+	ipBans    map[string]Ban
+	allowlist map[ids.NodeID]struct{}
+}
+
+// New returns a list, which is persisted in [db]. Bans and the allowlist,
+// which were stored in [db] previously, are loaded.
+func New(db database.Database) (List, error) {
+	l := &list{
+		nodeIDDB:    prefixdb.New(nodeIDPrefix, db),
+		ipDB:        prefixdb.New(ipPrefix, db),
+		allowlistDB: prefixdb.New(allowlistPrefix, db),
+		nodeIDBans:  make(map[ids.NodeID]Ban),
+		ipBans:      make(map[string]Ban),
+		allowlist:   make(map[ids.NodeID]struct{}),
+	}
+	if err := l.load(); err != nil {
+		return nil, fmt.Errorf("couldn't load banlist: %w", err)
+	}
+	return l, nil
+}
+
+func (l *list) load() error {
+	nodeIDIt := l.nodeIDDB.NewIterator()
+	defer nodeIDIt.Release()
+	for nodeIDIt.Next() {
+		nodeID, err := ids.ToNodeID(nodeIDIt.Key())
+		if err != nil {
+			return err
+		}
+		ban, err := parseBan(nodeIDIt.Value())
+		if err != nil {
+			return err
+		}
+		ban.NodeID = nodeID
+		l.nodeIDBans[nodeID] = ban
+	}
+	if err := nodeIDIt.Error(); err != nil {
+		return err
+	}
+
+	ipIt := l.ipDB.NewIterator()
+	defer ipIt.Release()
+	for ipIt.Next() {
+		ip := net.IP(ipIt.Key())
+		if len(ip) != net.IPv6len {
+			return errInvalidIP
+		}
+		ban, err := parseBan(ipIt.Value())
+		if err != nil {
+			return err
+		}
+		ban.IP = ip
+		l.ipBans[string(ip)] = ban
+	}
+	if err := ipIt.Error(); err != nil {
+		return err
+	}
+
+	allowlistIt := l.allowlistDB.NewIterator()
+	defer allowlistIt.Release()
+	for allowlistIt.Next() {
+		nodeID, err := ids.ToNodeID(allowlistIt.Key())
+		if err != nil {
+			return err
+		}
+		l.allowlist[nodeID] = struct{}{}
+	}
+	return allowlistIt.Error()
+}
+
+func (l *list) BanNodeID(nodeID ids.NodeID, expiry time.Time, reason string) error {
+	banBytes, err := banBytes(expiry, reason)
+	if err != nil {
+		return err
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if err := l.nodeIDDB.Put(nodeID[:], banBytes); err != nil {
+		return err
+	}
+	l.nodeIDBans[nodeID] = Ban{
+		NodeID: nodeID,
+		Expiry: expiry,
+		Reason: reason,
+	}
+	return l.removeExpired()
+}
+
+func (l *list) BanIP(ip net.IP, expiry time.Time, reason string) error {
+	ip = ip.To16()
+	if ip == nil {
+		return errInvalidIP
+	}
+	banBytes, err := banBytes(expiry, reason)
+	if err != nil {
+		return err
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if err := l.ipDB.Put(ip, banBytes); err != nil {
+		return err
+	}
+	l.ipBans[string(ip)] = Ban{
+		IP:     ip,
+		Expiry: expiry,
+		Reason: reason,
+	}
+	return l.removeExpired()
+}
+
+func (l *list) UnbanNodeID(nodeID ids.NodeID) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if err := l.nodeIDDB.Delete(nodeID[:]); err != nil {
+		return err
+	}
+	delete(l.nodeIDBans, nodeID)
+	return l.removeExpired()
+}
+
+func (l *list) UnbanIP(ip net.IP) error {
+	ip = ip.To16()
+	if ip == nil {
+		return errInvalidIP
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if err := l.ipDB.Delete(ip); err != nil {
+		return err
+	}
+	delete(l.ipBans, string(ip))
+	return l.removeExpired()
+}
+
+func (l *list) IsNodeIDBanned(nodeID ids.NodeID) bool {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	ban, ok := l.nodeIDBans[nodeID]
+	return ok && !l.isExpired(ban)
+}
+
+func (l *list) IsIPBanned(ip net.IP) bool {
+	ip = ip.To16()
+	if ip == nil {
+		return false
+	}
+
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	ban, ok := l.ipBans[string(ip)]
+	return ok && !l.isExpired(ban)
+}
+
+func (l *list) Bans() []Ban {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	bans := make([]Ban, 0, len(l.nodeIDBans)+len(l.ipBans))
+	for _, ban := range l.nodeIDBans {
+		if !l.isExpired(ban) {
+			bans = append(bans, ban)
+		}
+	}
+	for _, ban := range l.ipBans {
+		if !l.isExpired(ban) {
+			bans = append(bans, ban)
+		}
+	}
+	return bans
+}
+
+func (l *list) Allow(nodeID ids.NodeID) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if err := l.allowlistDB.Put(nodeID[:], nil); err != nil {
+		return err
+	}
+	l.allowlist[nodeID] = struct{}{}
+	return nil
+}
+
+func (l *list) Disallow(nodeID ids.NodeID) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if err := l.allowlistDB.Delete(nodeID[:]); err != nil {
+		return err
+	}
+	delete(l.allowlist, nodeID)
+	return nil
+}
+
+func (l *list) IsAllowed(nodeID ids.NodeID) bool {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	_, ok := l.allowlist[nodeID]
+	return ok
+}
+
+func (l *list) Allowed() []ids.NodeID {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	nodeIDs := make([]ids.NodeID, 0, len(l.allowlist))
+	for nodeID := range l.allowlist {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	return nodeIDs
+}
+
+// Assumes [l.lock] is held
+func (l *list) isExpired(ban Ban) bool {
+	return !ban.Expiry.IsZero() && !l.clock.Time().Before(ban.Expiry)
+}
+
+// removeExpired deletes expired bans from the database.
+// Assumes [l.lock] is held.
+func (l *list) removeExpired() error {
+	for nodeID, ban := range l.nodeIDBans {
+		if !l.isExpired(ban) {
+			continue
+		}
+		if err := l.nodeIDDB.Delete(nodeID[:]); err != nil {
+			return err
+		}
+		delete(l.nodeIDBans, nodeID)
+	}
+	for ipStr, ban := range l.ipBans {
+		if !l.isExpired(ban) {
+			continue
+		}
+		if err := l.ipDB.Delete(ban.IP); err != nil {
+			return err
+		}
+		delete(l.ipBans, ipStr)
+	}
+	return nil
+}
+
+func banBytes(expiry time.Time, reason string) ([]byte, error) {
+	if len(reason) > maxReasonLen {
+		return nil, errReasonTooLong
+	}
+	var expiryUnix uint64
+	if !expiry.IsZero() {
+		expiryUnix = uint64(expiry.Unix())
+	}
+	p := wrappers.Packer{MaxSize: wrappers.LongLen + wrappers.ShortLen + len(reason)}
+	p.PackLong(expiryUnix)
+	p.PackStr(reason)
+	return p.Bytes, p.Err
+}
+
+func parseBan(b []byte) (Ban, error) {
+	p := wrappers.Packer{Bytes: b}
+	expiryUnix := p.UnpackLong()
+	reason := p.UnpackLimitedStr(maxReasonLen)
+	if p.Errored() {
+		return Ban{}, p.Err
+	}
+	ban := Ban{Reason: reason}
+	if expiryUnix != 0 {
+		ban.Expiry = time.Unix(int64(expiryUnix), 0)
+	}
+	return ban, nil
+}
+
+// noList bans and allows nothing and can't be changed
+type noList struct{}
+
+// NewNoList returns a list, which bans and allows nothing. All the changes of
+// the list fail.
+func NewNoList() List {
+	return noList{}
+}
+
+func (noList) BanNodeID(ids.NodeID, time.Time, string) error {
+	return errBanlistDisabled
+}
+
+func (noList) BanIP(net.IP, time.Time, string) error {
+	return errBanlistDisabled
+}
+
+func (noList) UnbanNodeID(ids.NodeID) error {
+	return errBanlistDisabled
+}
+
+func (noList) UnbanIP(net.IP) error {
+	return errBanlistDisabled
+}
+
+func (noList) IsNodeIDBanned(ids.NodeID) bool {
+	return false
+}
+
+func (noList) IsIPBanned(net.IP) bool {
+	return false
+}
+
+func (noList) Bans() []Ban {
+	return nil
+}
+
+func (noList) Allow(ids.NodeID) error {
+	return errBanlistDisabled
+}
+
+func (noList) Disallow(ids.NodeID) error {
+	return errBanlistDisabled
+}
+
+func (noList) IsAllowed(ids.NodeID) bool {
+	return false
+}
+
+func (noList) Allowed() []ids.NodeID {
+	return nil
+}
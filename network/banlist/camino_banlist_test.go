@@ -0,0 +1,159 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package banlist
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+func TestBanNodeID(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	bl, err := New(db)
+	require.NoError(err)
+
+	nodeID := ids.GenerateTestNodeID()
+	require.False(bl.IsNodeIDBanned(nodeID))
+
+	require.NoError(bl.BanNodeID(nodeID, time.Time{}, "spam"))
+	require.True(bl.IsNodeIDBanned(nodeID))
+	require.Equal([]Ban{{NodeID: nodeID, Reason: "spam"}}, bl.Bans())
+
+	// bans are persisted
+	bl, err = New(db)
+	require.NoError(err)
+	require.True(bl.IsNodeIDBanned(nodeID))
+	require.Equal([]Ban{{NodeID: nodeID, Reason: "spam"}}, bl.Bans())
+
+	require.NoError(bl.UnbanNodeID(nodeID))
+	require.False(bl.IsNodeIDBanned(nodeID))
+	require.Empty(bl.Bans())
+
+	bl, err = New(db)
+	require.NoError(err)
+	require.False(bl.IsNodeIDBanned(nodeID))
+}
+
+func TestBanIP(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	bl, err := New(db)
+	require.NoError(err)
+
+	ip := net.IPv4(1, 2, 3, 4)
+	require.False(bl.IsIPBanned(ip))
+
+	require.NoError(bl.BanIP(ip, time.Time{}, ""))
+	// IPv4 and IPv4-in-IPv6 forms are equivalent
+	require.True(bl.IsIPBanned(ip.To4()))
+	require.True(bl.IsIPBanned(ip.To16()))
+	require.False(bl.IsIPBanned(net.IPv4(1, 2, 3, 5)))
+	require.False(bl.IsIPBanned(nil))
+
+	bl, err = New(db)
+	require.NoError(err)
+	require.True(bl.IsIPBanned(ip))
+	require.Len(bl.Bans(), 1)
+	require.True(ip.Equal(bl.Bans()[0].IP))
+
+	require.NoError(bl.UnbanIP(ip.To4()))
+	require.False(bl.IsIPBanned(ip))
+
+	require.ErrorIs(bl.BanIP(net.IP{1}, time.Time{}, ""), errInvalidIP)
+}
+
+func TestBanExpiry(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	blIntf, err := New(db)
+	require.NoError(err)
+	bl := blIntf.(*list)
+	now := time.Unix(1_000_000, 0)
+	bl.clock.Set(now)
+
+	nodeID := ids.GenerateTestNodeID()
+	ip := net.IPv4(1, 2, 3, 4)
+	require.NoError(bl.BanNodeID(nodeID, now.Add(time.Hour), ""))
+	require.NoError(bl.BanIP(ip, now.Add(2*time.Hour), ""))
+	require.True(bl.IsNodeIDBanned(nodeID))
+	require.True(bl.IsIPBanned(ip))
+
+	bl.clock.Set(now.Add(time.Hour))
+	require.False(bl.IsNodeIDBanned(nodeID))
+	require.True(bl.IsIPBanned(ip))
+	require.Len(bl.Bans(), 1)
+
+	// expired bans are removed from the database on the next change
+	require.NoError(bl.UnbanIP(net.IPv4(1, 2, 3, 5)))
+	has, err := bl.nodeIDDB.Has(nodeID[:])
+	require.NoError(err)
+	require.False(has)
+
+	bl.clock.Set(now.Add(2 * time.Hour))
+	require.False(bl.IsIPBanned(ip))
+	require.Empty(bl.Bans())
+}
+
+func TestBanReasonTooLong(t *testing.T) {
+	bl, err := New(memdb.New())
+	require.NoError(t, err)
+
+	err = bl.BanNodeID(ids.GenerateTestNodeID(), time.Time{}, strings.Repeat("a", maxReasonLen+1))
+	require.ErrorIs(t, err, errReasonTooLong)
+}
+
+func TestAllowlist(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	bl, err := New(db)
+	require.NoError(err)
+
+	nodeID := ids.GenerateTestNodeID()
+	require.False(bl.IsAllowed(nodeID))
+
+	require.NoError(bl.Allow(nodeID))
+	require.True(bl.IsAllowed(nodeID))
+	require.Equal([]ids.NodeID{nodeID}, bl.Allowed())
+
+	bl, err = New(db)
+	require.NoError(err)
+	require.True(bl.IsAllowed(nodeID))
+
+	require.NoError(bl.Disallow(nodeID))
+	require.False(bl.IsAllowed(nodeID))
+	require.Empty(bl.Allowed())
+
+	bl, err = New(db)
+	require.NoError(err)
+	require.False(bl.IsAllowed(nodeID))
+}
+
+func TestNoList(t *testing.T) {
+	require := require.New(t)
+
+	bl := NewNoList()
+	nodeID := ids.GenerateTestNodeID()
+	ip := net.IPv4(1, 2, 3, 4)
+
+	require.ErrorIs(bl.BanNodeID(nodeID, time.Time{}, ""), errBanlistDisabled)
+	require.ErrorIs(bl.BanIP(ip, time.Time{}, ""), errBanlistDisabled)
+	require.ErrorIs(bl.Allow(nodeID), errBanlistDisabled)
+	require.False(bl.IsNodeIDBanned(nodeID))
+	require.False(bl.IsIPBanned(ip))
+	require.False(bl.IsAllowed(nodeID))
+	require.Empty(bl.Bans())
+	require.Empty(bl.Allowed())
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package network
+
+import (
+	"net"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/peer"
+	"github.com/ava-labs/avalanchego/utils/ips"
+)
+
+// DisconnectBannedPeers starts closing the connections to all peers, which are
+// banned by node ID or by IP or, if the allowlist is enabled, aren't
+// allowlisted.
+func (n *network) DisconnectBannedPeers() {
+	n.peersLock.RLock()
+	peers := n.connectingPeers.Sample(n.connectingPeers.Len(), peer.NoPrecondition)
+	peers = append(peers, n.connectedPeers.Sample(n.connectedPeers.Len(), peer.NoPrecondition)...)
+	n.peersLock.RUnlock()
+
+	for _, p := range peers {
+		if !n.isPermitted(p.ID()) || n.config.Banlist.IsIPBanned(remoteIP(p.RemoteAddr().String())) {
+			n.disconnectBanned(p)
+		}
+	}
+}
+
+func (n *network) disconnectBanned(p peer.Peer) {
+	n.peerConfig.Log.Debug("disconnecting from peer",
+		zap.String("reason", "peer is banned"),
+		zap.Stringer("nodeID", p.ID()),
+	)
+	p.StartClose()
+}
+
+// OnNodeRegistered is a no-op, because registered nodes are connected to as
+// usual once their IPs are gossiped.
+func (*network) OnNodeRegistered(ids.NodeID, ids.ShortID) {}
+
+// OnNodeUnregistered disconnects from [nodeID], unless it is exempt from the
+// registration requirement.
+func (n *network) OnNodeUnregistered(nodeID ids.NodeID) {
+	if n.isExemptFromRegistration(nodeID) {
+		return
+	}
+
+	n.peersLock.RLock()
+	p, ok := n.connectingPeers.GetByID(nodeID)
+	if !ok {
+		p, ok = n.connectedPeers.GetByID(nodeID)
+	}
+	n.peersLock.RUnlock()
+
+	if ok {
+		n.peerConfig.Log.Debug("disconnecting from peer",
+			zap.String("reason", "node is no longer registered"),
+			zap.Stringer("nodeID", nodeID),
+		)
+		p.StartClose()
+	}
+}
+
+// isPermitted returns false if [nodeID] is banned, if the allowlist is
+// enabled and [nodeID] is neither allowlisted nor a beacon, or if registered
+// nodes are required and [nodeID] is neither registered nor exempt.
+func (n *network) isPermitted(nodeID ids.NodeID) bool {
+	if n.config.Banlist.IsNodeIDBanned(nodeID) {
+		return false
+	}
+	if n.config.AllowlistEnabled &&
+		!n.config.Banlist.IsAllowed(nodeID) &&
+		!n.config.Beacons.Contains(nodeID) {
+		return false
+	}
+	return !n.config.RequireRegisteredNodeToConnect ||
+		n.config.RegisteredNodes.Contains(nodeID) ||
+		n.isExemptFromRegistration(nodeID)
+}
+
+// isExemptFromRegistration returns true if [nodeID] can be connected to, even
+// if it isn't registered to a consortium member.
+func (n *network) isExemptFromRegistration(nodeID ids.NodeID) bool {
+	return n.config.Beacons.Contains(nodeID) ||
+		n.config.RegisteredNodeAllowlist.Contains(nodeID) ||
+		n.config.Banlist.IsAllowed(nodeID)
+}
+
+// remoteIP returns the IP of the remote address [addr]. Returns nil if [addr]
+// can't be parsed.
+func remoteIP(addr string) net.IP {
+	ip, err := ips.ToIPPort(addr)
+	if err != nil {
+		return nil
+	}
+	return ip.IP
+}
@@ -0,0 +1,195 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package network
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/banlist"
+	"github.com/ava-labs/avalanchego/network/peer"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+var _ peer.Peer = (*testBannedPeer)(nil)
+
+// testBannedPeer is a connected peer, which records whether it was closed
+type testBannedPeer struct {
+	peer.Peer
+	id         ids.NodeID
+	remoteAddr net.Addr
+	closed     bool
+}
+
+func (p *testBannedPeer) ID() ids.NodeID {
+	return p.id
+}
+
+func (p *testBannedPeer) RemoteAddr() net.Addr {
+	return p.remoteAddr
+}
+
+func (p *testBannedPeer) StartClose() {
+	p.closed = true
+}
+
+func TestIsPermitted(t *testing.T) {
+	beaconID := ids.GenerateTestNodeID()
+	registeredID := ids.GenerateTestNodeID()
+	allowedID := ids.GenerateTestNodeID()
+	configAllowedID := ids.GenerateTestNodeID()
+	bannedID := ids.GenerateTestNodeID()
+	otherID := ids.GenerateTestNodeID()
+
+	tests := map[string]struct {
+		allowlistEnabled               bool
+		requireRegisteredNodeToConnect bool
+		permitted                      set.Set[ids.NodeID]
+	}{
+		"default": {
+			permitted: set.Set[ids.NodeID]{
+				beaconID: {}, registeredID: {}, allowedID: {}, configAllowedID: {}, otherID: {},
+			},
+		},
+		"allowlist enabled": {
+			allowlistEnabled: true,
+			permitted: set.Set[ids.NodeID]{
+				beaconID: {}, allowedID: {},
+			},
+		},
+		"registered node required": {
+			requireRegisteredNodeToConnect: true,
+			permitted: set.Set[ids.NodeID]{
+				beaconID: {}, registeredID: {}, allowedID: {}, configAllowedID: {},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			bl, err := banlist.New(memdb.New())
+			require.NoError(err)
+			require.NoError(bl.Allow(allowedID))
+			require.NoError(bl.BanNodeID(bannedID, time.Time{}, ""))
+
+			beacons := validators.NewSet()
+			require.NoError(beacons.Add(beaconID, nil, ids.Empty, 1))
+
+			registeredNodes := validators.NewRegisteredNodes()
+			registeredNodes.Add(registeredID, ids.GenerateTestShortID())
+			// banned nodes are never permitted
+			registeredNodes.Add(bannedID, ids.GenerateTestShortID())
+
+			n := &network{config: &Config{
+				Beacons:                        beacons,
+				AllowlistEnabled:               tt.allowlistEnabled,
+				RequireRegisteredNodeToConnect: tt.requireRegisteredNodeToConnect,
+				RegisteredNodeAllowlist:        set.Set[ids.NodeID]{configAllowedID: {}},
+				Banlist:                        bl,
+				RegisteredNodes:                registeredNodes,
+			}}
+			for _, nodeID := range []ids.NodeID{beaconID, registeredID, allowedID, configAllowedID, bannedID, otherID} {
+				require.Equal(tt.permitted.Contains(nodeID), n.isPermitted(nodeID), nodeID)
+			}
+		})
+	}
+}
+
+func TestNewNetworkMissingRegisteredNodes(t *testing.T) {
+	vdrs := validators.NewManager()
+	vdrs.Add(constants.PrimaryNetworkID, validators.NewSet())
+
+	_, err := NewNetwork(
+		&Config{
+			Validators:                     vdrs,
+			RequireRegisteredNodeToConnect: true,
+		},
+		nil,
+		nil,
+		logging.NoLog{},
+		nil,
+		nil,
+		nil,
+	)
+	require.ErrorIs(t, err, errMissingRegisteredNodes)
+}
+
+func TestOnNodeUnregistered(t *testing.T) {
+	require := require.New(t)
+
+	bl, err := banlist.New(memdb.New())
+	require.NoError(err)
+
+	registeredNodes := validators.NewRegisteredNodes()
+	n := &network{
+		config: &Config{
+			Beacons:                        validators.NewSet(),
+			RequireRegisteredNodeToConnect: true,
+			Banlist:                        bl,
+			RegisteredNodes:                registeredNodes,
+		},
+		peerConfig:      &peer.Config{Log: logging.NoLog{}},
+		connectingPeers: peer.NewSet(),
+		connectedPeers:  peer.NewSet(),
+	}
+	registeredNodes.RegisterCallbackListener(n)
+
+	nodeID := ids.GenerateTestNodeID()
+	registeredNodes.Add(nodeID, ids.GenerateTestShortID())
+	require.True(n.isPermitted(nodeID))
+
+	// unregistering a node, which isn't connected, is handled gracefully
+	registeredNodes.Remove(nodeID)
+	require.False(n.isPermitted(nodeID))
+}
+
+func TestDisconnectBannedPeers(t *testing.T) {
+	require := require.New(t)
+
+	bl, err := banlist.New(memdb.New())
+	require.NoError(err)
+
+	newPeer := func(ip net.IP) *testBannedPeer {
+		return &testBannedPeer{
+			id:         ids.GenerateTestNodeID(),
+			remoteAddr: &net.TCPAddr{IP: ip, Port: 9651},
+		}
+	}
+	bannedIDPeer := newPeer(net.IPv4(1, 1, 1, 1))
+	connectingBannedIPPeer := newPeer(net.IPv4(2, 2, 2, 2))
+	connectedBannedIPPeer := newPeer(net.IPv4(3, 3, 3, 3))
+	otherPeer := newPeer(net.IPv4(4, 4, 4, 4))
+	require.NoError(bl.BanNodeID(bannedIDPeer.id, time.Time{}, ""))
+	require.NoError(bl.BanIP(connectingBannedIPPeer.remoteAddr.(*net.TCPAddr).IP, time.Time{}, ""))
+	require.NoError(bl.BanIP(connectedBannedIPPeer.remoteAddr.(*net.TCPAddr).IP, time.Time{}, ""))
+
+	n := &network{
+		config: &Config{
+			Beacons: validators.NewSet(),
+			Banlist: bl,
+		},
+		peerConfig:      &peer.Config{Log: logging.NoLog{}},
+		connectingPeers: peer.NewSet(),
+		connectedPeers:  peer.NewSet(),
+	}
+	n.connectingPeers.Add(bannedIDPeer)
+	n.connectingPeers.Add(connectingBannedIPPeer)
+	n.connectedPeers.Add(connectedBannedIPPeer)
+	n.connectedPeers.Add(otherPeer)
+
+	n.DisconnectBannedPeers()
+	require.True(bannedIDPeer.closed)
+	require.True(connectingBannedIPPeer.closed)
+	require.True(connectedBannedIPPeer.closed)
+	require.False(otherPeer.closed)
+}
@@ -9,12 +9,15 @@ import (
 	"time"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/banlist"
 	"github.com/ava-labs/avalanchego/network/dialer"
 	"github.com/ava-labs/avalanchego/network/peer"
 	"github.com/ava-labs/avalanchego/network/throttling"
+	"github.com/ava-labs/avalanchego/snow/networking/reputation"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
 	"github.com/ava-labs/avalanchego/snow/uptime"
 	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/compression"
 	"github.com/ava-labs/avalanchego/utils/ips"
 	"github.com/ava-labs/avalanchego/utils/set"
 )
@@ -119,9 +122,9 @@ type Config struct {
 	PingFrequency      time.Duration     `json:"pingFrequency"`
 	AllowPrivateIPs    bool              `json:"allowPrivateIPs"`
 
-	// CompressionEnabled will compress available outbound messages when set to
-	// true.
-	CompressionEnabled bool `json:"compressionEnabled"`
+	// CompressionType is the compression type of available outbound messages.
+	// Messages to peers, which don't support it, are gzip compressed.
+	CompressionType compression.Type `json:"compressionType"`
 
 	// TLSKey is this node's TLS key that is used to sign IPs.
 	TLSKey crypto.Signer `json:"-"`
@@ -149,6 +152,19 @@ type Config struct {
 	// the network negatively.
 	RequireValidatorToConnect bool `json:"requireValidatorToConnect"`
 
+	// If true, this node will only maintain connections with beacons and
+	// with nodes in the allowlist of [Banlist].
+	AllowlistEnabled bool `json:"allowlistEnabled"`
+
+	// If true, this node will only maintain connections with nodes, which are
+	// registered to consortium members, beacons, nodes in
+	// [RegisteredNodeAllowlist] and nodes in the allowlist of [Banlist].
+	RequireRegisteredNodeToConnect bool `json:"requireRegisteredNodeToConnect"`
+
+	// Nodes, which this node maintains connections with, even if they aren't
+	// registered to consortium members
+	RegisteredNodeAllowlist set.Set[ids.NodeID] `json:"registeredNodeAllowlist"`
+
 	// MaximumInboundMessageTimeout is the maximum deadline duration in a
 	// message. Messages sent by clients setting values higher than this value
 	// will be reset to this value.
@@ -165,6 +181,10 @@ type Config struct {
 	// Tracks the CPU/disk usage caused by processing messages of each peer.
 	ResourceTracker tracker.ResourceTracker `json:"-"`
 
+	// Tracks the reputation of each peer. Gossip targets are sampled
+	// weighted by their score.
+	ReputationTracker reputation.Tracker `json:"-"`
+
 	// Specifies how much CPU usage each peer can cause before
 	// we rate-limit them.
 	CPUTargeter tracker.Targeter `json:"-"`
@@ -175,4 +195,13 @@ type Config struct {
 
 	// Tracks which validators have been sent to which peers
 	GossipTracker peer.GossipTracker `json:"-"`
+
+	// Banned node IDs and IPs, to which this node will never maintain a
+	// connection, and the allowlist, which is used if [AllowlistEnabled]
+	Banlist banlist.List `json:"-"`
+
+	// Nodes registered to consortium members, kept up to date by the P-chain,
+	// which must be given the same set. Must not be nil if
+	// [RequireRegisteredNodeToConnect].
+	RegisteredNodes validators.RegisteredNodes `json:"-"`
 }
@@ -34,9 +34,11 @@ import (
 	"github.com/ava-labs/avalanchego/api/health"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/network/banlist"
 	"github.com/ava-labs/avalanchego/network/dialer"
 	"github.com/ava-labs/avalanchego/network/peer"
 	"github.com/ava-labs/avalanchego/network/throttling"
+	"github.com/ava-labs/avalanchego/snow/networking/reputation"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 	"github.com/ava-labs/avalanchego/snow/networking/sender"
 	"github.com/ava-labs/avalanchego/snow/validators"
@@ -57,16 +59,22 @@ const (
 	TimeSinceLastMsgReceivedKey = "timeSinceLastMsgReceived"
 	TimeSinceLastMsgSentKey     = "timeSinceLastMsgSent"
 	SendFailRateKey             = "sendFailRate"
+
+	// Weight of the peers with the worst reputation, when sampling peers to
+	// gossip to
+	minSamplingWeight = 0.01
 )
 
 var (
-	_ sender.ExternalSender = (*network)(nil)
-	_ Network               = (*network)(nil)
+	_ sender.ExternalSender                      = (*network)(nil)
+	_ Network                                    = (*network)(nil)
+	_ validators.RegisteredNodesCallbackListener = (*network)(nil)
 
 	errMissingPrimaryValidators = errors.New("missing primary validator set")
 	errNotValidator             = errors.New("node is not a validator")
 	errNotWhiteListed           = errors.New("subnet is not whitelisted")
 	errSubnetNotExist           = errors.New("subnet does not exist")
+	errMissingRegisteredNodes   = errors.New("missing registered nodes")
 )
 
 // Network defines the functionality of the networking library.
@@ -106,6 +114,11 @@ type Network interface {
 	// NodeUptime returns given node's [subnetID] UptimeResults in the view of
 	// this node's peer validators.
 	NodeUptime(subnetID ids.ID) (UptimeResult, error)
+
+	// DisconnectBannedPeers starts closing the connections to all peers,
+	// which are banned or, if the allowlist is enabled, aren't allowlisted.
+	// Should be called after the banlist was changed.
+	DisconnectBannedPeers()
 }
 
 type UptimeResult struct {
@@ -199,6 +212,16 @@ func NewNetwork(
 	if !ok {
 		return nil, errMissingPrimaryValidators
 	}
+	if config.RequireRegisteredNodeToConnect && config.RegisteredNodes == nil {
+		return nil, errMissingRegisteredNodes
+	}
+
+	if config.Banlist == nil {
+		config.Banlist = banlist.NewNoList()
+	}
+	if config.ReputationTracker == nil {
+		config.ReputationTracker = reputation.NewNoTracker()
+	}
 
 	inboundMsgThrottler, err := throttling.NewInboundMsgThrottler(
 		log,
@@ -253,10 +276,16 @@ func NewNetwork(
 		PongTimeout:          config.PingPongTimeout,
 		MaxClockDifference:   config.MaxClockDifference,
 		ResourceTracker:      config.ResourceTracker,
+		ReputationTracker:    config.ReputationTracker,
 		UptimeCalculator:     config.UptimeCalculator,
 		IPSigner:             peer.NewIPSigner(config.MyIPPort, config.TLSKey),
 	}
 
+	inboundConnUpgradeThrottler := throttling.NewBanningInboundConnUpgradeThrottler(
+		throttling.NewInboundConnUpgradeThrottler(log, config.ThrottlerConfig.InboundConnUpgradeThrottlerConfig),
+		config.Banlist,
+	)
+
 	onCloseCtx, cancel := context.WithCancel(context.Background())
 	n := &network{
 		config:               config,
@@ -264,7 +293,7 @@ func NewNetwork(
 		metrics:              metrics,
 		outboundMsgThrottler: outboundMsgThrottler,
 
-		inboundConnUpgradeThrottler: throttling.NewInboundConnUpgradeThrottler(log, config.ThrottlerConfig.InboundConnUpgradeThrottlerConfig),
+		inboundConnUpgradeThrottler: inboundConnUpgradeThrottler,
 		listener:                    listener,
 		dialer:                      dialer,
 		serverUpgrader:              peer.NewTLSServerUpgrader(config.TLSConfig),
@@ -287,6 +316,9 @@ func NewNetwork(
 		router:          router,
 	}
 	n.peerConfig.Network = n
+	if config.RequireRegisteredNodeToConnect {
+		config.RegisteredNodes.RegisterCallbackListener(n)
+	}
 	return n, nil
 }
 
@@ -433,8 +465,11 @@ func (n *network) Connected(nodeID ids.NodeID) {
 // AllowConnection returns true if this node should have a connection to the
 // provided nodeID. If the node is attempting to connect to the minimum number
 // of peers, then it should only connect if this node is a validator, or the
-// peer is a validator/beacon.
+// peer is a validator/beacon. Banned nodes are never allowed.
 func (n *network) AllowConnection(nodeID ids.NodeID) bool {
+	if !n.isPermitted(nodeID) {
+		return false
+	}
 	return !n.config.RequireValidatorToConnect ||
 		validators.Contains(n.config.Validators, constants.PrimaryNetworkID, n.config.MyNodeID) ||
 		n.WantsConnection(nodeID)
@@ -849,8 +884,13 @@ func (n *network) samplePeers(
 	n.peersLock.RLock()
 	defer n.peersLock.RUnlock()
 
-	return n.connectedPeers.Sample(
+	return n.connectedPeers.SampleWeighted(
 		numValidatorsToSample+numNonValidatorsToSample+numPeersToSample,
+		func(p peer.Peer) float64 {
+			// Peers with a bad reputation are sampled less often, but are
+			// never excluded entirely
+			return gomath.Max(n.config.ReputationTracker.Score(p.ID()), minSamplingWeight)
+		},
 		func(p peer.Peer) bool {
 			// Only return peers that are tracking [subnetID]
 			trackedSubnets := p.TrackedSubnets()
@@ -1027,7 +1067,7 @@ func (n *network) dial(ctx context.Context, nodeID ids.NodeID, ip *trackedIP) {
 			}
 
 			n.peersLock.Lock()
-			if !n.wantsConnection(nodeID) {
+			if !n.wantsConnection(nodeID) || !n.isPermitted(nodeID) || n.config.Banlist.IsIPBanned(ip.ip.IP) {
 				// Typically [n.trackedIPs[nodeID]] will already equal [ip], but
 				// the reference to [ip] is refreshed to avoid any potential
 				// race conditions before removing the entry.
@@ -1133,6 +1173,16 @@ func (n *network) upgrade(conn net.Conn, upgrader peer.Upgrader) error {
 		return nil
 	}
 
+	if n.config.Banlist.IsIPBanned(remoteIP(tlsConn.RemoteAddr().String())) {
+		_ = tlsConn.Close()
+		n.peerConfig.Log.Verbo(
+			"dropping connection",
+			zap.String("reason", "IP is banned"),
+			zap.Stringer("nodeID", nodeID),
+		)
+		return nil
+	}
+
 	if !n.AllowConnection(nodeID) {
 		_ = tlsConn.Close()
 		n.peerConfig.Log.Verbo(
@@ -0,0 +1,63 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package peer
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/utils/compression"
+)
+
+func TestSendZstd(t *testing.T) {
+	require := require.New(t)
+
+	peer0, peer1 := makeReadyTestPeers(t)
+
+	// both peers advertised zstd support in the handshake
+	require.True(peer0.Peer.(*peer).supportsZstd.GetValue())
+	require.True(peer1.Peer.(*peer).supportsZstd.GetValue())
+
+	mc, err := message.NewCreator(
+		prometheus.NewRegistry(),
+		"",
+		compression.TypeZstd,
+		10*time.Second,
+	)
+	require.NoError(err)
+
+	outboundMsg, err := mc.AppGossip(ids.Empty, bytes.Repeat([]byte{0}, 100))
+	require.NoError(err)
+	require.Equal(compression.TypeZstd, outboundMsg.CompressionType())
+
+	sent := peer0.Send(context.Background(), outboundMsg)
+	require.True(sent)
+
+	inboundMsg := <-peer1.inboundMsgChan
+	require.Equal(message.AppGossipOp, inboundMsg.Op())
+
+	// peers, which didn't advertise zstd support, are sent gzip compressed
+	// messages
+	peer0.Peer.(*peer).supportsZstd.SetValue(false)
+
+	sent = peer0.Send(context.Background(), outboundMsg)
+	require.True(sent)
+
+	inboundMsg = <-peer1.inboundMsgChan
+	require.Equal(message.AppGossipOp, inboundMsg.Op())
+
+	peer1.StartClose()
+	err = peer0.AwaitClosed(context.Background())
+	require.NoError(err)
+	err = peer1.AwaitClosed(context.Background())
+	require.NoError(err)
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package peer
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	weightedSampleLock sync.Mutex
+	// We don't use a cryptographically secure source of randomness here, as
+	// there's no need to ensure a truly random sampling.
+	weightedSampleRNG = rand.New(rand.NewSource(time.Now().UnixNano())) // #nosec G404
+)
+
+type weightedPeer struct {
+	peer Peer
+	key  float64
+}
+
+// SampleWeighted orders the peers by the key u^(1/weight), where u is uniform
+// in (0, 1), and returns the first [n] peers satisfying [precondition]. This
+// is weighted random sampling without replacement as described by Efraimidis
+// and Spirakis.
+func (s *peerSet) SampleWeighted(n int, weight func(Peer) float64, precondition func(Peer) bool) []Peer {
+	if n <= 0 {
+		return nil
+	}
+
+	weightedSampleLock.Lock()
+	defer weightedSampleLock.Unlock()
+
+	weightedPeers := make([]weightedPeer, 0, len(s.peersSlice))
+	for _, peer := range s.peersSlice {
+		w := weight(peer)
+		if w <= 0 {
+			continue
+		}
+		weightedPeers = append(weightedPeers, weightedPeer{
+			peer: peer,
+			// Comparing logarithms avoids the keys underflowing to 0. u is
+			// in (0, 1], as required.
+			key: math.Log(1-weightedSampleRNG.Float64()) / w,
+		})
+	}
+	sort.Slice(weightedPeers, func(i, j int) bool {
+		return weightedPeers[i].key > weightedPeers[j].key
+	})
+
+	peers := make([]Peer, 0, n)
+	for _, weightedPeer := range weightedPeers {
+		if len(peers) >= n {
+			break
+		}
+		if !precondition(weightedPeer.peer) {
+			continue
+		}
+		peers = append(peers, weightedPeer.peer)
+	}
+	return peers
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package peer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+func TestSetSampleWeighted(t *testing.T) {
+	require := require.New(t)
+
+	set := NewSet()
+
+	peer1 := &peer{
+		id: ids.NodeID{0x01},
+	}
+	peer2 := &peer{
+		id: ids.NodeID{0x02},
+	}
+	peer3 := &peer{
+		id: ids.NodeID{0x03},
+	}
+	weights := map[ids.NodeID]float64{
+		peer1.id: 1,
+		peer2.id: 0.01,
+		peer3.id: 0,
+	}
+	weight := func(p Peer) float64 {
+		return weights[p.ID()]
+	}
+
+	// sampling from an empty set returns no peers
+	require.Empty(set.SampleWeighted(1, weight, NoPrecondition))
+
+	set.Add(peer1)
+	set.Add(peer2)
+	set.Add(peer3)
+
+	// non-positive sample size returns no peers
+	require.Empty(set.SampleWeighted(0, weight, NoPrecondition))
+
+	// peers with zero weight are never sampled
+	peers := set.SampleWeighted(3, weight, NoPrecondition)
+	require.Len(peers, 2)
+	require.NotContains(peers, peer3)
+
+	// the precondition is respected
+	peers = set.SampleWeighted(2, weight, func(p Peer) bool {
+		return p.ID() != peer1.id
+	})
+	require.Equal([]Peer{peer2}, peers)
+
+	// peers with a higher weight are sampled more often
+	numPeer1Sampled := 0
+	for i := 0; i < 1000; i++ {
+		peers := set.SampleWeighted(1, weight, NoPrecondition)
+		require.Len(peers, 1)
+		if peers[0] == peer1 {
+			numPeer1Sampled++
+		}
+	}
+	require.Greater(numPeer1Sampled, 900)
+}
@@ -20,4 +20,5 @@ type Info struct {
 	ObservedUptime        json.Uint32            `json:"observedUptime"`
 	ObservedSubnetUptimes map[ids.ID]json.Uint32 `json:"observedSubnetUptimes"`
 	TrackedSubnets        []ids.ID               `json:"trackedSubnets"`
+	Score                 json.Float64           `json:"score"`
 }
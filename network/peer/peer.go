@@ -20,6 +20,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/compression"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/ips"
 	"github.com/ava-labs/avalanchego/utils/json"
@@ -46,6 +47,10 @@ type Peer interface {
 	// authenticate their messages.
 	Cert() *x509.Certificate
 
+	// RemoteAddr returns the address of the remote end of the connection to
+	// the peer. It can be called before [Ready] returns true.
+	RemoteAddr() net.Addr
+
 	// LastSent returns the last time a message was sent to the peer.
 	LastSent() time.Time
 
@@ -129,6 +134,10 @@ type peer struct {
 	// trackedSubnets is the subset of subnetIDs the peer sent us in the Version
 	// message that we are also tracking.
 	trackedSubnets set.Set[ids.ID]
+	// True if the peer advertised zstd support in the Version message.
+	// Otherwise, zstd compressed messages are sent gzip compressed.
+	// Only modified on the connection's reader routine.
+	supportsZstd utils.AtomicBool
 
 	observedUptimesLock sync.RWMutex
 	// [observedUptimesLock] must be held while accessing [observedUptime]
@@ -212,6 +221,10 @@ func (p *peer) Cert() *x509.Certificate {
 	return p.cert
 }
 
+func (p *peer) RemoteAddr() net.Addr {
+	return p.conn.RemoteAddr()
+}
+
 func (p *peer) LastSent() time.Time {
 	return time.Unix(
 		atomic.LoadInt64(&p.lastSent),
@@ -273,6 +286,7 @@ func (p *peer) Info() Info {
 		ObservedUptime:        json.Uint32(primaryUptime),
 		ObservedSubnetUptimes: uptimes,
 		TrackedSubnets:        trackedSubnets,
+		Score:                 json.Float64(p.ReputationTracker.Score(p.id)),
 	}
 }
 
@@ -446,6 +460,7 @@ func (p *peer) readMessages() {
 		// [p.CPUTracker.StopProcessing] must be called when this loop iteration is
 		// finished.
 		p.ResourceTracker.StartProcessing(p.id, p.Clock.Time())
+		p.ReputationTracker.RegisterBandwidth(p.id, int(msgLen))
 
 		p.Log.Verbo("parsing message",
 			zap.Stringer("nodeID", p.id),
@@ -462,6 +477,7 @@ func (p *peer) readMessages() {
 			)
 
 			p.Metrics.FailedToParse.Inc()
+			p.ReputationTracker.RegisterInvalidMessage(p.id)
 
 			// Couldn't parse the message. Read the next one.
 			onFinishedHandling()
@@ -546,6 +562,18 @@ func (p *peer) writeMessages() {
 
 func (p *peer) writeMessage(writer io.Writer, msg message.OutboundMessage) {
 	msgBytes := msg.Bytes()
+	if msg.CompressionType() == compression.TypeZstd && !p.supportsZstd.GetValue() {
+		var err error
+		msgBytes, err = msg.GzipBytes()
+		if err != nil {
+			p.Log.Error("failed to compress message",
+				zap.Stringer("nodeID", p.id),
+				zap.Stringer("messageOp", msg.Op()),
+				zap.Error(err),
+			)
+			return
+		}
+	}
 	p.Log.Verbo("sending message",
 		zap.Stringer("nodeID", p.id),
 		zap.Binary("messageBytes", msgBytes),
@@ -717,6 +745,8 @@ func (p *peer) handlePing(_ *p2ppb.Ping) {
 			zap.Error(err),
 		)
 		primaryUptime = 0
+	} else {
+		p.ReputationTracker.RegisterUptime(p.id, primaryUptime)
 	}
 
 	subnetUptimes := make([]*p2ppb.SubnetUptime, 0, p.trackedSubnets.Len())
@@ -752,6 +782,7 @@ func (p *peer) handlePing(_ *p2ppb.Ping) {
 
 func (p *peer) handlePong(msg *p2ppb.Pong) {
 	if msg.Uptime > 100 {
+		p.ReputationTracker.RegisterInvalidMessage(p.id)
 		p.Log.Debug("dropping pong message with invalid uptime",
 			zap.Stringer("nodeID", p.id),
 			zap.Uint32("uptime", msg.Uptime),
@@ -764,6 +795,7 @@ func (p *peer) handlePong(msg *p2ppb.Pong) {
 	for _, subnetUptime := range msg.SubnetUptimes {
 		subnetID, err := ids.ToID(subnetUptime.SubnetId)
 		if err != nil {
+			p.ReputationTracker.RegisterInvalidMessage(p.id)
 			p.Log.Debug("dropping pong message with invalid subnetID",
 				zap.Stringer("nodeID", p.id),
 				zap.Error(err),
@@ -774,6 +806,7 @@ func (p *peer) handlePong(msg *p2ppb.Pong) {
 
 		uptime := subnetUptime.Uptime
 		if uptime > 100 {
+			p.ReputationTracker.RegisterInvalidMessage(p.id)
 			p.Log.Debug("dropping pong message with invalid uptime",
 				zap.Stringer("nodeID", p.id),
 				zap.Stringer("subnetID", subnetID),
@@ -896,6 +929,13 @@ func (p *peer) handleVersion(msg *p2ppb.Version) {
 		}
 	}
 
+	// older peers don't advertise supported compression types
+	for _, compressionType := range msg.SupportedCompression {
+		if compression.Type(compressionType) == compression.TypeZstd {
+			p.supportsZstd.SetValue(true)
+		}
+	}
+
 	// "net.IP" type in Golang is 16-byte
 	if ipLen := len(msg.IpAddr); ipLen != net.IPv6len {
 		p.Log.Debug("message with invalid field",
@@ -43,6 +43,13 @@ type Set interface {
 	// [precondition] to return true will be returned in the slice.
 	Sample(n int, precondition func(Peer) bool) []Peer
 
+	// SampleWeighted attempts to return a random slice of peers with length
+	// [n], where the probability of a peer being sampled is proportional to
+	// its [weight]. Peers with a non-positive weight are never sampled. Only
+	// peers that cause the [precondition] to return true will be returned in
+	// the slice.
+	SampleWeighted(n int, weight func(Peer) float64, precondition func(Peer) bool) []Peer
+
 	// Returns information about all the peers.
 	AllInfo() []Info
 
@@ -0,0 +1,37 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package throttling
+
+import (
+	"github.com/ava-labs/avalanchego/network/banlist"
+	"github.com/ava-labs/avalanchego/utils/ips"
+)
+
+var _ InboundConnUpgradeThrottler = (*banningInboundConnUpgradeThrottler)(nil)
+
+// banningInboundConnUpgradeThrottler never upgrades inbound connections from
+// banned IPs. Other connections are throttled by the wrapped throttler.
+type banningInboundConnUpgradeThrottler struct {
+	InboundConnUpgradeThrottler
+	banlist banlist.List
+}
+
+// NewBanningInboundConnUpgradeThrottler returns an InboundConnUpgradeThrottler,
+// which doesn't upgrade inbound connections from IPs banned in [banlist] and
+// otherwise behaves like [throttler].
+func NewBanningInboundConnUpgradeThrottler(
+	throttler InboundConnUpgradeThrottler,
+	banlist banlist.List,
+) InboundConnUpgradeThrottler {
+	return &banningInboundConnUpgradeThrottler{
+		InboundConnUpgradeThrottler: throttler,
+		banlist:                     banlist,
+	}
+}
+
+func (t *banningInboundConnUpgradeThrottler) ShouldUpgrade(ip ips.IPPort) bool {
+	// Banned IPs are checked first, so they don't use up the rate limit of
+	// the wrapped throttler
+	return !t.banlist.IsIPBanned(ip.IP) && t.InboundConnUpgradeThrottler.ShouldUpgrade(ip)
+}
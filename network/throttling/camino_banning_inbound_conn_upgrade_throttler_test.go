@@ -0,0 +1,34 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package throttling
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/network/banlist"
+	"github.com/ava-labs/avalanchego/utils/ips"
+)
+
+func TestBanningInboundConnUpgradeThrottler(t *testing.T) {
+	require := require.New(t)
+
+	bl, err := banlist.New(memdb.New())
+	require.NoError(err)
+
+	bannedIP := ips.IPPort{IP: net.IPv4(1, 2, 3, 4), Port: 9651}
+	otherIP := ips.IPPort{IP: net.IPv4(1, 2, 3, 5), Port: 9651}
+	require.NoError(bl.BanIP(bannedIP.IP, time.Time{}, ""))
+
+	throttler := NewBanningInboundConnUpgradeThrottler(&noInboundConnUpgradeThrottler{}, bl)
+	require.False(throttler.ShouldUpgrade(bannedIP))
+	require.True(throttler.ShouldUpgrade(otherIP))
+
+	require.NoError(bl.UnbanIP(bannedIP.IP))
+	require.True(throttler.ShouldUpgrade(bannedIP))
+}
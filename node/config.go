@@ -10,10 +10,12 @@ import (
 	"github.com/ava-labs/avalanchego/chains"
 	"github.com/ava-labs/avalanchego/genesis"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer"
 	"github.com/ava-labs/avalanchego/nat"
 	"github.com/ava-labs/avalanchego/network"
 	"github.com/ava-labs/avalanchego/snow/consensus/avalanche"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
+	"github.com/ava-labs/avalanchego/snow/networking/reputation"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 	"github.com/ava-labs/avalanchego/snow/networking/sender"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
@@ -40,8 +42,11 @@ type APIAuthConfig struct {
 }
 
 type APIIndexerConfig struct {
-	IndexAPIEnabled      bool `json:"indexAPIEnabled"`
-	IndexAllowIncomplete bool `json:"indexAllowIncomplete"`
+	IndexAPIEnabled      bool                           `json:"indexAPIEnabled"`
+	IndexAllowIncomplete bool                           `json:"indexAllowIncomplete"`
+	IndexChains          []string                       `json:"indexChains"`
+	IndexExcludedChains  []string                       `json:"indexExcludedChains"`
+	IndexChainConfigs    map[string]indexer.ChainConfig `json:"indexChainConfigs"`
 }
 
 type HTTPConfig struct {
@@ -132,6 +137,18 @@ type DatabaseConfig struct {
 
 	// Path to config file
 	Config []byte `json:"-"`
+
+	// Path to snapshot archive, that should be restored into the empty
+	// database directory before the database is opened
+	RestoreArchive string `json:"restoreArchive"`
+
+	// Key, that the database is encrypted at rest with. If nil, the database
+	// isn't encrypted.
+	EncryptionKey []byte `json:"-"`
+
+	// Key, that the database was previously encrypted with. If not nil, the
+	// database is re-encrypted with [EncryptionKey] before it's used.
+	PreviousEncryptionKey []byte `json:"-"`
 }
 
 // Config contains all of the configurations of an Avalanche node.
@@ -164,6 +181,9 @@ type Config struct {
 	// Benchlist Configuration
 	BenchlistConfig benchlist.Config `json:"benchlistConfig"`
 
+	// Reputation Configuration
+	ReputationConfig reputation.Config `json:"reputationConfig"`
+
 	// Profiling configurations
 	ProfilerConfig profiler.Config `json:"profilerConfig"`
 
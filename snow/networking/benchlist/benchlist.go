@@ -15,6 +15,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/networking/reputation"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/set"
@@ -136,6 +137,11 @@ type benchlist struct {
 	// The maximum percentage of total network stake that may be benched
 	// Must be in [0,1)
 	maxPortion float64
+
+	// A validator will be benched if its reputation score is below
+	// [minReputationScore]. [reputationTracker] may be nil.
+	reputationTracker  reputation.Tracker
+	minReputationScore float64
 }
 
 // NewBenchlist returns a new Benchlist
@@ -255,8 +261,10 @@ func (b *benchlist) isBenched(nodeID ids.NodeID) bool {
 // RegisterResponse notes that we received a response from validator [validatorID]
 func (b *benchlist) RegisterResponse(nodeID ids.NodeID) {
 	b.streaklock.Lock()
-	defer b.streaklock.Unlock()
 	delete(b.failureStreaks, nodeID)
+	b.streaklock.Unlock()
+
+	b.benchBadReputation(nodeID)
 }
 
 // RegisterResponse notes that a request to validator [validatorID] timed out
@@ -282,7 +290,8 @@ func (b *benchlist) RegisterFailure(nodeID ids.NodeID) {
 	b.failureStreaks[nodeID] = failureStreak
 	b.streaklock.Unlock()
 
-	if failureStreak.consecutive >= b.threshold && now.After(failureStreak.firstFailure.Add(b.minimumFailingDuration)) {
+	if failureStreak.consecutive >= b.threshold && now.After(failureStreak.firstFailure.Add(b.minimumFailingDuration)) ||
+		b.hasBadReputation(nodeID) {
 		b.bench(nodeID)
 	}
 }
@@ -0,0 +1,79 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package benchlist
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/networking/reputation"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+// NewCaminoBenchlist returns a new Benchlist, which also benches validators,
+// whose reputation score in [reputationTracker] is below [minReputationScore],
+// as soon as a query to them succeeds or fails. [reputationTracker] may be
+// nil, then only failure streaks are taken into account.
+func NewCaminoBenchlist(
+	chainID ids.ID,
+	log logging.Logger,
+	benchable Benchable,
+	validators validators.Set,
+	threshold int,
+	minimumFailingDuration,
+	duration time.Duration,
+	maxPortion float64,
+	reputationTracker reputation.Tracker,
+	minReputationScore float64,
+	registerer prometheus.Registerer,
+) (Benchlist, error) {
+	benchlistIntf, err := NewBenchlist(
+		chainID,
+		log,
+		benchable,
+		validators,
+		threshold,
+		minimumFailingDuration,
+		duration,
+		maxPortion,
+		registerer,
+	)
+	if err != nil {
+		return nil, err
+	}
+	benchlist := benchlistIntf.(*benchlist)
+	benchlist.reputationTracker = reputationTracker
+	benchlist.minReputationScore = minReputationScore
+	return benchlist, nil
+}
+
+// hasBadReputation returns true if reputation score of [nodeID] is below
+// [b.minReputationScore]
+func (b *benchlist) hasBadReputation(nodeID ids.NodeID) bool {
+	return b.reputationTracker != nil && b.reputationTracker.Score(nodeID) < b.minReputationScore
+}
+
+// benchBadReputation benches [nodeID], if it has bad reputation and isn't
+// benched yet
+func (b *benchlist) benchBadReputation(nodeID ids.NodeID) {
+	if !b.hasBadReputation(nodeID) {
+		return
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.benchlistSet.Contains(nodeID) {
+		return
+	}
+	b.log.Debug("benching validator with bad reputation",
+		zap.Stringer("nodeID", nodeID),
+	)
+	b.bench(nodeID)
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package benchlist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/networking/reputation"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+// Test that validators with bad reputation are benched without failure streak
+func TestBenchlistBadReputation(t *testing.T) {
+	require := require.New(t)
+
+	vdrs := validators.NewSet()
+	vdrID0 := ids.GenerateTestNodeID()
+	vdrID1 := ids.GenerateTestNodeID()
+	vdrID2 := ids.GenerateTestNodeID()
+	require.NoError(vdrs.Add(vdrID0, nil, ids.Empty, 50))
+	require.NoError(vdrs.Add(vdrID1, nil, ids.Empty, 50))
+	require.NoError(vdrs.Add(vdrID2, nil, ids.Empty, 50))
+
+	reputationTracker, err := reputation.NewTracker(reputation.Config{
+		Halflife:             time.Hour,
+		MaxLatency:           time.Second,
+		MaxBandwidth:         1,
+		InvalidMessageWeight: 1,
+	})
+	require.NoError(err)
+	for i := 0; i < 100; i++ {
+		reputationTracker.RegisterInvalidMessage(vdrID0)
+		reputationTracker.RegisterInvalidMessage(vdrID1)
+	}
+
+	benched := set.Set[ids.NodeID]{}
+	benchable := &TestBenchable{T: t}
+	benchable.Default(true)
+	benchable.BenchedF = func(_ ids.ID, nodeID ids.NodeID) {
+		benched.Add(nodeID)
+	}
+
+	benchIntf, err := NewCaminoBenchlist(
+		ids.Empty,
+		logging.NoLog{},
+		benchable,
+		vdrs,
+		10,
+		minimumFailingDuration,
+		time.Minute,
+		0.7,
+		reputationTracker,
+		0.5,
+		prometheus.NewRegistry(),
+	)
+	require.NoError(err)
+	b := benchIntf.(*benchlist)
+	defer b.timer.Stop()
+
+	// validators with bad reputation are benched on the first failed or
+	// successful query
+	b.RegisterFailure(vdrID0)
+	require.True(b.IsBenched(vdrID0))
+	b.RegisterResponse(vdrID1)
+	require.True(b.IsBenched(vdrID1))
+
+	// validators with good reputation are benched only after failure streak
+	b.RegisterFailure(vdrID2)
+	require.False(b.IsBenched(vdrID2))
+
+	require.Equal(set.Set[ids.NodeID]{vdrID0: struct{}{}, vdrID1: struct{}{}}, benched)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/networking/reputation"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/utils/constants"
 )
@@ -52,6 +53,12 @@ type Config struct {
 	MinimumFailingDuration time.Duration      `json:"minimumFailingDuration"`
 	Duration               time.Duration      `json:"duration"`
 	MaxPortion             float64            `json:"maxPortion"`
+	// Validators with reputation score below [MinReputationScore] are
+	// benched, so queries to them fail immediately. Queries are still
+	// sampled by stake, as consensus safety relies on stake-weighted
+	// sampling.
+	ReputationTracker  reputation.Tracker `json:"-"`
+	MinReputationScore float64            `json:"minReputationScore"`
 }
 
 type manager struct {
@@ -129,7 +136,7 @@ func (m *manager) RegisterChain(ctx *snow.ConsensusContext) error {
 		return errUnknownValidators
 	}
 
-	benchlist, err := NewBenchlist(
+	benchlist, err := NewCaminoBenchlist(
 		ctx.ChainID,
 		ctx.Log,
 		m.config.Benchable,
@@ -138,6 +145,8 @@ func (m *manager) RegisterChain(ctx *snow.ConsensusContext) error {
 		m.config.MinimumFailingDuration,
 		m.config.Duration,
 		m.config.MaxPortion,
+		m.config.ReputationTracker,
+		m.config.MinReputationScore,
 		ctx.Registerer,
 	)
 	if err != nil {
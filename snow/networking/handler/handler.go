@@ -21,6 +21,7 @@ import (
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/networking/reputation"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
 	"github.com/ava-labs/avalanchego/snow/networking/worker"
 	"github.com/ava-labs/avalanchego/snow/validators"
@@ -88,6 +89,8 @@ type handler struct {
 
 	// Tracks cpu/disk usage caused by each peer.
 	resourceTracker tracker.ResourceTracker
+	// Tracks invalid messages sent by each peer.
+	reputationTracker reputation.Tracker
 
 	// Holds messages that [engine] hasn't processed yet.
 	// [unprocessedMsgsCond.L] must be held while accessing [syncMessageQueue].
@@ -117,20 +120,22 @@ func New(
 	preemptTimeouts chan struct{},
 	gossipFrequency time.Duration,
 	resourceTracker tracker.ResourceTracker,
+	reputationTracker reputation.Tracker,
 	subnetConnector validators.SubnetConnector,
 ) (Handler, error) {
 	h := &handler{
-		ctx:              ctx,
-		validators:       validators,
-		msgFromVMChan:    msgFromVMChan,
-		preemptTimeouts:  preemptTimeouts,
-		gossipFrequency:  gossipFrequency,
-		asyncMessagePool: worker.NewPool(threadPoolSize),
-		timeouts:         make(chan struct{}, 1),
-		closingChan:      make(chan struct{}),
-		closed:           make(chan struct{}),
-		resourceTracker:  resourceTracker,
-		subnetConnector:  subnetConnector,
+		ctx:               ctx,
+		validators:        validators,
+		msgFromVMChan:     msgFromVMChan,
+		preemptTimeouts:   preemptTimeouts,
+		gossipFrequency:   gossipFrequency,
+		asyncMessagePool:  worker.NewPool(threadPoolSize),
+		timeouts:          make(chan struct{}, 1),
+		closingChan:       make(chan struct{}),
+		closed:            make(chan struct{}),
+		resourceTracker:   resourceTracker,
+		reputationTracker: reputationTracker,
+		subnetConnector:   subnetConnector,
 	}
 
 	var err error
@@ -476,6 +481,7 @@ func (h *handler) handleSyncMsg(ctx context.Context, msg message.InboundMessage)
 		// TODO: Enforce that the numbers are sorted to make this verification
 		//       more efficient.
 		if !utils.IsUnique(msg.Heights) {
+			h.reputationTracker.RegisterInvalidMessage(nodeID)
 			h.ctx.Log.Debug("message with invalid field",
 				zap.Stringer("nodeID", nodeID),
 				zap.Stringer("messageOp", message.GetAcceptedStateSummaryOp),
@@ -496,6 +502,7 @@ func (h *handler) handleSyncMsg(ctx context.Context, msg message.InboundMessage)
 	case *p2ppb.AcceptedStateSummary:
 		summaryIDs, err := getIDs(msg.SummaryIds)
 		if err != nil {
+			h.reputationTracker.RegisterInvalidMessage(nodeID)
 			h.ctx.Log.Debug("message with invalid field",
 				zap.Stringer("nodeID", nodeID),
 				zap.Stringer("messageOp", message.AcceptedStateSummaryOp),
@@ -517,6 +524,7 @@ func (h *handler) handleSyncMsg(ctx context.Context, msg message.InboundMessage)
 	case *p2ppb.AcceptedFrontier:
 		containerIDs, err := getIDs(msg.ContainerIds)
 		if err != nil {
+			h.reputationTracker.RegisterInvalidMessage(nodeID)
 			h.ctx.Log.Debug("message with invalid field",
 				zap.Stringer("nodeID", nodeID),
 				zap.Stringer("messageOp", message.AcceptedFrontierOp),
@@ -535,6 +543,7 @@ func (h *handler) handleSyncMsg(ctx context.Context, msg message.InboundMessage)
 	case *p2ppb.GetAccepted:
 		containerIDs, err := getIDs(msg.ContainerIds)
 		if err != nil {
+			h.reputationTracker.RegisterInvalidMessage(nodeID)
 			h.ctx.Log.Debug("message with invalid field",
 				zap.Stringer("nodeID", nodeID),
 				zap.Stringer("messageOp", message.GetAcceptedOp),
@@ -550,6 +559,7 @@ func (h *handler) handleSyncMsg(ctx context.Context, msg message.InboundMessage)
 	case *p2ppb.Accepted:
 		containerIDs, err := getIDs(msg.ContainerIds)
 		if err != nil {
+			h.reputationTracker.RegisterInvalidMessage(nodeID)
 			h.ctx.Log.Debug("message with invalid field",
 				zap.Stringer("nodeID", nodeID),
 				zap.Stringer("messageOp", message.AcceptedOp),
@@ -568,6 +578,7 @@ func (h *handler) handleSyncMsg(ctx context.Context, msg message.InboundMessage)
 	case *p2ppb.GetAncestors:
 		containerID, err := ids.ToID(msg.ContainerId)
 		if err != nil {
+			h.reputationTracker.RegisterInvalidMessage(nodeID)
 			h.ctx.Log.Debug("dropping message with invalid field",
 				zap.Stringer("nodeID", nodeID),
 				zap.Stringer("messageOp", message.GetAncestorsOp),
@@ -589,6 +600,7 @@ func (h *handler) handleSyncMsg(ctx context.Context, msg message.InboundMessage)
 	case *p2ppb.Get:
 		containerID, err := ids.ToID(msg.ContainerId)
 		if err != nil {
+			h.reputationTracker.RegisterInvalidMessage(nodeID)
 			h.ctx.Log.Debug("dropping message with invalid field",
 				zap.Stringer("nodeID", nodeID),
 				zap.Stringer("messageOp", message.GetOp),
@@ -613,6 +625,7 @@ func (h *handler) handleSyncMsg(ctx context.Context, msg message.InboundMessage)
 	case *p2ppb.PullQuery:
 		containerID, err := ids.ToID(msg.ContainerId)
 		if err != nil {
+			h.reputationTracker.RegisterInvalidMessage(nodeID)
 			h.ctx.Log.Debug("dropping message with invalid field",
 				zap.Stringer("nodeID", nodeID),
 				zap.Stringer("messageOp", message.PullQueryOp),
@@ -628,6 +641,7 @@ func (h *handler) handleSyncMsg(ctx context.Context, msg message.InboundMessage)
 	case *p2ppb.Chits:
 		votes, err := getIDs(msg.ContainerIds)
 		if err != nil {
+			h.reputationTracker.RegisterInvalidMessage(nodeID)
 			h.ctx.Log.Debug("message with invalid field",
 				zap.Stringer("nodeID", nodeID),
 				zap.Stringer("messageOp", message.ChitsOp),
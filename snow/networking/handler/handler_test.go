@@ -20,6 +20,7 @@ import (
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/networking/reputation"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/utils/math/meter"
@@ -50,6 +51,7 @@ func TestHandlerDropsTimedOutMessages(t *testing.T) {
 		nil,
 		time.Second,
 		resourceTracker,
+		reputation.NewNoTracker(),
 		validators.UnhandledSubnetConnector,
 	)
 	require.NoError(t, err)
@@ -131,6 +133,7 @@ func TestHandlerClosesOnError(t *testing.T) {
 		nil,
 		time.Second,
 		resourceTracker,
+		reputation.NewNoTracker(),
 		validators.UnhandledSubnetConnector,
 	)
 	require.NoError(t, err)
@@ -210,6 +213,7 @@ func TestHandlerDropsGossipDuringBootstrapping(t *testing.T) {
 		nil,
 		1,
 		resourceTracker,
+		reputation.NewNoTracker(),
 		validators.UnhandledSubnetConnector,
 	)
 	require.NoError(t, err)
@@ -279,6 +283,7 @@ func TestHandlerDispatchInternal(t *testing.T) {
 		nil,
 		time.Second,
 		resourceTracker,
+		reputation.NewNoTracker(),
 		validators.UnhandledSubnetConnector,
 	)
 	require.NoError(t, err)
@@ -347,6 +352,7 @@ func TestHandlerSubnetConnector(t *testing.T) {
 		nil,
 		time.Second,
 		resourceTracker,
+		reputation.NewNoTracker(),
 		connector,
 	)
 	require.NoError(t, err)
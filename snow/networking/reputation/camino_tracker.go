@@ -0,0 +1,298 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package reputation
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+
+	safemath "github.com/ava-labs/avalanchego/utils/math"
+)
+
+const (
+	// Score of peers, about which nothing bad is known
+	MaxScore = 1
+
+	// Peers, whose reputation wasn't updated for [pruneHalflives] halflives,
+	// are forgotten
+	pruneHalflives = 10
+)
+
+var (
+	_ Tracker = (*tracker)(nil)
+	_ Tracker = (*noTracker)(nil)
+
+	errNonPositiveHalflife     = errors.New("halflife must be > 0")
+	errNonPositiveMaxLatency   = errors.New("max latency must be > 0")
+	errNonPositiveMaxBandwidth = errors.New("max bandwidth must be > 0")
+	errNegativeWeight          = errors.New("weights must be >= 0")
+	errZeroWeights             = errors.New("at least one weight must be > 0")
+)
+
+// Tracker tracks the reputation of peers. The reputation is a decaying score
+// in [0, MaxScore], which combines the response latency, the number of invalid
+// messages, the bandwidth usage and the uptime of the peer. Recent
+// observations have more impact on the score than older ones.
+//
+// Scores weight the sampling of peers to gossip to, and validators with low
+// scores are benched. Consensus queries are still sampled by stake, as
+// sampling them by locally observed scores would weaken the stake-weighted
+// sampling, that consensus safety relies on.
+type Tracker interface {
+	// RegisterResponse registers that [nodeID] responded to our request
+	// after [latency]
+	RegisterResponse(nodeID ids.NodeID, latency time.Duration)
+	// RegisterTimeout registers that [nodeID] didn't respond to our request
+	// in time
+	RegisterTimeout(nodeID ids.NodeID)
+	// RegisterInvalidMessage registers that [nodeID] sent us an invalid
+	// message
+	RegisterInvalidMessage(nodeID ids.NodeID)
+	// RegisterBandwidth registers that [nodeID] sent us [numBytes] bytes
+	RegisterBandwidth(nodeID ids.NodeID, numBytes int)
+	// RegisterUptime registers our observation of the uptime of [nodeID],
+	// which is in [0, 1]
+	RegisterUptime(nodeID ids.NodeID, uptime float64)
+	// Score returns the current score of [nodeID]. Peers, about which
+	// nothing is known, have [MaxScore].
+	Score(nodeID ids.NodeID) float64
+}
+
+// Config defines the configuration for the reputation tracker
+type Config struct {
+	// Halflife of the impact of observations on the score
+	Halflife time.Duration `json:"halflife"`
+	// Average response latency, at which the latency score is 0.
+	// Timeouts are observed as this latency.
+	MaxLatency time.Duration `json:"maxLatency"`
+	// Average bandwidth usage in bytes per second, at which the bandwidth
+	// score is 0
+	MaxBandwidth float64 `json:"maxBandwidth"`
+
+	// Weights of the partial scores in the score
+	LatencyWeight        float64 `json:"latencyWeight"`
+	InvalidMessageWeight float64 `json:"invalidMessageWeight"`
+	BandwidthWeight      float64 `json:"bandwidthWeight"`
+	UptimeWeight         float64 `json:"uptimeWeight"`
+}
+
+// Verify returns nil if the config is valid
+func (c *Config) Verify() error {
+	switch {
+	case c.Halflife <= 0:
+		return errNonPositiveHalflife
+	case c.MaxLatency <= 0:
+		return errNonPositiveMaxLatency
+	case c.MaxBandwidth <= 0:
+		return errNonPositiveMaxBandwidth
+	case c.LatencyWeight < 0 || c.InvalidMessageWeight < 0 || c.BandwidthWeight < 0 || c.UptimeWeight < 0:
+		return errNegativeWeight
+	case c.LatencyWeight+c.InvalidMessageWeight+c.BandwidthWeight+c.UptimeWeight == 0:
+		return errZeroWeights
+	}
+	return nil
+}
+
+type tracker struct {
+	config Config
+	// Useful for faking time in tests
+	clock mockable.Clock
+
+	lock      sync.Mutex
+	peers     map[ids.NodeID]*peerReputation
+	lastPrune time.Time
+}
+
+// NewTracker returns a new reputation tracker
+func NewTracker(config Config) (Tracker, error) {
+	if err := config.Verify(); err != nil {
+		return nil, fmt.Errorf("invalid reputation config: %w", err)
+	}
+	return &tracker{
+		config: config,
+		peers:  make(map[ids.NodeID]*peerReputation),
+	}, nil
+}
+
+func (t *tracker) RegisterResponse(nodeID ids.NodeID, latency time.Duration) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := t.clock.Time()
+	p := t.getOrCreate(nodeID, now)
+	p.latency.Observe(float64(latency), now)
+	p.hasLatency = true
+}
+
+func (t *tracker) RegisterTimeout(nodeID ids.NodeID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := t.clock.Time()
+	p := t.getOrCreate(nodeID, now)
+	p.latency.Observe(float64(t.config.MaxLatency), now)
+	p.hasLatency = true
+}
+
+func (t *tracker) RegisterInvalidMessage(nodeID ids.NodeID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := t.clock.Time()
+	p := t.getOrCreate(nodeID, now)
+	p.invalidMessages.add(1, now)
+}
+
+func (t *tracker) RegisterBandwidth(nodeID ids.NodeID, numBytes int) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := t.clock.Time()
+	p := t.getOrCreate(nodeID, now)
+	p.bandwidth.add(float64(numBytes), now)
+}
+
+func (t *tracker) RegisterUptime(nodeID ids.NodeID, uptime float64) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := t.clock.Time()
+	p := t.getOrCreate(nodeID, now)
+	p.uptime = safemath.Min(safemath.Max(uptime, 0), 1)
+	p.hasUptime = true
+}
+
+func (t *tracker) Score(nodeID ids.NodeID) float64 {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	p, ok := t.peers[nodeID]
+	if !ok {
+		return MaxScore
+	}
+
+	now := t.clock.Time()
+	weightedSum := 0.0
+	totalWeight := 0.0
+
+	if p.hasLatency {
+		latencyScore := 1 - safemath.Min(p.latency.Read()/float64(t.config.MaxLatency), 1)
+		weightedSum += t.config.LatencyWeight * latencyScore
+		totalWeight += t.config.LatencyWeight
+	}
+
+	// Each recent invalid message halves the invalid message score
+	invalidMessageScore := math.Pow(2, -p.invalidMessages.read(now))
+	weightedSum += t.config.InvalidMessageWeight * invalidMessageScore
+	totalWeight += t.config.InvalidMessageWeight
+
+	bandwidth := p.bandwidth.read(now) * math.Ln2 / t.config.Halflife.Seconds()
+	bandwidthScore := 1 - safemath.Min(bandwidth/t.config.MaxBandwidth, 1)
+	weightedSum += t.config.BandwidthWeight * bandwidthScore
+	totalWeight += t.config.BandwidthWeight
+
+	if p.hasUptime {
+		weightedSum += t.config.UptimeWeight * p.uptime
+		totalWeight += t.config.UptimeWeight
+	}
+
+	if totalWeight == 0 {
+		return MaxScore
+	}
+	return MaxScore * weightedSum / totalWeight
+}
+
+// Assumes [t.lock] is held
+func (t *tracker) getOrCreate(nodeID ids.NodeID, now time.Time) *peerReputation {
+	t.prune(now)
+
+	p, ok := t.peers[nodeID]
+	if !ok {
+		p = &peerReputation{
+			latency:         safemath.NewUninitializedAverager(t.config.Halflife),
+			invalidMessages: decayingCounter{halflife: t.config.Halflife},
+			bandwidth:       decayingCounter{halflife: t.config.Halflife},
+		}
+		t.peers[nodeID] = p
+	}
+	p.lastUpdated = now
+	return p
+}
+
+// prune forgets peers, whose reputation wasn't updated for a long time.
+// Assumes [t.lock] is held.
+func (t *tracker) prune(now time.Time) {
+	if now.Sub(t.lastPrune) < t.config.Halflife {
+		return
+	}
+	t.lastPrune = now
+
+	pruneBefore := now.Add(-pruneHalflives * t.config.Halflife)
+	for nodeID, p := range t.peers {
+		if p.lastUpdated.Before(pruneBefore) {
+			delete(t.peers, nodeID)
+		}
+	}
+}
+
+type peerReputation struct {
+	// Average response latency in nanoseconds, only valid if [hasLatency]
+	latency         safemath.Averager
+	hasLatency      bool
+	invalidMessages decayingCounter
+	// Received bytes
+	bandwidth decayingCounter
+	// Observed uptime in [0, 1], only valid if [hasUptime]
+	uptime    float64
+	hasUptime bool
+
+	lastUpdated time.Time
+}
+
+// decayingCounter is a sum of values, each of which halves every [halflife]
+type decayingCounter struct {
+	halflife    time.Duration
+	value       float64
+	lastUpdated time.Time
+}
+
+func (c *decayingCounter) add(value float64, now time.Time) {
+	c.value = c.read(now) + value
+	c.lastUpdated = now
+}
+
+func (c *decayingCounter) read(now time.Time) float64 {
+	elapsed := now.Sub(c.lastUpdated)
+	if elapsed <= 0 {
+		return c.value
+	}
+	return c.value * math.Exp2(-float64(elapsed)/float64(c.halflife))
+}
+
+type noTracker struct{}
+
+// NewNoTracker returns a tracker, which gives all peers [MaxScore]
+func NewNoTracker() Tracker {
+	return &noTracker{}
+}
+
+func (noTracker) RegisterResponse(ids.NodeID, time.Duration) {}
+
+func (noTracker) RegisterTimeout(ids.NodeID) {}
+
+func (noTracker) RegisterInvalidMessage(ids.NodeID) {}
+
+func (noTracker) RegisterBandwidth(ids.NodeID, int) {}
+
+func (noTracker) RegisterUptime(ids.NodeID, float64) {}
+
+func (noTracker) Score(ids.NodeID) float64 {
+	return MaxScore
+}
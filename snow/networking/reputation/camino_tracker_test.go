@@ -0,0 +1,185 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package reputation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+var testConfig = Config{
+	Halflife:             time.Minute,
+	MaxLatency:           10 * time.Second,
+	MaxBandwidth:         1000,
+	LatencyWeight:        1,
+	InvalidMessageWeight: 1,
+	BandwidthWeight:      1,
+	UptimeWeight:         1,
+}
+
+func newTestTracker(t *testing.T, config Config) *tracker {
+	trackerIntf, err := NewTracker(config)
+	require.NoError(t, err)
+	tr := trackerIntf.(*tracker)
+	tr.clock.Set(time.Unix(1_000_000, 0))
+	return tr
+}
+
+func TestConfigVerify(t *testing.T) {
+	tests := map[string]struct {
+		modify      func(*Config)
+		expectedErr error
+	}{
+		"valid": {
+			modify: func(*Config) {},
+		},
+		"zero halflife": {
+			modify:      func(c *Config) { c.Halflife = 0 },
+			expectedErr: errNonPositiveHalflife,
+		},
+		"zero max latency": {
+			modify:      func(c *Config) { c.MaxLatency = 0 },
+			expectedErr: errNonPositiveMaxLatency,
+		},
+		"zero max bandwidth": {
+			modify:      func(c *Config) { c.MaxBandwidth = 0 },
+			expectedErr: errNonPositiveMaxBandwidth,
+		},
+		"negative weight": {
+			modify:      func(c *Config) { c.UptimeWeight = -1 },
+			expectedErr: errNegativeWeight,
+		},
+		"zero weights": {
+			modify: func(c *Config) {
+				c.LatencyWeight = 0
+				c.InvalidMessageWeight = 0
+				c.BandwidthWeight = 0
+				c.UptimeWeight = 0
+			},
+			expectedErr: errZeroWeights,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := testConfig
+			tt.modify(&config)
+			require.ErrorIs(t, config.Verify(), tt.expectedErr)
+		})
+	}
+}
+
+func TestTrackerUnknownPeer(t *testing.T) {
+	tr := newTestTracker(t, testConfig)
+	require.Equal(t, float64(MaxScore), tr.Score(ids.GenerateTestNodeID()))
+}
+
+func TestTrackerLatency(t *testing.T) {
+	require := require.New(t)
+
+	config := testConfig
+	config.InvalidMessageWeight = 0
+	config.BandwidthWeight = 0
+	config.UptimeWeight = 0
+	tr := newTestTracker(t, config)
+
+	fastNodeID := ids.GenerateTestNodeID()
+	slowNodeID := ids.GenerateTestNodeID()
+	for i := 0; i < 10; i++ {
+		tr.RegisterResponse(fastNodeID, time.Second)
+		tr.RegisterResponse(slowNodeID, time.Second)
+		tr.RegisterTimeout(slowNodeID)
+		tr.clock.Set(tr.clock.Time().Add(time.Second))
+	}
+
+	fastScore := tr.Score(fastNodeID)
+	slowScore := tr.Score(slowNodeID)
+	require.InDelta(0.9, fastScore, 0.001)
+	require.Less(slowScore, fastScore)
+
+	// recent responses outweigh old timeouts
+	for i := 0; i < 10; i++ {
+		tr.clock.Set(tr.clock.Time().Add(config.Halflife))
+		tr.RegisterResponse(slowNodeID, time.Second)
+	}
+	require.InDelta(fastScore, tr.Score(slowNodeID), 0.01)
+}
+
+func TestTrackerInvalidMessages(t *testing.T) {
+	require := require.New(t)
+
+	config := testConfig
+	config.LatencyWeight = 0
+	config.BandwidthWeight = 0
+	config.UptimeWeight = 0
+	tr := newTestTracker(t, config)
+
+	nodeID := ids.GenerateTestNodeID()
+	tr.RegisterInvalidMessage(nodeID)
+	require.InDelta(0.5, tr.Score(nodeID), 0.001)
+	tr.RegisterInvalidMessage(nodeID)
+	require.InDelta(0.25, tr.Score(nodeID), 0.001)
+
+	// the score recovers over time
+	tr.clock.Set(tr.clock.Time().Add(config.Halflife))
+	require.InDelta(0.5, tr.Score(nodeID), 0.001)
+	tr.clock.Set(tr.clock.Time().Add(20 * config.Halflife))
+	require.InDelta(MaxScore, tr.Score(nodeID), 0.001)
+}
+
+func TestTrackerBandwidth(t *testing.T) {
+	require := require.New(t)
+
+	config := testConfig
+	config.LatencyWeight = 0
+	config.InvalidMessageWeight = 0
+	config.UptimeWeight = 0
+	tr := newTestTracker(t, config)
+
+	lightNodeID := ids.GenerateTestNodeID()
+	heavyNodeID := ids.GenerateTestNodeID()
+	for i := 0; i < 600; i++ {
+		tr.RegisterBandwidth(lightNodeID, 100)
+		tr.RegisterBandwidth(heavyNodeID, 2000)
+		tr.clock.Set(tr.clock.Time().Add(time.Second))
+	}
+
+	require.Greater(tr.Score(lightNodeID), 0.85)
+	require.Zero(tr.Score(heavyNodeID))
+}
+
+func TestTrackerUptime(t *testing.T) {
+	require := require.New(t)
+
+	tr := newTestTracker(t, testConfig)
+
+	nodeID := ids.GenerateTestNodeID()
+	tr.RegisterUptime(nodeID, 0.5)
+	// latency isn't known yet, invalid messages and bandwidth have full scores
+	require.InDelta(2.5/3, tr.Score(nodeID), 0.001)
+
+	tr.RegisterUptime(nodeID, 2)
+	require.InDelta(MaxScore, tr.Score(nodeID), 0.001)
+}
+
+func TestTrackerPrune(t *testing.T) {
+	require := require.New(t)
+
+	tr := newTestTracker(t, testConfig)
+
+	nodeID := ids.GenerateTestNodeID()
+	tr.RegisterInvalidMessage(nodeID)
+	require.Contains(tr.peers, nodeID)
+
+	tr.clock.Set(tr.clock.Time().Add(pruneHalflives * testConfig.Halflife))
+	tr.RegisterInvalidMessage(ids.GenerateTestNodeID())
+	require.Contains(tr.peers, nodeID)
+
+	tr.clock.Set(tr.clock.Time().Add(testConfig.Halflife))
+	tr.RegisterInvalidMessage(ids.GenerateTestNodeID())
+	require.NotContains(tr.peers, nodeID)
+}
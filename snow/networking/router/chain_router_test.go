@@ -22,6 +22,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
 	"github.com/ava-labs/avalanchego/snow/networking/handler"
+	"github.com/ava-labs/avalanchego/snow/networking/reputation"
 	"github.com/ava-labs/avalanchego/snow/networking/timeout"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
 	"github.com/ava-labs/avalanchego/snow/validators"
@@ -48,6 +49,7 @@ func TestShutdown(t *testing.T) {
 			TimeoutHalflife:    5 * time.Minute,
 		},
 		benchlist,
+		reputation.NewNoTracker(),
 		"",
 		prometheus.NewRegistry(),
 	)
@@ -86,6 +88,7 @@ func TestShutdown(t *testing.T) {
 		nil,
 		time.Second,
 		resourceTracker,
+		reputation.NewNoTracker(),
 		validators.UnhandledSubnetConnector,
 	)
 	require.NoError(t, err)
@@ -170,6 +173,7 @@ func TestShutdownTimesOut(t *testing.T) {
 			TimeoutHalflife:    5 * time.Minute,
 		},
 		benchlist,
+		reputation.NewNoTracker(),
 		"",
 		metrics,
 	)
@@ -207,6 +211,7 @@ func TestShutdownTimesOut(t *testing.T) {
 		nil,
 		time.Second,
 		resourceTracker,
+		reputation.NewNoTracker(),
 		validators.UnhandledSubnetConnector,
 	)
 	require.NoError(t, err)
@@ -291,6 +296,7 @@ func TestRouterTimeout(t *testing.T) {
 			TimeoutHalflife:    5 * time.Minute,
 		},
 		benchlist.NewNoBenchlist(),
+		reputation.NewNoTracker(),
 		"",
 		prometheus.NewRegistry(),
 	)
@@ -346,6 +352,7 @@ func TestRouterTimeout(t *testing.T) {
 		nil,
 		time.Second,
 		resourceTracker,
+		reputation.NewNoTracker(),
 		validators.UnhandledSubnetConnector,
 	)
 	r.NoError(err)
@@ -614,6 +621,7 @@ func TestRouterClearTimeouts(t *testing.T) {
 			TimeoutHalflife:    5 * time.Minute,
 		},
 		benchlist.NewNoBenchlist(),
+		reputation.NewNoTracker(),
 		"",
 		prometheus.NewRegistry(),
 	)
@@ -656,6 +664,7 @@ func TestRouterClearTimeouts(t *testing.T) {
 		nil,
 		time.Second,
 		resourceTracker,
+		reputation.NewNoTracker(),
 		validators.UnhandledSubnetConnector,
 	)
 	require.NoError(t, err)
@@ -875,6 +884,7 @@ func TestValidatorOnlyMessageDrops(t *testing.T) {
 			TimeoutHalflife:    5 * time.Minute,
 		},
 		benchlist.NewNoBenchlist(),
+		reputation.NewNoTracker(),
 		"",
 		prometheus.NewRegistry(),
 	)
@@ -921,6 +931,7 @@ func TestValidatorOnlyMessageDrops(t *testing.T) {
 		nil,
 		time.Second,
 		resourceTracker,
+		reputation.NewNoTracker(),
 		validators.UnhandledSubnetConnector,
 	)
 	require.NoError(t, err)
@@ -1021,6 +1032,7 @@ func TestRouterCrossChainMessages(t *testing.T) {
 			TimeoutHalflife:    5 * time.Minute,
 		},
 		benchlist.NewNoBenchlist(),
+		reputation.NewNoTracker(),
 		"timeoutManager",
 		prometheus.NewRegistry(),
 	)
@@ -1070,6 +1082,7 @@ func TestRouterCrossChainMessages(t *testing.T) {
 		nil,
 		time.Second,
 		resourceTracker,
+		reputation.NewNoTracker(),
 		validators.UnhandledSubnetConnector,
 	)
 	require.NoError(t, err)
@@ -1087,6 +1100,7 @@ func TestRouterCrossChainMessages(t *testing.T) {
 		nil,
 		time.Second,
 		resourceTracker,
+		reputation.NewNoTracker(),
 		validators.UnhandledSubnetConnector,
 	)
 	require.NoError(t, err)
@@ -1157,6 +1171,7 @@ func TestConnectedSubnet(t *testing.T) {
 			TimeoutHalflife:    5 * time.Minute,
 		},
 		benchlist.NewNoBenchlist(),
+		reputation.NewNoTracker(),
 		"timeoutManager",
 		prometheus.NewRegistry(),
 	)
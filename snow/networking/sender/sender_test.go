@@ -23,10 +23,12 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
 	"github.com/ava-labs/avalanchego/snow/networking/handler"
+	"github.com/ava-labs/avalanchego/snow/networking/reputation"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 	"github.com/ava-labs/avalanchego/snow/networking/timeout"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
 	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/compression"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/math/meter"
 	"github.com/ava-labs/avalanchego/utils/resource"
@@ -57,6 +59,7 @@ func TestTimeout(t *testing.T) {
 			TimeoutCoefficient: 1.25,
 		},
 		benchlist,
+		reputation.NewNoTracker(),
 		"",
 		prometheus.NewRegistry(),
 	)
@@ -69,7 +72,7 @@ func TestTimeout(t *testing.T) {
 	mc, err := message.NewCreator(
 		metrics,
 		"dummyNamespace",
-		true,
+		compression.TypeGzip,
 		10*time.Second,
 	)
 	require.NoError(err)
@@ -117,6 +120,7 @@ func TestTimeout(t *testing.T) {
 		nil,
 		time.Hour,
 		resourceTracker,
+		reputation.NewNoTracker(),
 		validators.UnhandledSubnetConnector,
 	)
 	require.NoError(err)
@@ -311,6 +315,7 @@ func TestReliableMessages(t *testing.T) {
 			TimeoutCoefficient: 1.25,
 		},
 		benchlist,
+		reputation.NewNoTracker(),
 		"",
 		prometheus.NewRegistry(),
 	)
@@ -324,7 +329,7 @@ func TestReliableMessages(t *testing.T) {
 	mc, err := message.NewCreator(
 		metrics,
 		"dummyNamespace",
-		true,
+		compression.TypeGzip,
 		10*time.Second,
 	)
 	require.NoError(t, err)
@@ -373,6 +378,7 @@ func TestReliableMessages(t *testing.T) {
 		nil,
 		1,
 		resourceTracker,
+		reputation.NewNoTracker(),
 		validators.UnhandledSubnetConnector,
 	)
 	require.NoError(t, err)
@@ -442,6 +448,7 @@ func TestReliableMessagesToMyself(t *testing.T) {
 			TimeoutCoefficient: 1.25,
 		},
 		benchlist,
+		reputation.NewNoTracker(),
 		"",
 		prometheus.NewRegistry(),
 	)
@@ -455,7 +462,7 @@ func TestReliableMessagesToMyself(t *testing.T) {
 	mc, err := message.NewCreator(
 		metrics,
 		"dummyNamespace",
-		true,
+		compression.TypeGzip,
 		10*time.Second,
 	)
 	require.NoError(t, err)
@@ -497,6 +504,7 @@ func TestReliableMessagesToMyself(t *testing.T) {
 		nil,
 		time.Second,
 		resourceTracker,
+		reputation.NewNoTracker(),
 		validators.UnhandledSubnetConnector,
 	)
 	require.NoError(t, err)
@@ -13,6 +13,7 @@ import (
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
+	"github.com/ava-labs/avalanchego/snow/networking/reputation"
 	"github.com/ava-labs/avalanchego/utils/timer"
 )
 
@@ -67,6 +68,7 @@ type Manager interface {
 func NewManager(
 	timeoutConfig *timer.AdaptiveTimeoutConfig,
 	benchlistMgr benchlist.Manager,
+	reputationTracker reputation.Tracker,
 	metricsNamespace string,
 	metricsRegister prometheus.Registerer,
 ) (Manager, error) {
@@ -79,15 +81,17 @@ func NewManager(
 		return nil, fmt.Errorf("couldn't create timeout manager: %w", err)
 	}
 	return &manager{
-		benchlistMgr: benchlistMgr,
-		tm:           tm,
+		benchlistMgr:      benchlistMgr,
+		reputationTracker: reputationTracker,
+		tm:                tm,
 	}, nil
 }
 
 type manager struct {
-	tm           timer.AdaptiveTimeoutManager
-	benchlistMgr benchlist.Manager
-	metrics      metrics
+	tm                timer.AdaptiveTimeoutManager
+	benchlistMgr      benchlist.Manager
+	reputationTracker reputation.Tracker
+	metrics           metrics
 }
 
 func (m *manager) Dispatch() {
@@ -125,8 +129,10 @@ func (m *manager) RegisterRequest(
 	timeoutHandler func(),
 ) {
 	newTimeoutHandler := func() {
-		// If this request timed out, tell the benchlist manager
+		// If this request timed out, tell the benchlist manager and the
+		// reputation tracker
 		m.benchlistMgr.RegisterFailure(chainID, nodeID)
+		m.reputationTracker.RegisterTimeout(nodeID)
 		timeoutHandler()
 	}
 	m.tm.Put(requestID, measureLatency, newTimeoutHandler)
@@ -143,6 +149,7 @@ func (m *manager) RegisterResponse(
 ) {
 	m.metrics.Observe(nodeID, chainID, op, latency)
 	m.benchlistMgr.RegisterResponse(chainID, nodeID)
+	m.reputationTracker.RegisterResponse(nodeID, latency)
 	m.tm.Remove(requestID)
 }
 
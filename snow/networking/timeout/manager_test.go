@@ -13,6 +13,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
+	"github.com/ava-labs/avalanchego/snow/networking/reputation"
 	"github.com/ava-labs/avalanchego/utils/timer"
 )
 
@@ -27,6 +28,7 @@ func TestManagerFire(t *testing.T) {
 			TimeoutHalflife:    5 * time.Minute,
 		},
 		benchlist,
+		reputation.NewNoTracker(),
 		"",
 		prometheus.NewRegistry(),
 	)
@@ -60,6 +62,7 @@ func TestManagerCancel(t *testing.T) {
 			TimeoutHalflife:    5 * time.Minute,
 		},
 		benchlist,
+		reputation.NewNoTracker(),
 		"",
 		prometheus.NewRegistry(),
 	)
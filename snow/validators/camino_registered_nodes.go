@@ -0,0 +1,152 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package validators
+
+import (
+	"sync"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+var _ RegisteredNodes = (*registeredNodes)(nil)
+
+// RegisteredNodes is the set of nodes, which are registered to consortium
+// members. All the methods are safe for concurrent use.
+type RegisteredNodes interface {
+	// Add registers [nodeID] to the consortium member [consortiumMemberAddr].
+	// If [nodeID] is already registered to another consortium member, the
+	// registration is replaced.
+	Add(nodeID ids.NodeID, consortiumMemberAddr ids.ShortID)
+
+	// Remove unregisters [nodeID], if it is registered.
+	Remove(nodeID ids.NodeID)
+
+	// Get returns the consortium member, which [nodeID] is registered to.
+	Get(nodeID ids.NodeID) (ids.ShortID, bool)
+
+	// Contains returns true if [nodeID] is registered.
+	Contains(nodeID ids.NodeID) bool
+
+	// Len returns the number of registered nodes.
+	Len() int
+
+	// List returns all the registered nodes.
+	List() []ids.NodeID
+
+	// When a node is registered or unregistered, this listener is called.
+	// The listener is called for all the currently registered nodes upon
+	// registration. Listeners are called after the set is unlocked, so they
+	// may read it, but they must not change it.
+	RegisterCallbackListener(RegisteredNodesCallbackListener)
+}
+
+type RegisteredNodesCallbackListener interface {
+	OnNodeRegistered(nodeID ids.NodeID, consortiumMemberAddr ids.ShortID)
+	OnNodeUnregistered(nodeID ids.NodeID)
+}
+
+// NewRegisteredNodes returns a new, empty set of registered nodes.
+func NewRegisteredNodes() RegisteredNodes {
+	return &registeredNodes{
+		nodes: make(map[ids.NodeID]ids.ShortID),
+	}
+}
+
+type registeredNodes struct {
+	// [callbackLock] serializes the changes of the set together with the
+	// notifications of the listeners about them, so the listeners observe
+	// the changes in order. Listeners are called without holding [lock], so
+	// they can read the set and take locks, which are held while reading it.
+	callbackLock      sync.Mutex
+	lock              sync.RWMutex
+	nodes             map[ids.NodeID]ids.ShortID
+	callbackListeners []RegisteredNodesCallbackListener
+}
+
+func (r *registeredNodes) Add(nodeID ids.NodeID, consortiumMemberAddr ids.ShortID) {
+	r.callbackLock.Lock()
+	defer r.callbackLock.Unlock()
+
+	r.lock.Lock()
+	if addr, ok := r.nodes[nodeID]; ok && addr == consortiumMemberAddr {
+		r.lock.Unlock()
+		return
+	}
+	r.nodes[nodeID] = consortiumMemberAddr
+	callbackListeners := r.callbackListeners
+	r.lock.Unlock()
+
+	for _, callbackListener := range callbackListeners {
+		callbackListener.OnNodeRegistered(nodeID, consortiumMemberAddr)
+	}
+}
+
+func (r *registeredNodes) Remove(nodeID ids.NodeID) {
+	r.callbackLock.Lock()
+	defer r.callbackLock.Unlock()
+
+	r.lock.Lock()
+	if _, ok := r.nodes[nodeID]; !ok {
+		r.lock.Unlock()
+		return
+	}
+	delete(r.nodes, nodeID)
+	callbackListeners := r.callbackListeners
+	r.lock.Unlock()
+
+	for _, callbackListener := range callbackListeners {
+		callbackListener.OnNodeUnregistered(nodeID)
+	}
+}
+
+func (r *registeredNodes) Get(nodeID ids.NodeID) (ids.ShortID, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	addr, ok := r.nodes[nodeID]
+	return addr, ok
+}
+
+func (r *registeredNodes) Contains(nodeID ids.NodeID) bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	_, ok := r.nodes[nodeID]
+	return ok
+}
+
+func (r *registeredNodes) Len() int {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return len(r.nodes)
+}
+
+func (r *registeredNodes) List() []ids.NodeID {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	nodeIDs := make([]ids.NodeID, 0, len(r.nodes))
+	for nodeID := range r.nodes {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	return nodeIDs
+}
+
+func (r *registeredNodes) RegisterCallbackListener(callbackListener RegisteredNodesCallbackListener) {
+	r.callbackLock.Lock()
+	defer r.callbackLock.Unlock()
+
+	r.lock.Lock()
+	r.callbackListeners = append(r.callbackListeners, callbackListener)
+	nodes := make(map[ids.NodeID]ids.ShortID, len(r.nodes))
+	for nodeID, addr := range r.nodes {
+		nodes[nodeID] = addr
+	}
+	r.lock.Unlock()
+
+	for nodeID, addr := range nodes {
+		callbackListener.OnNodeRegistered(nodeID, addr)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package validators
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+type testRegisteredNodesCallbackListener struct {
+	registered   map[ids.NodeID]ids.ShortID
+	unregistered []ids.NodeID
+}
+
+func (l *testRegisteredNodesCallbackListener) OnNodeRegistered(nodeID ids.NodeID, addr ids.ShortID) {
+	l.registered[nodeID] = addr
+}
+
+func (l *testRegisteredNodesCallbackListener) OnNodeUnregistered(nodeID ids.NodeID) {
+	l.unregistered = append(l.unregistered, nodeID)
+}
+
+func TestRegisteredNodes(t *testing.T) {
+	require := require.New(t)
+
+	nodes := NewRegisteredNodes()
+
+	nodeID0 := ids.GenerateTestNodeID()
+	nodeID1 := ids.GenerateTestNodeID()
+	addr0 := ids.GenerateTestShortID()
+	addr1 := ids.GenerateTestShortID()
+
+	nodes.Add(nodeID0, addr0)
+	require.True(nodes.Contains(nodeID0))
+	require.False(nodes.Contains(nodeID1))
+	require.Equal(1, nodes.Len())
+
+	// the listener is called for already registered nodes
+	listener := &testRegisteredNodesCallbackListener{
+		registered: make(map[ids.NodeID]ids.ShortID),
+	}
+	nodes.RegisterCallbackListener(listener)
+	require.Equal(map[ids.NodeID]ids.ShortID{nodeID0: addr0}, listener.registered)
+
+	nodes.Add(nodeID1, addr1)
+	require.Equal(map[ids.NodeID]ids.ShortID{nodeID0: addr0, nodeID1: addr1}, listener.registered)
+	require.ElementsMatch([]ids.NodeID{nodeID0, nodeID1}, nodes.List())
+
+	// registering a node to another consortium member replaces the
+	// registration
+	nodes.Add(nodeID1, addr0)
+	addr, ok := nodes.Get(nodeID1)
+	require.True(ok)
+	require.Equal(addr0, addr)
+	require.Equal(addr0, listener.registered[nodeID1])
+
+	nodes.Remove(nodeID0)
+	require.False(nodes.Contains(nodeID0))
+	require.Equal([]ids.NodeID{nodeID0}, listener.unregistered)
+
+	// removing a node, which isn't registered, doesn't call the listener
+	nodes.Remove(nodeID0)
+	require.Equal([]ids.NodeID{nodeID0}, listener.unregistered)
+	require.Equal(1, nodes.Len())
+}
+
+// readingRegisteredNodesCallbackListener reads the set it listens to, while
+// holding its own lock, like the network does with its peers lock
+type readingRegisteredNodesCallbackListener struct {
+	lock     sync.Mutex
+	nodes    RegisteredNodes
+	contains map[ids.NodeID]bool
+}
+
+func (l *readingRegisteredNodesCallbackListener) OnNodeRegistered(nodeID ids.NodeID, _ ids.ShortID) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.contains[nodeID] = l.nodes.Contains(nodeID)
+}
+
+func (l *readingRegisteredNodesCallbackListener) OnNodeUnregistered(nodeID ids.NodeID) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.contains[nodeID] = l.nodes.Contains(nodeID)
+}
+
+func TestRegisteredNodesListenerReadsSet(t *testing.T) {
+	require := require.New(t)
+
+	nodes := NewRegisteredNodes()
+	listener := &readingRegisteredNodesCallbackListener{
+		nodes:    nodes,
+		contains: make(map[ids.NodeID]bool),
+	}
+	nodes.RegisterCallbackListener(listener)
+
+	nodeID := ids.GenerateTestNodeID()
+	nodes.Add(nodeID, ids.GenerateTestShortID())
+	require.True(listener.contains[nodeID])
+
+	// the set is read while the listener's lock is held concurrently with
+	// notifications, which take the listener's lock
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			listener.lock.Lock()
+			_ = nodes.Contains(nodeID)
+			listener.lock.Unlock()
+		}
+	}()
+	for i := 0; i < 1000; i++ {
+		nodes.Remove(nodeID)
+		nodes.Add(nodeID, ids.GenerateTestShortID())
+	}
+	<-done
+
+	nodes.Remove(nodeID)
+	require.False(listener.contains[nodeID])
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package compression
+
+import (
+	"errors"
+	"fmt"
+)
+
+var errUnknownCompressionType = errors.New("unknown compression type")
+
+// Type is the type of the compression algorithm
+type Type byte
+
+const (
+	TypeNone Type = iota + 1
+	TypeGzip
+	TypeZstd
+)
+
+func (t Type) String() string {
+	switch t {
+	case TypeNone:
+		return "none"
+	case TypeGzip:
+		return "gzip"
+	case TypeZstd:
+		return "zstd"
+	default:
+		return "unknown"
+	}
+}
+
+func (t Type) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", t.String())), nil
+}
+
+// TypeFromString returns the compression type named [s]
+func TypeFromString(s string) (Type, error) {
+	switch s {
+	case TypeNone.String():
+		return TypeNone, nil
+	case TypeGzip.String():
+		return TypeGzip, nil
+	case TypeZstd.String():
+		return TypeZstd, nil
+	default:
+		return TypeNone, fmt.Errorf("%w: %q", errUnknownCompressionType, s)
+	}
+}
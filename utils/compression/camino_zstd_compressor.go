@@ -0,0 +1,73 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package compression
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+var (
+	_ Compressor = (*zstdCompressor)(nil)
+
+	ErrInvalidMaxSizeZstdCompressor = errors.New("invalid zstd compressor max size")
+)
+
+// zstdCompressor is safe for concurrent use
+type zstdCompressor struct {
+	maxSize int64
+
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+// Compress [msg] and returns the compressed bytes.
+func (z *zstdCompressor) Compress(msg []byte) ([]byte, error) {
+	if int64(len(msg)) > z.maxSize {
+		return nil, fmt.Errorf("msg length (%d) > maximum msg length (%d)", len(msg), z.maxSize)
+	}
+	return z.encoder.EncodeAll(msg, nil), nil
+}
+
+// Decompress decompresses [msg].
+func (z *zstdCompressor) Decompress(msg []byte) ([]byte, error) {
+	// The decoder is limited to [z.maxSize] bytes, so the decompressed payload
+	// is never fully allocated, if it is greater than the maximum size.
+	decompressed, err := z.decoder.DecodeAll(msg, nil)
+	if err == zstd.ErrDecoderSizeExceeded {
+		return nil, fmt.Errorf("msg length > maximum msg length (%d)", z.maxSize)
+	}
+	return decompressed, err
+}
+
+// NewZstdCompressor returns a new zstd Compressor that compresses
+func NewZstdCompressor(maxSize int64) (Compressor, error) {
+	if maxSize <= 0 || maxSize == math.MaxInt64 {
+		return nil, ErrInvalidMaxSizeZstdCompressor
+	}
+
+	encoder, err := zstd.NewWriter(nil,
+		zstd.WithEncoderLevel(zstd.SpeedFastest),
+		zstd.WithEncoderConcurrency(1),
+	)
+	if err != nil {
+		return nil, err
+	}
+	decoder, err := zstd.NewReader(nil,
+		zstd.WithDecoderMaxMemory(uint64(maxSize)),
+		zstd.WithDecoderConcurrency(0),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &zstdCompressor{
+		maxSize: maxSize,
+		encoder: encoder,
+		decoder: decoder,
+	}, nil
+}
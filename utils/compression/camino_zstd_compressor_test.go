@@ -0,0 +1,104 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package compression
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/utils/units"
+)
+
+func TestZstdCompressDecompress(t *testing.T) {
+	require := require.New(t)
+
+	data := make([]byte, 4096)
+	for i := 0; i < len(data); i++ {
+		data[i] = byte(rand.Intn(256)) // #nosec G404
+	}
+	repetitiveData := make([]byte, 4096)
+
+	compressor, err := NewZstdCompressor(2 * units.MiB)
+	require.NoError(err)
+
+	dataCompressed, err := compressor.Compress(data)
+	require.NoError(err)
+
+	repetitiveDataCompressed, err := compressor.Compress(repetitiveData)
+	require.NoError(err)
+	require.Less(len(repetitiveDataCompressed), len(repetitiveData))
+
+	dataDecompressed, err := compressor.Decompress(dataCompressed)
+	require.NoError(err)
+	require.Equal(data, dataDecompressed)
+
+	repetitiveDataDecompressed, err := compressor.Decompress(repetitiveDataCompressed)
+	require.NoError(err)
+	require.Equal(repetitiveData, repetitiveDataDecompressed)
+
+	_, err = compressor.Decompress([]byte{1, 2, 3})
+	require.Error(err)
+}
+
+func TestZstdSizeLimiting(t *testing.T) {
+	require := require.New(t)
+
+	data := make([]byte, 3*units.MiB)
+	compressor, err := NewZstdCompressor(2 * units.MiB)
+	require.NoError(err)
+
+	_, err = compressor.Compress(data) // should be too large
+	require.Error(err)
+
+	compressor2, err := NewZstdCompressor(4 * units.MiB)
+	require.NoError(err)
+
+	dataCompressed, err := compressor2.Compress(data)
+	require.NoError(err)
+
+	_, err = compressor.Decompress(dataCompressed) // should be too large
+	require.Error(err)
+}
+
+func TestNewZstdCompressorWithInvalidLimit(t *testing.T) {
+	_, err := NewZstdCompressor(math.MaxInt64)
+	require.ErrorIs(t, err, ErrInvalidMaxSizeZstdCompressor)
+}
+
+func TestTypeFromString(t *testing.T) {
+	require := require.New(t)
+
+	for _, compressionType := range []Type{TypeNone, TypeGzip, TypeZstd} {
+		parsedType, err := TypeFromString(compressionType.String())
+		require.NoError(err)
+		require.Equal(compressionType, parsedType)
+	}
+
+	_, err := TypeFromString("snappy")
+	require.ErrorIs(err, errUnknownCompressionType)
+}
+
+func FuzzZstdCompressor(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		require := require.New(t)
+
+		if len(data) > 2*units.MiB {
+			t.SkipNow()
+		}
+
+		compressor, err := NewZstdCompressor(2 * units.MiB)
+		require.NoError(err)
+
+		compressed, err := compressor.Compress(data)
+		require.NoError(err)
+
+		decompressed, err := compressor.Decompress(compressed)
+		require.NoError(err)
+
+		require.Equal(data, decompressed)
+	})
+}
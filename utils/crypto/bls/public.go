@@ -3,14 +3,33 @@
 
 package bls
 
-type PublicKey []byte
+import "github.com/ava-labs/avalanchego/utils/crypto/blsavax"
 
-var DummyPublicKey = PublicKey{0}
+const PublicKeyLen = blsavax.PublicKeyLen
 
-func PublicKeyFromBytes([]byte) (*PublicKey, error) {
-	return &DummyPublicKey, nil
+type PublicKey = blsavax.PublicKey
+
+// PublicKeyToBytes returns the compressed big-endian format of the public key.
+func PublicKeyToBytes(pk *PublicKey) []byte {
+	return blsavax.PublicKeyToBytes(pk)
+}
+
+// PublicKeyFromBytes parses the compressed big-endian format of the public key
+// into a public key.
+func PublicKeyFromBytes(pkBytes []byte) (*PublicKey, error) {
+	return blsavax.PublicKeyFromBytes(pkBytes)
+}
+
+// AggregatePublicKeys aggregates a non-zero number of public keys into a single
+// aggregated public key.
+// Invariant: all [pks] have been validated.
+func AggregatePublicKeys(pks []*PublicKey) (*PublicKey, error) {
+	return blsavax.AggregatePublicKeys(pks)
 }
 
-func PublicKeyToBytes(*PublicKey) []byte {
-	return DummyPublicKey
+// Verify the [sig] of [msg] against the [pk].
+// The [sig] and [pk] may have been an aggregation of other signatures and keys.
+// Invariant: [pk] and [sig] have both been validated.
+func Verify(pk *PublicKey, sig *Signature, msg []byte) bool {
+	return blsavax.Verify(pk, sig, msg)
 }
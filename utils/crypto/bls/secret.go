@@ -3,26 +3,36 @@
 
 package bls
 
-type SecretKey bool
+import "github.com/ava-labs/avalanchego/utils/crypto/blsavax"
 
-var falseSecretKey SecretKey = false
-var ciphersuiteSignature = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+const SecretKeyLen = blsavax.SecretKeyLen
 
+type SecretKey = blsavax.SecretKey
+
+// NewSecretKey generates a new secret key from the local source of
+// cryptographically secure randomness.
 func NewSecretKey() (*SecretKey, error) {
-	return &falseSecretKey, nil
+	return blsavax.NewSecretKey()
 }
 
-func SecretKeyFromBytes([]byte) (*SecretKey, error) {
-	return &falseSecretKey, nil
+// SecretKeyToBytes returns the big-endian format of the secret key.
+func SecretKeyToBytes(sk *SecretKey) []byte {
+	return blsavax.SecretKeyToBytes(sk)
 }
 
-func SecretKeyToBytes(*SecretKey) []byte {
-	return []byte{}
+// SecretKeyFromBytes parses the big-endian format of the secret key into a
+// secret key.
+func SecretKeyFromBytes(skBytes []byte) (*SecretKey, error) {
+	return blsavax.SecretKeyFromBytes(skBytes)
 }
 
+// PublicFromSecretKey returns the public key that corresponds to this secret
+// key.
 func PublicFromSecretKey(sk *SecretKey) *PublicKey {
-	if sk == nil {
-		return nil
-	}
-	return &DummyPublicKey
+	return blsavax.PublicFromSecretKey(sk)
+}
+
+// Sign [msg] to authorize this message from this [sk].
+func Sign(sk *SecretKey, msg []byte) *Signature {
+	return blsavax.Sign(sk, msg)
 }
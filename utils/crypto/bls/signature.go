@@ -0,0 +1,28 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bls
+
+import "github.com/ava-labs/avalanchego/utils/crypto/blsavax"
+
+const SignatureLen = blsavax.SignatureLen
+
+type Signature = blsavax.Signature
+
+// SignatureToBytes returns the compressed big-endian format of the signature.
+func SignatureToBytes(sig *Signature) []byte {
+	return blsavax.SignatureToBytes(sig)
+}
+
+// SignatureFromBytes parses the compressed big-endian format of the signature
+// into a signature.
+func SignatureFromBytes(sigBytes []byte) (*Signature, error) {
+	return blsavax.SignatureFromBytes(sigBytes)
+}
+
+// AggregateSignatures aggregates a non-zero number of signatures into a single
+// aggregated signature.
+// Invariant: all [sigs] have been validated.
+func AggregateSignatures(sigs []*Signature) (*Signature, error) {
+	return blsavax.AggregateSignatures(sigs)
+}
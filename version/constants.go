@@ -118,6 +118,14 @@ var (
 		constants.FujiID:    time.Date(10000, time.December, 1, 0, 0, 0, 0, time.UTC),
 	}
 	XChainMigrationDefaultTime = time.Date(2020, time.December, 5, 5, 0, 0, 0, time.UTC)
+
+	// FIXME: update this before release
+	AthensPhaseTimes = map[uint32]time.Time{
+		constants.CaminoID:     time.Date(10000, time.December, 1, 0, 0, 0, 0, time.UTC),
+		constants.ColumbusID:   time.Date(10000, time.December, 1, 0, 0, 0, 0, time.UTC),
+		constants.KopernikusID: time.Date(10000, time.December, 1, 0, 0, 0, 0, time.UTC),
+	}
+	AthensPhaseDefaultTime = time.Date(2020, time.December, 5, 5, 0, 0, 0, time.UTC)
 )
 
 func init() {
@@ -197,6 +205,13 @@ func GetXChainMigrationTime(networkID uint32) time.Time {
 	return XChainMigrationDefaultTime
 }
 
+func GetAthensPhaseTime(networkID uint32) time.Time {
+	if upgradeTime, exists := AthensPhaseTimes[networkID]; exists {
+		return upgradeTime
+	}
+	return AthensPhaseDefaultTime
+}
+
 func GetCompatibility(networkID uint32) Compatibility {
 	return NewCompatibility(
 		CurrentApp,
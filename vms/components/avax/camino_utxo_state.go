@@ -0,0 +1,15 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avax
+
+import (
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+)
+
+// NewUTXOIterator returns an iterator over all utxos of the UTXOState stored
+// in [db]. Iterated keys are utxo IDs, values are marshalled utxos.
+func NewUTXOIterator(db database.Database) database.Iterator {
+	return prefixdb.New(utxoPrefix, db).NewIterator()
+}
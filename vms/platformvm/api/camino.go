@@ -39,6 +39,9 @@ type Camino struct {
 	ValidatorConsortiumMembers []ids.ShortID           `json:"validatorConsortiumMembers"`
 	UTXODeposits               []UTXODeposit           `json:"utxoDeposits"`
 	InitialMultisigAddresses   []genesis.MultisigAlias `json:"initialMultisigAddresses"`
+	ValidatorRewardRate        uint64                  `json:"validatorRewardRate"`
+	DaoProposalBondAmount      uint64                  `json:"daoProposalBondAmount"`
+	DaoProposalQuorum          uint64                  `json:"daoProposalQuorum"`
 }
 
 func (c Camino) ParseToGenesis() genesis.Camino {
@@ -49,6 +52,9 @@ func (c Camino) ParseToGenesis() genesis.Camino {
 		AddressStates:            c.AddressStates,
 		DepositOffers:            c.DepositOffers,
 		InitialMultisigAddresses: c.InitialMultisigAddresses,
+		ValidatorRewardRate:      c.ValidatorRewardRate,
+		DaoProposalBondAmount:    c.DaoProposalBondAmount,
+		DaoProposalQuorum:        c.DaoProposalQuorum,
 	}
 }
 
@@ -225,7 +231,7 @@ func buildCaminoGenesis(args *BuildGenesisArgs, reply *BuildGenesisReply) error
 	}
 
 	// Marshal genesis to bytes
-	bytes, err := genesis.Codec.Marshal(genesis.Version, g)
+	bytes, err := genesis.Codec.Marshal(camino.CodecVersion(), g)
 	if err != nil {
 		return fmt.Errorf("couldn't marshal genesis: %w", err)
 	}
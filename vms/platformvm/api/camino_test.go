@@ -0,0 +1,53 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package api
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+	"github.com/ava-labs/avalanchego/vms/platformvm/genesis"
+)
+
+func TestBuildGenesisCodecVersion(t *testing.T) {
+	tests := map[string]struct {
+		camino          Camino
+		expectedVersion uint16
+	}{
+		"No post-launch params": {
+			camino:          Camino{VerifyNodeSignature: true},
+			expectedVersion: blocks.Version,
+		},
+		"Validator reward rate": {
+			camino:          Camino{VerifyNodeSignature: true, ValidatorRewardRate: 100},
+			expectedVersion: blocks.GenesisVersion1,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			args := BuildGenesisArgs{
+				Time:     5,
+				Encoding: formatting.Hex,
+				Camino:   tt.camino,
+			}
+			reply := BuildGenesisReply{}
+
+			ss := StaticService{}
+			require.NoError(t, ss.BuildGenesis(nil, &args, &reply))
+
+			genesisBytes, err := formatting.Decode(reply.Encoding, reply.Bytes)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedVersion, binary.BigEndian.Uint16(genesisBytes))
+
+			g, err := genesis.Parse(genesisBytes)
+			require.NoError(t, err)
+			require.Equal(t, tt.camino.VerifyNodeSignature, g.Camino.VerifyNodeSignature)
+			require.Equal(t, tt.camino.ValidatorRewardRate, g.Camino.ValidatorRewardRate)
+		})
+	}
+}
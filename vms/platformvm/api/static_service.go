@@ -131,6 +131,8 @@ type PermissionlessValidator struct {
 	Connected             bool                      `json:"connected"`
 	Staked                []UTXO                    `json:"staked,omitempty"`
 	Signer                *signer.ProofOfPossession `json:"signer,omitempty"`
+	// Stake-weighted average of the uptimes observed by other validators
+	UptimeEstimate *json.Float32 `json:"uptimeEstimate,omitempty"`
 	// The delegators delegating to this validator
 	Delegators []PrimaryDelegator `json:"delegators"`
 }
@@ -386,7 +388,7 @@ func (*StaticService) BuildGenesis(_ *http.Request, args *BuildGenesisArgs, repl
 	}
 
 	// Marshal genesis to bytes
-	bytes, err := genesis.Codec.Marshal(genesis.Version, g)
+	bytes, err := genesis.Codec.Marshal(g.Camino.CodecVersion(), g)
 	if err != nil {
 		return fmt.Errorf("couldn't marshal genesis: %w", err)
 	}
@@ -358,6 +358,14 @@ func buildBlock(
 		)
 	}
 
+	caminoBlk, err := caminoBuildBlock(builder, parentID, height, timestamp, parentState)
+	if err != nil {
+		return nil, err
+	}
+	if caminoBlk != nil {
+		return caminoBlk, nil
+	}
+
 	// Clean out the mempool's transactions with invalid timestamps.
 	builder.dropExpiredStakerTxs(timestamp)
 
@@ -0,0 +1,58 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package builder
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+
+	txbuilder "github.com/ava-labs/avalanchego/vms/platformvm/txs/builder"
+	txexecutor "github.com/ava-labs/avalanchego/vms/platformvm/txs/executor"
+)
+
+// caminoBuildBlock builds camino-specific system blocks. It returns nil block,
+// if there is nothing to build.
+func caminoBuildBlock(
+	builder *builder,
+	parentID ids.ID,
+	height uint64,
+	timestamp time.Time,
+	parentState state.Chain,
+) (blocks.Block, error) {
+	caminoTxBuilder, ok := builder.txBuilder.(txbuilder.CaminoBuilder)
+	if !ok {
+		return nil, nil
+	}
+
+	// Finish proposals which voting ends at the new chain time.
+	proposals, err := txexecutor.GetProposalsToFinish(parentState, timestamp)
+	if err != nil {
+		return nil, fmt.Errorf("could not find proposals to finish: %w", err)
+	}
+	if len(proposals) == 0 {
+		return nil, nil
+	}
+
+	proposalIDs := make([]ids.ID, len(proposals))
+	for i, proposal := range proposals {
+		proposalIDs[i] = proposal.ID
+	}
+
+	finishProposalsTx, err := caminoTxBuilder.NewFinishProposalsTx(proposalIDs)
+	if err != nil {
+		return nil, fmt.Errorf("could not build tx to finish proposals: %w", err)
+	}
+
+	return blocks.NewBanffStandardBlock(
+		timestamp,
+		parentID,
+		height,
+		[]*txs.Tx{finishProposalsTx},
+	)
+}
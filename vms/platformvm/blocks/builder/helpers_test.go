@@ -129,7 +129,7 @@ func newEnvironment(t *testing.T) *environment {
 
 	res.atomicUTXOs = avax.NewAtomicUTXOManager(res.ctx.SharedMemory, txs.Codec)
 	res.uptimes = uptime.NewManager(res.state)
-	res.utxosHandler = utxo.NewHandler(res.ctx, res.clk, res.state, res.fx)
+	res.utxosHandler = utxo.NewHandler(res.ctx, res.clk, res.state, res.fx, res.config)
 
 	res.txBuilder = txbuilder.New(
 		res.ctx,
@@ -178,6 +178,9 @@ func newEnvironment(t *testing.T) *environment {
 		res.state,
 		&res.backend,
 		window,
+		nil,
+		nil,
+		nil,
 	)
 
 	res.Builder = New(
@@ -18,12 +18,18 @@ import (
 
 	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/codec/linearcodec"
+	"github.com/ava-labs/avalanchego/codec/reflectcodec"
 	"github.com/ava-labs/avalanchego/utils/wrappers"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 )
 
-// Version is the current default codec version
-const Version = txs.Version
+const (
+	// Version is the current default codec version
+	Version = txs.Version
+	// GenesisVersion1 is the genesis codec version, which additionally
+	// serializes fields tagged with "serializeV1"
+	GenesisVersion1 = Version + 1
+)
 
 // GenesisCode allows blocks of larger than usual size to be parsed.
 // While this gives flexibility in accommodating large genesis blocks
@@ -38,10 +44,14 @@ func init() {
 	c := linearcodec.NewCaminoDefault()
 	Codec = codec.NewDefaultManager()
 	gc := linearcodec.NewCaminoCustomMaxLength(math.MaxInt32)
+	gcV1 := linearcodec.NewCamino([]string{
+		reflectcodec.DefaultTagName,
+		reflectcodec.DefaultTagName + "V1",
+	}, math.MaxInt32)
 	GenesisCodec = codec.NewManager(math.MaxInt32)
 
 	errs := wrappers.Errs{}
-	for _, c := range []codec.CaminoRegistry{c, gc} {
+	for _, c := range []codec.CaminoRegistry{c, gc, gcV1} {
 		errs.Add(
 			RegisterApricotBlockTypes(c),
 			txs.RegisterUnsignedTxsTypes(c),
@@ -51,6 +61,7 @@ func init() {
 	errs.Add(
 		Codec.RegisterCodec(Version, c),
 		GenesisCodec.RegisterCodec(Version, gc),
+		GenesisCodec.RegisterCodec(GenesisVersion1, gcV1),
 	)
 	if errs.Errored() {
 		panic(errs.Err)
@@ -9,6 +9,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/pubsub"
 	"github.com/ava-labs/avalanchego/snow/choices"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/window"
@@ -27,6 +28,9 @@ type acceptor struct {
 	metrics          metrics.Metrics
 	recentlyAccepted window.Window[ids.ID]
 	bootstrapped     *utils.AtomicBool
+	pubsub           *pubsub.Server
+	addressTxsIndex  *AddressTxsIndex
+	acceptedListener AcceptedListener
 }
 
 func (a *acceptor) BanffAbortBlock(b *blocks.BanffAbortBlock) error {
@@ -139,15 +143,22 @@ func (a *acceptor) ApricotAtomicBlock(b *blocks.ApricotAtomicBlock) error {
 		zap.Stringer("parentID", b.Parent()),
 	)
 
-	if err := a.commonAccept(b); err != nil {
-		return err
-	}
-
 	blkState, ok := a.blkIDToState[blkID]
 	if !ok {
 		return fmt.Errorf("couldn't find state of block %s", blkID)
 	}
 
+	// Txs are indexed before the block is accepted, so [onAcceptState]
+	// can still read from its parent state.
+	defer a.abortIndex()
+	if err := a.indexTxs(b, blkState.onAcceptState); err != nil {
+		return err
+	}
+
+	if err := a.commonAccept(b); err != nil {
+		return err
+	}
+
 	// Update the state to reflect the changes made in [onAcceptState].
 	blkState.onAcceptState.Apply(a.state)
 
@@ -161,8 +172,17 @@ func (a *acceptor) ApricotAtomicBlock(b *blocks.ApricotAtomicBlock) error {
 		)
 	}
 
+	indexBatches, err := a.indexBatches()
+	if err != nil {
+		return fmt.Errorf(
+			"failed to commit address txs index for block %s: %w",
+			blkID,
+			err,
+		)
+	}
+
 	// Note that this method writes [batch] to the database.
-	if err := a.ctx.SharedMemory.Apply(blkState.atomicRequests, batch); err != nil {
+	if err := a.ctx.SharedMemory.Apply(blkState.atomicRequests, append(indexBatches, batch)...); err != nil {
 		return fmt.Errorf(
 			"failed to atomically accept tx %s in block %s: %w",
 			b.Tx.ID(),
@@ -170,6 +190,10 @@ func (a *acceptor) ApricotAtomicBlock(b *blocks.ApricotAtomicBlock) error {
 			err,
 		)
 	}
+	a.state.Committed()
+
+	a.publishTxs(b)
+	a.notifyAccepted(b)
 	return nil
 }
 
@@ -220,6 +244,19 @@ func (a *acceptor) optionBlock(b, parent blocks.Block) error {
 		a.free(blkID)
 	}()
 
+	blkState, ok := a.blkIDToState[blkID]
+	if !ok {
+		return fmt.Errorf("couldn't find state of block %s", blkID)
+	}
+
+	// Proposal tx is accepted with changes of its commit or abort block.
+	// Txs are indexed before the blocks are accepted, so [onAcceptState]
+	// can still read from its ancestors states.
+	defer a.abortIndex()
+	if err := a.indexTxs(parent, blkState.onAcceptState); err != nil {
+		return err
+	}
+
 	// Note that the parent must be accepted first.
 	if err := a.commonAccept(parent); err != nil {
 		return err
@@ -229,12 +266,14 @@ func (a *acceptor) optionBlock(b, parent blocks.Block) error {
 		return err
 	}
 
-	blkState, ok := a.blkIDToState[blkID]
-	if !ok {
-		return fmt.Errorf("couldn't find state of block %s", blkID)
-	}
 	blkState.onAcceptState.Apply(a.state)
-	return a.state.Commit()
+	if err := a.commit(); err != nil {
+		return err
+	}
+
+	a.publishTxs(parent)
+	a.notifyAccepted(b)
+	return nil
 }
 
 func (a *acceptor) proposalBlock(b blocks.Block) {
@@ -261,15 +300,22 @@ func (a *acceptor) standardBlock(b blocks.Block) error {
 	blkID := b.ID()
 	defer a.free(blkID)
 
-	if err := a.commonAccept(b); err != nil {
-		return err
-	}
-
 	blkState, ok := a.blkIDToState[blkID]
 	if !ok {
 		return fmt.Errorf("couldn't find state of block %s", blkID)
 	}
 
+	// Txs are indexed before the block is accepted, so [onAcceptState]
+	// can still read from its parent state.
+	defer a.abortIndex()
+	if err := a.indexTxs(b, blkState.onAcceptState); err != nil {
+		return err
+	}
+
+	if err := a.commonAccept(b); err != nil {
+		return err
+	}
+
 	// Update the state to reflect the changes made in [onAcceptState].
 	blkState.onAcceptState.Apply(a.state)
 
@@ -283,14 +329,27 @@ func (a *acceptor) standardBlock(b blocks.Block) error {
 		)
 	}
 
+	indexBatches, err := a.indexBatches()
+	if err != nil {
+		return fmt.Errorf(
+			"failed to commit address txs index for block %s: %w",
+			blkID,
+			err,
+		)
+	}
+
 	// Note that this method writes [batch] to the database.
-	if err := a.ctx.SharedMemory.Apply(blkState.atomicRequests, batch); err != nil {
+	if err := a.ctx.SharedMemory.Apply(blkState.atomicRequests, append(indexBatches, batch)...); err != nil {
 		return fmt.Errorf("failed to apply vm's state to shared memory: %w", err)
 	}
+	a.state.Committed()
 
 	if onAcceptFunc := blkState.onAcceptFunc; onAcceptFunc != nil {
 		onAcceptFunc()
 	}
+
+	a.publishTxs(b)
+	a.notifyAccepted(b)
 	return nil
 }
 
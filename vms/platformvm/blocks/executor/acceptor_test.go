@@ -119,12 +119,7 @@ func TestAcceptorVisitAtomicBlock(t *testing.T) {
 	)
 	require.NoError(err)
 
-	// Set expected calls on the state.
-	// We should error after [commonAccept] is called.
-	s.EXPECT().SetLastAccepted(blk.ID()).Times(1)
-	s.EXPECT().SetHeight(blk.Height()).Times(1)
-	s.EXPECT().AddStatelessBlock(blk, choices.Accepted).Times(1)
-
+	// We should error before [commonAccept] is called.
 	err = acceptor.ApricotAtomicBlock(blk)
 	require.Error(err, "should fail because the block isn't in the state map")
 
@@ -155,6 +150,7 @@ func TestAcceptorVisitAtomicBlock(t *testing.T) {
 	s.EXPECT().AddStatelessBlock(blk, choices.Accepted).Times(1)
 	batch := database.NewMockBatch(ctrl)
 	s.EXPECT().CommitBatch().Return(batch, nil).Times(1)
+	s.EXPECT().Committed().Times(1)
 	s.EXPECT().Abort().Times(1)
 	onAcceptState.EXPECT().Apply(s).Times(1)
 	sharedMemory.EXPECT().Apply(atomicRequests, batch).Return(nil).Times(1)
@@ -207,12 +203,7 @@ func TestAcceptorVisitStandardBlock(t *testing.T) {
 	)
 	require.NoError(err)
 
-	// Set expected calls on the state.
-	// We should error after [commonAccept] is called.
-	s.EXPECT().SetLastAccepted(blk.ID()).Times(1)
-	s.EXPECT().SetHeight(blk.Height()).Times(1)
-	s.EXPECT().AddStatelessBlock(blk, choices.Accepted).Times(1)
-
+	// We should error before [commonAccept] is called.
 	err = acceptor.BanffStandardBlock(blk)
 	require.Error(err, "should fail because the block isn't in the state map")
 
@@ -249,6 +240,7 @@ func TestAcceptorVisitStandardBlock(t *testing.T) {
 	s.EXPECT().AddStatelessBlock(blk, choices.Accepted).Times(1)
 	batch := database.NewMockBatch(ctrl)
 	s.EXPECT().CommitBatch().Return(batch, nil).Times(1)
+	s.EXPECT().Committed().Times(1)
 	s.EXPECT().Abort().Times(1)
 	onAcceptState.EXPECT().Apply(s).Times(1)
 	sharedMemory.EXPECT().Apply(atomicRequests, batch).Return(nil).Times(1)
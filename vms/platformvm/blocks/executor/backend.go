@@ -77,6 +77,10 @@ func (b *backend) LastAccepted() ids.ID {
 	return b.lastAccepted
 }
 
+func (b *backend) SetLastAccepted(blkID ids.ID) {
+	b.lastAccepted = blkID
+}
+
 func (b *backend) free(blkID ids.ID) {
 	delete(b.blkIDToState, blkID)
 }
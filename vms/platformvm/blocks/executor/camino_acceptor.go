@@ -0,0 +1,182 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/chains/atomic"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/versiondb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/index"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+// AddressTxsIndex is the index of accepted txs by addresses of utxos, that
+// they consume and produce. Changes of [DB] are written atomically with
+// the chain state.
+type AddressTxsIndex struct {
+	Indexer index.AddressTxsIndexer
+	DB      *versiondb.Database
+}
+
+// AcceptedListener is notified about accepted blocks, after their changes
+// were committed to the chain state.
+type AcceptedListener interface {
+	Accepted(blocks.Block)
+}
+
+// publishTxs notifies pubsub subscribers about accepted txs of block [b].
+// Must be called after the block state was committed to the chain state.
+func (a *acceptor) publishTxs(b blocks.Block) {
+	if a.pubsub == nil {
+		return
+	}
+	for _, tx := range b.Txs() {
+		utxos, err := stateUTXOs(a.state, tx)
+		if err != nil {
+			a.ctx.Log.Warn("failed to get utxos produced by tx execution",
+				zap.Stringer("txID", tx.ID()),
+				zap.Error(err),
+			)
+		}
+		a.pubsub.Publish(txs.NewPubSubFilterer(tx, utxos))
+	}
+}
+
+// stateUTXOs returns utxos produced by execution of [tx], which aren't [tx]
+// outputs: claimed deposit rewards, validator rewards and returned stakes.
+// [chainState] must contain changes made by [tx].
+func stateUTXOs(chainState state.Chain, tx *txs.Tx) ([]*avax.UTXO, error) {
+	txID := tx.ID()
+	outputIndex := len(tx.Unsigned.Outputs())
+	switch utx := tx.Unsigned.(type) {
+	case *txs.ClaimTx, *txs.CaminoRewardValidatorTx:
+	case *txs.RewardValidatorTx:
+		// Stake and rewards are returned with ids following staker tx outputs
+		stakerTx, _, err := chainState.GetTx(utx.TxID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get staker tx %s: %w", utx.TxID, err)
+		}
+		txID = utx.TxID
+		outputIndex = len(stakerTx.Unsigned.Outputs())
+	default:
+		return nil, nil
+	}
+
+	utxos := []*avax.UTXO{}
+	for ; ; outputIndex++ {
+		utxoID := avax.UTXOID{TxID: txID, OutputIndex: uint32(outputIndex)}
+		utxo, err := chainState.GetUTXO(utxoID.InputID())
+		if err == database.ErrNotFound {
+			return utxos, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get utxo %s: %w", utxoID.InputID(), err)
+		}
+		utxos = append(utxos, utxo)
+	}
+}
+
+// indexTxs adds txs of block [b] to the address txs index. [onAcceptState]
+// must contain changes made by these txs. Must be called before the block
+// state is applied to the chain state, so consumed utxos could be fetched
+// from it.
+func (a *acceptor) indexTxs(b blocks.Block, onAcceptState state.Chain) error {
+	if a.addressTxsIndex == nil {
+		return nil
+	}
+
+	// Utxos produced by previous txs of the same block aren't in the chain
+	// state yet
+	producedUTXOs := map[ids.ID]*avax.UTXO{}
+	for _, tx := range b.Txs() {
+		inputIDs := tx.Unsigned.InputIDs()
+		inputUTXOs := make([]*avax.UTXO, 0, inputIDs.Len())
+		for utxoID := range inputIDs {
+			utxo, ok := producedUTXOs[utxoID]
+			if !ok {
+				var err error
+				utxo, err = a.state.GetUTXO(utxoID)
+				if err == database.ErrNotFound {
+					// Imported utxos aren't stored in the chain state
+					continue
+				} else if err != nil {
+					return fmt.Errorf("failed to get utxo %s: %w", utxoID, err)
+				}
+			}
+			inputUTXOs = append(inputUTXOs, utxo)
+		}
+
+		stateUTXOs, err := stateUTXOs(onAcceptState, tx)
+		if err != nil {
+			return err
+		}
+		outputUTXOs := append(tx.UTXOs(), stateUTXOs...)
+		for _, utxo := range outputUTXOs {
+			producedUTXOs[utxo.InputID()] = utxo
+		}
+
+		txID := tx.ID()
+		if err := a.addressTxsIndex.Indexer.Accept(txID, inputUTXOs, outputUTXOs); err != nil {
+			return fmt.Errorf("failed to index tx %s: %w", txID, err)
+		}
+	}
+	return nil
+}
+
+// indexBatches returns batches with the address txs index changes, which must
+// be written atomically with the chain state batch.
+func (a *acceptor) indexBatches() ([]database.Batch, error) {
+	if a.addressTxsIndex == nil {
+		return nil, nil
+	}
+	batch, err := a.addressTxsIndex.DB.CommitBatch()
+	if err != nil {
+		return nil, err
+	}
+	return []database.Batch{batch}, nil
+}
+
+// notifyAccepted notifies the accepted listener about committed block [b].
+func (a *acceptor) notifyAccepted(b blocks.Block) {
+	if a.acceptedListener != nil {
+		a.acceptedListener.Accepted(b)
+	}
+}
+
+func (a *acceptor) abortIndex() {
+	if a.addressTxsIndex != nil {
+		a.addressTxsIndex.DB.Abort()
+	}
+}
+
+// commit writes the chain state and the address txs index changes atomically.
+func (a *acceptor) commit() error {
+	if a.addressTxsIndex == nil {
+		return a.state.Commit()
+	}
+
+	defer a.state.Abort()
+	batch, err := a.state.CommitBatch()
+	if err != nil {
+		return err
+	}
+
+	defer a.abortIndex()
+	indexBatches, err := a.indexBatches()
+	if err != nil {
+		return err
+	}
+	if err := atomic.WriteAll(batch, indexBatches...); err != nil {
+		return err
+	}
+	a.state.Committed()
+	return nil
+}
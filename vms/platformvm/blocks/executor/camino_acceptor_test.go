@@ -0,0 +1,180 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/database/versiondb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/index"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestStateUTXOs(t *testing.T) {
+	testErr := errors.New("test error")
+	out := &avax.TransferableOutput{Out: &secp256k1fx.TransferOutput{Amt: 1}}
+	utxo1 := &avax.UTXO{Out: &secp256k1fx.TransferOutput{Amt: 2}}
+	utxo2 := &avax.UTXO{Out: &secp256k1fx.TransferOutput{Amt: 3}}
+
+	claimTx := &txs.Tx{Unsigned: &txs.ClaimTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+		Outs: []*avax.TransferableOutput{out},
+	}}}}
+	require.NoError(t, claimTx.Initialize(txs.Codec))
+	stakerTx := &txs.Tx{Unsigned: &txs.AddValidatorTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+		Outs: []*avax.TransferableOutput{out},
+	}}}}
+	rewardValidatorTx := &txs.Tx{Unsigned: &txs.RewardValidatorTx{TxID: ids.ID{1}}}
+	require.NoError(t, rewardValidatorTx.Initialize(txs.Codec))
+	addressStateTx := &txs.Tx{Unsigned: &txs.AddAddressStateTx{}}
+	require.NoError(t, addressStateTx.Initialize(txs.Codec))
+
+	utxoID := func(txID ids.ID, outputIndex uint32) ids.ID {
+		utxoID := avax.UTXOID{TxID: txID, OutputIndex: outputIndex}
+		return utxoID.InputID()
+	}
+
+	tests := map[string]struct {
+		tx            *txs.Tx
+		chainState    func(*gomock.Controller) state.Chain
+		expectedUTXOs []*avax.UTXO
+		expectedErr   error
+	}{
+		"Tx without state utxos": {
+			tx:         addressStateTx,
+			chainState: func(c *gomock.Controller) state.Chain { return state.NewMockChain(c) },
+		},
+		"ClaimTx": {
+			tx: claimTx,
+			chainState: func(c *gomock.Controller) state.Chain {
+				s := state.NewMockChain(c)
+				s.EXPECT().GetUTXO(utxoID(claimTx.ID(), 1)).Return(utxo1, nil)
+				s.EXPECT().GetUTXO(utxoID(claimTx.ID(), 2)).Return(utxo2, nil)
+				s.EXPECT().GetUTXO(utxoID(claimTx.ID(), 3)).Return(nil, database.ErrNotFound)
+				return s
+			},
+			expectedUTXOs: []*avax.UTXO{utxo1, utxo2},
+		},
+		"RewardValidatorTx": {
+			tx: rewardValidatorTx,
+			chainState: func(c *gomock.Controller) state.Chain {
+				s := state.NewMockChain(c)
+				s.EXPECT().GetTx(ids.ID{1}).Return(stakerTx, status.Committed, nil)
+				s.EXPECT().GetUTXO(utxoID(ids.ID{1}, 1)).Return(utxo1, nil)
+				s.EXPECT().GetUTXO(utxoID(ids.ID{1}, 2)).Return(nil, database.ErrNotFound)
+				return s
+			},
+			expectedUTXOs: []*avax.UTXO{utxo1},
+		},
+		"Fail to get utxo": {
+			tx: claimTx,
+			chainState: func(c *gomock.Controller) state.Chain {
+				s := state.NewMockChain(c)
+				s.EXPECT().GetUTXO(utxoID(claimTx.ID(), 1)).Return(nil, testErr)
+				return s
+			},
+			expectedErr: testErr,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			utxos, err := stateUTXOs(tt.chainState(ctrl), tt.tx)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Equal(t, tt.expectedUTXOs, utxos)
+		})
+	}
+}
+
+func TestIndexTxs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	assetID := ids.ID{1}
+	addr1 := ids.ShortID{1}
+	addr2 := ids.ShortID{2}
+	addr3 := ids.ShortID{3}
+	newUTXO := func(txID ids.ID, outputIndex uint32, addr ids.ShortID) *avax.UTXO {
+		return &avax.UTXO{
+			UTXOID: avax.UTXOID{TxID: txID, OutputIndex: outputIndex},
+			Asset:  avax.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          1,
+				OutputOwners: secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr}},
+			},
+		}
+	}
+	toInput := func(utxo *avax.UTXO) *avax.TransferableInput {
+		return &avax.TransferableInput{
+			UTXOID: utxo.UTXOID,
+			Asset:  utxo.Asset,
+			In:     &secp256k1fx.TransferInput{Amt: 1, Input: secp256k1fx.Input{SigIndices: []uint32{0}}},
+		}
+	}
+	toOutput := func(utxo *avax.UTXO) *avax.TransferableOutput {
+		return &avax.TransferableOutput{Asset: utxo.Asset, Out: utxo.Out.(avax.TransferableOut)}
+	}
+
+	// tx1 consumes utxo from chain state and produces utxo for addr2
+	stateUTXO := newUTXO(ids.ID{10}, 0, addr1)
+	tx1 := &txs.Tx{Unsigned: &txs.AddAddressStateTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+		Ins:  []*avax.TransferableInput{toInput(stateUTXO)},
+		Outs: []*avax.TransferableOutput{toOutput(newUTXO(ids.Empty, 0, addr2))},
+	}}}}
+	require.NoError(t, tx1.Initialize(txs.Codec))
+
+	// tx2 consumes utxo produced by tx1 and its execution produces utxo for addr3
+	tx1UTXO := tx1.UTXOs()[0]
+	tx2 := &txs.Tx{Unsigned: &txs.ClaimTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+		Ins: []*avax.TransferableInput{toInput(tx1UTXO)},
+	}}}}
+	require.NoError(t, tx2.Initialize(txs.Codec))
+	claimedUTXO := newUTXO(tx2.ID(), 0, addr3)
+
+	blk, err := blocks.NewBanffStandardBlock(time.Unix(1, 0), ids.ID{2}, 1, []*txs.Tx{tx1, tx2})
+	require.NoError(t, err)
+
+	chainState := state.NewMockState(ctrl)
+	chainState.EXPECT().GetUTXO(stateUTXO.InputID()).Return(stateUTXO, nil)
+	onAcceptState := state.NewMockDiff(ctrl)
+	onAcceptState.EXPECT().GetUTXO(claimedUTXO.InputID()).Return(claimedUTXO, nil)
+	onAcceptState.EXPECT().GetUTXO((&avax.UTXOID{TxID: tx2.ID(), OutputIndex: 1}).InputID()).
+		Return(nil, database.ErrNotFound)
+
+	indexDB := versiondb.New(memdb.New())
+	indexer, err := index.NewIndexer(indexDB, logging.NoLog{}, "", prometheus.NewRegistry(), false)
+	require.NoError(t, err)
+	acceptor := &acceptor{
+		backend:         &backend{state: chainState},
+		addressTxsIndex: &AddressTxsIndex{Indexer: indexer, DB: indexDB},
+	}
+
+	require.NoError(t, acceptor.indexTxs(blk, onAcceptState))
+
+	for addr, expectedTxIDs := range map[ids.ShortID][]ids.ID{
+		addr1: {tx1.ID()},
+		addr2: {tx1.ID(), tx2.ID()},
+		addr3: {tx2.ID()},
+	} {
+		txIDs, err := indexer.Read(addr[:], assetID, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, expectedTxIDs, txIDs)
+	}
+}
@@ -148,7 +148,7 @@ func newEnvironment(t *testing.T, ctrl *gomock.Controller) *environment {
 	if ctrl == nil {
 		res.state = defaultState(res.config, res.ctx, res.baseDB, rewardsCalc)
 		res.uptimes = uptime.NewManager(res.state)
-		res.utxosHandler = utxo.NewHandler(res.ctx, res.clk, res.state, res.fx)
+		res.utxosHandler = utxo.NewHandler(res.ctx, res.clk, res.state, res.fx, res.config)
 		res.txBuilder = p_tx_builder.New(
 			res.ctx,
 			res.config,
@@ -162,7 +162,7 @@ func newEnvironment(t *testing.T, ctrl *gomock.Controller) *environment {
 		genesisBlkID = ids.GenerateTestID()
 		res.mockedState = state.NewMockState(ctrl)
 		res.uptimes = uptime.NewManager(res.mockedState)
-		res.utxosHandler = utxo.NewHandler(res.ctx, res.clk, res.mockedState, res.fx)
+		res.utxosHandler = utxo.NewHandler(res.ctx, res.clk, res.mockedState, res.fx, res.config)
 		res.txBuilder = p_tx_builder.New(
 			res.ctx,
 			res.config,
@@ -213,6 +213,9 @@ func newEnvironment(t *testing.T, ctrl *gomock.Controller) *environment {
 			res.state,
 			res.backend,
 			window,
+			nil,
+			nil,
+			nil,
 		)
 		addSubnet(res)
 	} else {
@@ -222,6 +225,9 @@ func newEnvironment(t *testing.T, ctrl *gomock.Controller) *environment {
 			res.mockedState,
 			res.backend,
 			window,
+			nil,
+			nil,
+			nil,
 		)
 		// we do not add any subnet to state, since we can mock
 		// whatever we need
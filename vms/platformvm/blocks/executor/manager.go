@@ -5,6 +5,7 @@ package executor
 
 import (
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/pubsub"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
 	"github.com/ava-labs/avalanchego/utils/window"
 	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
@@ -21,6 +22,9 @@ type Manager interface {
 
 	// Returns the ID of the most recently accepted block.
 	LastAccepted() ids.ID
+	// Sets the ID of the most recently accepted block. Used when the chain
+	// state was replaced by state sync.
+	SetLastAccepted(blkID ids.ID)
 	GetBlock(blkID ids.ID) (snowman.Block, error)
 	GetStatelessBlock(blkID ids.ID) (blocks.Block, error)
 	NewBlock(blocks.Block) snowman.Block
@@ -32,6 +36,9 @@ func NewManager(
 	s state.State,
 	txExecutorBackend *executor.Backend,
 	recentlyAccepted window.Window[ids.ID],
+	pubsub *pubsub.Server,
+	addressTxsIndex *AddressTxsIndex,
+	acceptedListener AcceptedListener,
 ) Manager {
 	backend := &backend{
 		Mempool:      mempool,
@@ -52,6 +59,9 @@ func NewManager(
 			metrics:          metrics,
 			recentlyAccepted: recentlyAccepted,
 			bootstrapped:     txExecutorBackend.Bootstrapped,
+			pubsub:           pubsub,
+			addressTxsIndex:  addressTxsIndex,
+			acceptedListener: acceptedListener,
 		},
 		rejector: &rejector{backend: backend},
 	}
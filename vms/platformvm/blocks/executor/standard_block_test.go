@@ -147,6 +147,12 @@ func TestBanffStandardBlockTimeVerification(t *testing.T) {
 	pendingIt.EXPECT().Release().Return().AnyTimes()
 	onParentAccept.EXPECT().GetPendingStakerIterator().Return(pendingIt, nil).AnyTimes()
 
+	// no proposals
+	onParentAccept.EXPECT().GetAllProposals().Return(nil, nil).AnyTimes()
+
+	// no kyc expirations
+	onParentAccept.EXPECT().GetAllKycExpirations().Return(map[ids.ShortID]uint64{}, nil).AnyTimes()
+
 	onParentAccept.EXPECT().GetTimestamp().Return(chainTime).AnyTimes()
 
 	txID := ids.GenerateTestID()
@@ -163,6 +169,7 @@ func TestBanffStandardBlockTimeVerification(t *testing.T) {
 	}
 	utxoID := utxo.InputID()
 	onParentAccept.EXPECT().GetUTXO(utxoID).Return(utxo, nil).AnyTimes()
+	onParentAccept.EXPECT().GetBaseFee().Return(env.config.TxFee, nil).AnyTimes()
 
 	// Create the tx
 	utx := &txs.CreateSubnetTx{
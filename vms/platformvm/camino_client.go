@@ -0,0 +1,126 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"context"
+
+	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+// CaminoClient interface for interacting with the camino-specific
+// P Chain endpoints
+type CaminoClient interface {
+	// GetDepositOffers returns deposit offers. If [timestamp] isn't zero,
+	// only offers active at [timestamp] are returned.
+	GetDepositOffers(ctx context.Context, timestamp uint64, options ...rpc.Option) ([]*APIDepositOffer, error)
+	// GetDeposits returns deposits with [depositTxIDs] and their rewards and
+	// unlockable amounts calculated at current chain time
+	GetDeposits(ctx context.Context, depositTxIDs []ids.ID, options ...rpc.Option) (*GetDepositsReply, error)
+	// GetDepositsByAddress returns deposits which rewards owner or deposited
+	// outputs owner contains [address]
+	GetDepositsByAddress(ctx context.Context, address ids.ShortID, options ...rpc.Option) (*GetDepositsReply, error)
+	// GetMultisigAlias returns owners and threshold of multisig [alias]
+	GetMultisigAlias(ctx context.Context, alias ids.ShortID, options ...rpc.Option) (*secp256k1fx.OutputOwners, error)
+	// GetAddressStates returns decoded states of [address]
+	GetAddressStates(ctx context.Context, address ids.ShortID, options ...rpc.Option) (*GetAddressStatesReply, error)
+	// GetRegisteredNodeID returns id of the node registered by
+	// consortium member [address]
+	GetRegisteredNodeID(ctx context.Context, address ids.ShortID, options ...rpc.Option) (ids.NodeID, error)
+	// GetNodeConsortiumMember returns address of the consortium member that
+	// registered [nodeID]
+	GetNodeConsortiumMember(ctx context.Context, nodeID ids.NodeID, options ...rpc.Option) (ids.ShortID, error)
+	// GetAddressTxs returns ids of accepted txs, that changed [address]
+	// balance of [assetID], starting from [cursor], and the cursor of the
+	// next page
+	GetAddressTxs(ctx context.Context, address ids.ShortID, assetID ids.ID, cursor, pageSize uint64, options ...rpc.Option) ([]ids.ID, uint64, error)
+}
+
+func (c *client) GetDepositOffers(ctx context.Context, timestamp uint64, options ...rpc.Option) ([]*APIDepositOffer, error) {
+	res := &GetDepositOffersReply{}
+	err := c.requester.SendRequest(ctx, "platform.getDepositOffers", &GetDepositOffersArgs{
+		Timestamp: json.Uint64(timestamp),
+	}, res, options...)
+	return res.DepositOffers, err
+}
+
+func (c *client) GetDeposits(ctx context.Context, depositTxIDs []ids.ID, options ...rpc.Option) (*GetDepositsReply, error) {
+	res := &GetDepositsReply{}
+	err := c.requester.SendRequest(ctx, "platform.getDeposits", &GetDepositsArgs{
+		DepositTxIDs: depositTxIDs,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetDepositsByAddress(ctx context.Context, address ids.ShortID, options ...rpc.Option) (*GetDepositsReply, error) {
+	res := &GetDepositsReply{}
+	err := c.requester.SendRequest(ctx, "platform.getDepositsByAddress", &GetDepositsByAddressArgs{
+		Address: address.String(),
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetMultisigAlias(ctx context.Context, alias ids.ShortID, options ...rpc.Option) (*secp256k1fx.OutputOwners, error) {
+	res := &GetMultisigAliasReply{}
+	err := c.requester.SendRequest(ctx, "platform.getMultisigAlias", &GetMultisigAliasArgs{
+		Alias: alias.String(),
+	}, res, options...)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := address.ParseToIDs(res.Addresses)
+	if err != nil {
+		return nil, err
+	}
+	return &secp256k1fx.OutputOwners{
+		Threshold: uint32(res.Threshold),
+		Addrs:     addrs,
+	}, nil
+}
+
+func (c *client) GetAddressStates(ctx context.Context, address ids.ShortID, options ...rpc.Option) (*GetAddressStatesReply, error) {
+	res := &GetAddressStatesReply{}
+	err := c.requester.SendRequest(ctx, "platform.getAddressStates", &GetAddressStatesArgs{
+		Address: address.String(),
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetRegisteredNodeID(ctx context.Context, address ids.ShortID, options ...rpc.Option) (ids.NodeID, error) {
+	res := &GetRegisteredShortIDLinkReply{}
+	err := c.requester.SendRequest(ctx, "platform.getRegisteredShortIDLink", &GetRegisteredShortIDLinkArgs{
+		Address: address.String(),
+	}, res, options...)
+	if err != nil {
+		return ids.EmptyNodeID, err
+	}
+	return ids.NodeIDFromString(res.Address)
+}
+
+func (c *client) GetNodeConsortiumMember(ctx context.Context, nodeID ids.NodeID, options ...rpc.Option) (ids.ShortID, error) {
+	res := &GetRegisteredShortIDLinkReply{}
+	err := c.requester.SendRequest(ctx, "platform.getRegisteredShortIDLink", &GetRegisteredShortIDLinkArgs{
+		Address: nodeID.String(),
+	}, res, options...)
+	if err != nil {
+		return ids.ShortEmpty, err
+	}
+	return address.ParseToID(res.Address)
+}
+
+func (c *client) GetAddressTxs(ctx context.Context, address ids.ShortID, assetID ids.ID, cursor, pageSize uint64, options ...rpc.Option) ([]ids.ID, uint64, error) {
+	res := &GetAddressTxsReply{}
+	err := c.requester.SendRequest(ctx, "platform.getAddressTxs", &GetAddressTxsArgs{
+		JSONAddress: api.JSONAddress{Address: address.String()},
+		Cursor:      json.Uint64(cursor),
+		PageSize:    json.Uint64(pageSize),
+		AssetID:     assetID.String(),
+	}, res, options...)
+	return res.TxIDs, uint64(res.Cursor), err
+}
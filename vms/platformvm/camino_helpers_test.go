@@ -132,9 +132,6 @@ func defaultCaminoConfig(postBanff bool) config.Config {
 		ApricotPhase3Time: defaultValidateEndTime,
 		ApricotPhase5Time: defaultValidateEndTime,
 		BanffTime:         banffTime,
-		CaminoConfig: config.CaminoConfig{
-			DaoProposalBondAmount: 100 * units.Avax,
-		},
 	}
 }
 
@@ -10,6 +10,7 @@ import (
 	"net/http"
 
 	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto"
@@ -19,7 +20,9 @@ import (
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/keystore"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/builder"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"go.uber.org/zap"
 
@@ -248,6 +251,51 @@ func (s *CaminoService) SetAddressState(_ *http.Request, args *SetAddressStateAr
 	return nil
 }
 
+type SetKycExpirationArgs struct {
+	api.JSONSpendHeader
+
+	Address string `json:"address"`
+	// Unix timestamp at which kyc verified state of address expires.
+	// Zero means that it never expires.
+	Expiration utilsjson.Uint64 `json:"expiration"`
+}
+
+// SetKycExpiration issues a SetKycExpirationTx
+func (s *CaminoService) SetKycExpiration(_ *http.Request, args *SetKycExpirationArgs, response *api.JSONTxID) error {
+	s.vm.ctx.Log.Debug("Platform: SetKycExpiration called")
+
+	keys, err := s.getKeystoreKeys(&args.JSONSpendHeader)
+	if err != nil {
+		return err
+	}
+
+	var changeAddr ids.ShortID
+	if len(args.ChangeAddr) > 0 {
+		if changeAddr, err = avax.ParseServiceAddress(s.addrManager, args.ChangeAddr); err != nil {
+			return fmt.Errorf(errInvalidChangeAddr, err)
+		}
+	}
+
+	targetAddr, err := avax.ParseServiceAddress(s.addrManager, args.Address)
+	if err != nil {
+		return fmt.Errorf("couldn't parse param Address: %w", err)
+	}
+
+	tx, err := s.vm.txBuilder.NewSetKycExpirationTx(
+		targetAddr,
+		uint64(args.Expiration),
+		keys.Keys,
+		changeAddr,
+	)
+	if err != nil {
+		return fmt.Errorf(errCreateTx, err)
+	}
+
+	response.TxID = tx.ID()
+
+	return s.vm.Builder.AddUnverifiedTx(tx)
+}
+
 type GetAddressStateTxArgs struct {
 	SetAddressStateArgs
 
@@ -425,6 +473,326 @@ func (s *CaminoService) RegisterNode(_ *http.Request, args *RegisterNodeArgs, re
 	return errs.Err
 }
 
+type GetDepositOffersArgs struct {
+	// If non-zero, only offers active at this timestamp will be returned
+	Timestamp utilsjson.Uint64 `json:"timestamp"`
+}
+
+// APIDepositOffer is a deposit offer with its uint fields encoded as strings
+type APIDepositOffer struct {
+	ID                      ids.ID           `json:"id"`
+	InterestRateNominator   utilsjson.Uint64 `json:"interestRateNominator"`
+	Start                   utilsjson.Uint64 `json:"start"`
+	End                     utilsjson.Uint64 `json:"end"`
+	MinAmount               utilsjson.Uint64 `json:"minAmount"`
+	MinDuration             utilsjson.Uint32 `json:"minDuration"`
+	MaxDuration             utilsjson.Uint32 `json:"maxDuration"`
+	UnlockPeriodDuration    utilsjson.Uint32 `json:"unlockPeriodDuration"`
+	NoRewardsPeriodDuration utilsjson.Uint32 `json:"noRewardsPeriodDuration"`
+	Flags                   utilsjson.Uint64 `json:"flags"`
+}
+
+type GetDepositOffersReply struct {
+	DepositOffers []*APIDepositOffer `json:"depositOffers"`
+}
+
+// GetDepositOffers returns deposit offers
+func (s *CaminoService) GetDepositOffers(_ *http.Request, args *GetDepositOffersArgs, reply *GetDepositOffersReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetDepositOffers called")
+
+	offers, err := s.vm.state.GetAllDepositOffers()
+	if err != nil {
+		return err
+	}
+
+	reply.DepositOffers = make([]*APIDepositOffer, 0, len(offers))
+	for _, offer := range offers {
+		if args.Timestamp == 0 || offer.IsActiveAt(uint64(args.Timestamp)) {
+			reply.DepositOffers = append(reply.DepositOffers, &APIDepositOffer{
+				ID:                      offer.ID,
+				InterestRateNominator:   utilsjson.Uint64(offer.InterestRateNominator),
+				Start:                   utilsjson.Uint64(offer.Start),
+				End:                     utilsjson.Uint64(offer.End),
+				MinAmount:               utilsjson.Uint64(offer.MinAmount),
+				MinDuration:             utilsjson.Uint32(offer.MinDuration),
+				MaxDuration:             utilsjson.Uint32(offer.MaxDuration),
+				UnlockPeriodDuration:    utilsjson.Uint32(offer.UnlockPeriodDuration),
+				NoRewardsPeriodDuration: utilsjson.Uint32(offer.NoRewardsPeriodDuration),
+				Flags:                   utilsjson.Uint64(offer.Flags),
+			})
+		}
+	}
+
+	return nil
+}
+
+type GetDepositsArgs struct {
+	DepositTxIDs []ids.ID `json:"depositTxIDs"`
+}
+
+// APIDeposit is a deposit with its rewards and unlockable amount
+// calculated at current chain time
+type APIDeposit struct {
+	DepositTxID         ids.ID           `json:"depositTxID"`
+	DepositOfferID      ids.ID           `json:"depositOfferID"`
+	UnlockedAmount      utilsjson.Uint64 `json:"unlockedAmount"`
+	ClaimedRewardAmount utilsjson.Uint64 `json:"claimedRewardAmount"`
+	Start               utilsjson.Uint64 `json:"start"`
+	Duration            utilsjson.Uint32 `json:"duration"`
+	Amount              utilsjson.Uint64 `json:"amount"`
+	UnlockableAmount    utilsjson.Uint64 `json:"unlockableAmount"`
+	ClaimableReward     utilsjson.Uint64 `json:"claimableReward"`
+	TotalReward         utilsjson.Uint64 `json:"totalReward"`
+}
+
+type GetDepositsReply struct {
+	Deposits []*APIDeposit `json:"deposits"`
+	// Chain time at which unlockable amounts and claimable rewards were calculated
+	Timestamp utilsjson.Uint64 `json:"timestamp"`
+}
+
+// GetDeposits returns deposits by their tx ids
+func (s *CaminoService) GetDeposits(_ *http.Request, args *GetDepositsArgs, reply *GetDepositsReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetDeposits called")
+
+	return s.getDeposits(args.DepositTxIDs, reply)
+}
+
+type GetDepositsByAddressArgs struct {
+	Address string `json:"address"`
+}
+
+// GetDepositsByAddress returns deposits which rewards owner or
+// deposited outputs owner contains address
+func (s *CaminoService) GetDepositsByAddress(_ *http.Request, args *GetDepositsByAddressArgs, reply *GetDepositsReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetDepositsByAddress called")
+
+	address, err := avax.ParseServiceAddress(s.addrManager, args.Address)
+	if err != nil {
+		return fmt.Errorf("couldn't parse address: %w", err)
+	}
+
+	depositTxIDs, err := s.vm.state.GetDepositIDsByAddress(address)
+	if err != nil {
+		return err
+	}
+
+	return s.getDeposits(depositTxIDs, reply)
+}
+
+func (s *CaminoService) getDeposits(depositTxIDs []ids.ID, reply *GetDepositsReply) error {
+	currentTimestamp := uint64(s.vm.state.GetTimestamp().Unix())
+
+	reply.Timestamp = utilsjson.Uint64(currentTimestamp)
+	reply.Deposits = make([]*APIDeposit, len(depositTxIDs))
+	for i, depositTxID := range depositTxIDs {
+		deposit, err := s.vm.state.GetDeposit(depositTxID)
+		if err != nil {
+			return fmt.Errorf("couldn't get deposit %s: %w", depositTxID, err)
+		}
+
+		offer, err := s.vm.state.GetDepositOffer(deposit.DepositOfferID)
+		if err != nil {
+			return fmt.Errorf("couldn't get deposit offer %s: %w", deposit.DepositOfferID, err)
+		}
+
+		reply.Deposits[i] = &APIDeposit{
+			DepositTxID:         depositTxID,
+			DepositOfferID:      deposit.DepositOfferID,
+			UnlockedAmount:      utilsjson.Uint64(deposit.UnlockedAmount),
+			ClaimedRewardAmount: utilsjson.Uint64(deposit.ClaimedRewardAmount),
+			Start:               utilsjson.Uint64(deposit.Start),
+			Duration:            utilsjson.Uint32(deposit.Duration),
+			Amount:              utilsjson.Uint64(deposit.Amount),
+			UnlockableAmount:    utilsjson.Uint64(deposit.UnlockableAmount(offer, currentTimestamp)),
+			ClaimableReward:     utilsjson.Uint64(deposit.ClaimableReward(offer, currentTimestamp)),
+			TotalReward:         utilsjson.Uint64(deposit.TotalReward(offer)),
+		}
+	}
+
+	return nil
+}
+
+type GetMultisigAliasArgs struct {
+	Alias string `json:"alias"`
+}
+
+type GetMultisigAliasReply struct {
+	Threshold utilsjson.Uint32 `json:"threshold"`
+	Addresses []string         `json:"addresses"`
+}
+
+// GetMultisigAlias returns owners and threshold of multisig alias
+func (s *CaminoService) GetMultisigAlias(_ *http.Request, args *GetMultisigAliasArgs, reply *GetMultisigAliasReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetMultisigAlias called")
+
+	alias, err := avax.ParseServiceAddress(s.addrManager, args.Alias)
+	if err != nil {
+		return fmt.Errorf("couldn't parse alias: %w", err)
+	}
+
+	owner, err := s.vm.state.GetMultisigOwner(alias)
+	if err != nil {
+		return fmt.Errorf("couldn't get multisig alias %s: %w", args.Alias, err)
+	}
+
+	reply.Threshold = utilsjson.Uint32(owner.Owners.Threshold)
+	reply.Addresses = make([]string, len(owner.Owners.Addrs))
+	for i, addr := range owner.Owners.Addrs {
+		if reply.Addresses[i], err = s.addrManager.FormatLocalAddress(addr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type GetAddressStatesArgs struct {
+	Address string `json:"address"`
+}
+
+// GetAddressStatesReply is the decoded address state
+type GetAddressStatesReply struct {
+	RoleAdmin      bool `json:"roleAdmin"`
+	RoleKyc        bool `json:"roleKyc"`
+	RoleValidator  bool `json:"roleValidator"`
+	KycVerified    bool `json:"kycVerified"`
+	KycExpired     bool `json:"kycExpired"`
+	Consortium     bool `json:"consortium"`
+	RegisteredNode bool `json:"registeredNode"`
+	// Unix timestamp at which kyc verification expires, zero if it never expires
+	KycExpiration utilsjson.Uint64 `json:"kycExpiration"`
+}
+
+// GetAddressStates returns decoded states of address
+func (s *CaminoService) GetAddressStates(_ *http.Request, args *GetAddressStatesArgs, reply *GetAddressStatesReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetAddressStates called")
+
+	address, err := avax.ParseServiceAddress(s.addrManager, args.Address)
+	if err != nil {
+		return fmt.Errorf("couldn't parse address: %w", err)
+	}
+
+	states, err := s.vm.state.GetAddressStates(address)
+	if err != nil {
+		return err
+	}
+
+	reply.RoleAdmin = states&txs.AddressStateRoleAdminBit != 0
+	reply.RoleKyc = states&txs.AddressStateRoleKycBit != 0
+	reply.RoleValidator = states&txs.AddressStateRoleValidatorBit != 0
+	reply.KycVerified = states&txs.AddressStateKycVerifiedBit != 0
+	reply.KycExpired = states&txs.AddressStateKycExpiredBit != 0
+	reply.Consortium = states&txs.AddressStateConsortiumBit != 0
+	reply.RegisteredNode = states&txs.AddressStateRegisteredNodeBit != 0
+
+	kycExpiration, err := s.vm.state.GetKycExpiration(address)
+	switch err {
+	case nil:
+		reply.KycExpiration = utilsjson.Uint64(kycExpiration)
+	case database.ErrNotFound:
+	default:
+		return err
+	}
+
+	return nil
+}
+
+type GetRegisteredShortIDLinkArgs struct {
+	// Consortium member address or registered node id
+	Address string `json:"address"`
+}
+
+type GetRegisteredShortIDLinkReply struct {
+	// Registered node id or consortium member address
+	Address string `json:"address"`
+}
+
+// GetRegisteredShortIDLink returns node id registered by consortium member
+// address or consortium member address that registered node id
+func (s *CaminoService) GetRegisteredShortIDLink(_ *http.Request, args *GetRegisteredShortIDLinkArgs, reply *GetRegisteredShortIDLinkReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetRegisteredShortIDLink called")
+
+	if nodeID, err := ids.NodeIDFromString(args.Address); err == nil {
+		addr, err := s.vm.state.GetNodeConsortiumMember(nodeID)
+		if err != nil {
+			return fmt.Errorf("couldn't get consortium member of node %s: %w", nodeID, err)
+		}
+		reply.Address, err = s.addrManager.FormatLocalAddress(addr)
+		return err
+	}
+
+	addr, err := avax.ParseServiceAddress(s.addrManager, args.Address)
+	if err != nil {
+		return fmt.Errorf("couldn't parse address: %w", err)
+	}
+
+	nodeID, err := s.vm.state.GetConsortiumMemberNode(addr)
+	if err != nil {
+		return fmt.Errorf("couldn't get node registered by %s: %w", args.Address, err)
+	}
+	reply.Address = nodeID.String()
+	return nil
+}
+
+type GetAddressTxsArgs struct {
+	api.JSONAddress
+	// Cursor used as a page index / offset
+	Cursor utilsjson.Uint64 `json:"cursor"`
+	// PageSize num of items per page
+	PageSize utilsjson.Uint64 `json:"pageSize"`
+	// AssetID defaulted to AVAX if omitted or left blank
+	AssetID string `json:"assetID"`
+}
+
+type GetAddressTxsReply struct {
+	TxIDs []ids.ID `json:"txIDs"`
+	// Cursor used as a page index / offset
+	Cursor utilsjson.Uint64 `json:"cursor"`
+}
+
+// GetAddressTxs returns ids of accepted txs, that consumed or produced utxos
+// owned by address. Requires address transaction indexing to be enabled in
+// the chain config.
+func (s *CaminoService) GetAddressTxs(_ *http.Request, args *GetAddressTxsArgs, reply *GetAddressTxsReply) error {
+	cursor := uint64(args.Cursor)
+	pageSize := uint64(args.PageSize)
+	s.vm.ctx.Log.Debug("Platform: GetAddressTxs called",
+		logging.UserString("address", args.Address),
+		logging.UserString("assetID", args.AssetID),
+		zap.Uint64("cursor", cursor),
+		zap.Uint64("pageSize", pageSize),
+	)
+
+	if pageSize > builder.MaxPageSize {
+		return fmt.Errorf("pageSize > maximum allowed (%d)", builder.MaxPageSize)
+	} else if pageSize == 0 {
+		pageSize = builder.MaxPageSize
+	}
+
+	address, err := avax.ParseServiceAddress(s.addrManager, args.Address)
+	if err != nil {
+		return fmt.Errorf("couldn't parse address: %w", err)
+	}
+
+	assetID := s.vm.ctx.AVAXAssetID
+	if args.AssetID != "" {
+		assetID, err = ids.FromString(args.AssetID)
+		if err != nil {
+			return fmt.Errorf("couldn't parse assetID: %w", err)
+		}
+	}
+
+	reply.TxIDs, err = s.vm.addressTxsIndex.Indexer.Read(address[:], assetID, cursor, pageSize)
+	if err != nil {
+		return err
+	}
+
+	// To get the next set of tx IDs, the user should provide this cursor.
+	reply.Cursor = utilsjson.Uint64(cursor + uint64(len(reply.TxIDs)))
+	return nil
+}
+
 func (s *Service) getKeystoreKeys(args *api.JSONSpendHeader) (*secp256k1fx.Keychain, error) {
 	// Parse the from addresses
 	fromAddrs, err := avax.ParseServiceAddresses(s.addrManager, args.From)
@@ -496,3 +864,17 @@ func (s *Service) buildAddressStateTx(args *SetAddressStateArgs, keys *secp256k1
 	}
 	return tx, nil
 }
+
+// getAPIUptimeEstimate returns the uptime of the primary network validator
+// [staker], that was estimated from the uptime proofs of other validators.
+func (s *Service) getAPIUptimeEstimate(staker *state.Staker) *utilsjson.Float32 {
+	if staker.SubnetID != constants.PrimaryNetworkID {
+		return nil
+	}
+	estimate, ok := s.vm.uptimeProofTracker.Estimate(staker.NodeID)
+	if !ok {
+		return nil
+	}
+	uptime := utilsjson.Float32(estimate.Uptime)
+	return &uptime
+}
@@ -6,10 +6,14 @@ package platformvm
 import (
 	"context"
 	"fmt"
+
+	stdjson "encoding/json"
 	"testing"
 
+	apiutils "github.com/ava-labs/avalanchego/api"
 	"github.com/ava-labs/avalanchego/api/keystore"
 	"github.com/ava-labs/avalanchego/database/manager"
+	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
@@ -17,9 +21,14 @@ import (
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/version"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/index"
 	"github.com/ava-labs/avalanchego/vms/platformvm/api"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/genesis"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/builder"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
 )
 
@@ -169,6 +178,185 @@ func TestGetCaminoBalance(t *testing.T) {
 	}
 }
 
+func TestGetDepositOffers(t *testing.T) {
+	activeOffer := genesis.DepositOffer{
+		InterestRateNominator:   1,
+		Start:                   100,
+		End:                     200,
+		MinAmount:               2,
+		MinDuration:             10,
+		MaxDuration:             20,
+		UnlockPeriodDuration:    5,
+		NoRewardsPeriodDuration: 6,
+	}
+	lockedOffer := activeOffer
+	lockedOffer.Flags = deposit.OfferFlagLocked
+	inactiveOffer := activeOffer
+	inactiveOffer.Start = 300
+	inactiveOffer.End = 400
+
+	toAPIOffer := func(t *testing.T, offer genesis.DepositOffer) *APIDepositOffer {
+		offerID, err := offer.ID()
+		require.NoError(t, err)
+		return &APIDepositOffer{
+			ID:                      offerID,
+			InterestRateNominator:   json.Uint64(offer.InterestRateNominator),
+			Start:                   json.Uint64(offer.Start),
+			End:                     json.Uint64(offer.End),
+			MinAmount:               json.Uint64(offer.MinAmount),
+			MinDuration:             json.Uint32(offer.MinDuration),
+			MaxDuration:             json.Uint32(offer.MaxDuration),
+			UnlockPeriodDuration:    json.Uint32(offer.UnlockPeriodDuration),
+			NoRewardsPeriodDuration: json.Uint32(offer.NoRewardsPeriodDuration),
+			Flags:                   json.Uint64(offer.Flags),
+		}
+	}
+
+	tests := map[string]struct {
+		timestamp      uint64
+		expectedOffers []genesis.DepositOffer
+	}{
+		"All offers": {
+			expectedOffers: []genesis.DepositOffer{activeOffer, lockedOffer, inactiveOffer},
+		},
+		"Offers active at timestamp": {
+			timestamp:      150,
+			expectedOffers: []genesis.DepositOffer{activeOffer},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			service := defaultCaminoService(t, api.Camino{
+				LockModeBondDeposit: true,
+				DepositOffers:       []genesis.DepositOffer{activeOffer, lockedOffer, inactiveOffer},
+			}, []api.UTXO{})
+			service.vm.ctx.Lock.Lock()
+			defer func() {
+				require.NoError(t, service.vm.Shutdown(context.Background()))
+				service.vm.ctx.Lock.Unlock()
+			}()
+
+			reply := GetDepositOffersReply{}
+			require.NoError(t, service.GetDepositOffers(nil, &GetDepositOffersArgs{
+				Timestamp: json.Uint64(tt.timestamp),
+			}, &reply))
+
+			expectedOffers := make([]*APIDepositOffer, len(tt.expectedOffers))
+			for i := range tt.expectedOffers {
+				expectedOffers[i] = toAPIOffer(t, tt.expectedOffers[i])
+			}
+			require.ElementsMatch(t, expectedOffers, reply.DepositOffers)
+		})
+	}
+}
+
+func TestAPIDepositOfferJSON(t *testing.T) {
+	offerBytes, err := stdjson.Marshal(&APIDepositOffer{
+		InterestRateNominator: 1,
+		Start:                 2,
+		MinDuration:           3,
+	})
+	require.NoError(t, err)
+
+	fields := map[string]interface{}{}
+	require.NoError(t, stdjson.Unmarshal(offerBytes, &fields))
+	require.Equal(t, "1", fields["interestRateNominator"])
+	require.Equal(t, "2", fields["start"])
+	require.Equal(t, "3", fields["minDuration"])
+}
+
+func TestGetAddressTxs(t *testing.T) {
+	hrp := constants.NetworkIDToHRP[testNetworkID]
+	addrID := keys[0].PublicKey().Address()
+	addr, err := address.Format("P", hrp, addrID.Bytes())
+	require.NoError(t, err)
+	otherAssetID := ids.ID{1}
+
+	tests := map[string]struct {
+		args          GetAddressTxsArgs
+		expectedReply GetAddressTxsReply
+		expectedErr   bool
+	}{
+		"Page size is too big": {
+			args: GetAddressTxsArgs{
+				JSONAddress: apiutils.JSONAddress{Address: addr},
+				PageSize:    json.Uint64(builder.MaxPageSize + 1),
+			},
+			expectedErr: true,
+		},
+		"Bad address": {
+			args:        GetAddressTxsArgs{JSONAddress: apiutils.JSONAddress{Address: "bad"}},
+			expectedErr: true,
+		},
+		"Bad assetID": {
+			args: GetAddressTxsArgs{
+				JSONAddress: apiutils.JSONAddress{Address: addr},
+				AssetID:     "bad",
+			},
+			expectedErr: true,
+		},
+		"All txs": {
+			args: GetAddressTxsArgs{JSONAddress: apiutils.JSONAddress{Address: addr}},
+			expectedReply: GetAddressTxsReply{
+				TxIDs:  []ids.ID{{1}, {2}, {3}},
+				Cursor: 3,
+			},
+		},
+		"Page": {
+			args: GetAddressTxsArgs{
+				JSONAddress: apiutils.JSONAddress{Address: addr},
+				Cursor:      1,
+				PageSize:    1,
+			},
+			expectedReply: GetAddressTxsReply{
+				TxIDs:  []ids.ID{{2}},
+				Cursor: 2,
+			},
+		},
+		"Other asset": {
+			args: GetAddressTxsArgs{
+				JSONAddress: apiutils.JSONAddress{Address: addr},
+				AssetID:     otherAssetID.String(),
+			},
+			expectedReply: GetAddressTxsReply{
+				TxIDs:  []ids.ID{{4}},
+				Cursor: 1,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			service := defaultCaminoService(t, api.Camino{}, []api.UTXO{})
+			service.vm.ctx.Lock.Lock()
+			defer func() {
+				require.NoError(t, service.vm.Shutdown(context.Background()))
+				service.vm.ctx.Lock.Unlock()
+			}()
+
+			indexer, err := index.NewIndexer(memdb.New(), logging.NoLog{}, "", prometheus.NewRegistry(), false)
+			require.NoError(t, err)
+			service.vm.addressTxsIndex.Indexer = indexer
+
+			owners := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addrID}}
+			avaxUTXO := generateTestUTXO(ids.ID{10}, service.vm.ctx.AVAXAssetID, 1, owners, ids.Empty, ids.Empty)
+			otherUTXO := generateTestUTXO(ids.ID{11}, otherAssetID, 1, owners, ids.Empty, ids.Empty)
+			require.NoError(t, indexer.Accept(ids.ID{1}, []*avax.UTXO{avaxUTXO}, nil))
+			require.NoError(t, indexer.Accept(ids.ID{2}, nil, []*avax.UTXO{avaxUTXO}))
+			require.NoError(t, indexer.Accept(ids.ID{3}, []*avax.UTXO{avaxUTXO}, []*avax.UTXO{avaxUTXO}))
+			require.NoError(t, indexer.Accept(ids.ID{4}, []*avax.UTXO{otherUTXO}, nil))
+
+			reply := GetAddressTxsReply{}
+			err = service.GetAddressTxs(nil, &tt.args, &reply)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedReply, reply)
+		})
+	}
+}
+
 func defaultCaminoService(t *testing.T, camino api.Camino, utxos []api.UTXO) *CaminoService {
 	vm, _, _ := newCaminoVM(camino, utxos)
 
@@ -0,0 +1,244 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/cache"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/vms/platformvm/statesync"
+	"github.com/ava-labs/avalanchego/vms/platformvm/teleporter"
+)
+
+const defaultStateSyncSummaryFrequency = 4096
+
+var (
+	_ block.StateSyncableVM = (*VM)(nil)
+	_ block.StateSummary    = (*stateSummary)(nil)
+
+	stateSyncPrefix = []byte("stateSync")
+)
+
+// stateSyncDB returns the database of state sync summaries and downloads.
+// Unlike other databases of the vm, it's nested into the vm database, so
+// its records are seen as the raw records of the vm database, that must not
+// be synced.
+func (vm *VM) stateSyncDB() database.Database {
+	return prefixdb.NewNested(stateSyncPrefix, vm.dbManager.Current().Database)
+}
+
+// stateSyncExcludedPrefixes returns prefixes of the raw vm database records,
+// that don't belong to the chain state.
+func stateSyncExcludedPrefixes() [][]byte {
+	return [][]byte{
+		hashing.ComputeHash256(stateSyncPrefix),
+		hashing.ComputeHash256(addressTxsIndexPrefix),
+	}
+}
+
+// recoverStateSync completes replacing of the chain state with the synced one,
+// if the node was stopped in the middle of it. Must be called before the
+// chain state is loaded.
+func (vm *VM) recoverStateSync(config ChainConfig, appSender common.AppSender) error {
+	vm.stateSyncEnabled = config.StateSyncEnabled
+	vm.stateSyncer = statesync.NewSyncer(
+		vm.ctx.Log,
+		vm.ctx.NodeID,
+		vm.stateSyncDB(),
+		appSender,
+		0,
+	)
+	return vm.stateSyncer.Recover(vm.dbManager.Current().Database, stateSyncExcludedPrefixes()...)
+}
+
+// initStateSyncServer initializes the server of state summaries, which are
+// built every [config.StateSyncSummaryFrequency] accepted blocks.
+func (vm *VM) initStateSyncServer(config ChainConfig, appSender common.AppSender) error {
+	server, err := statesync.NewServer(
+		vm.ctx.Log,
+		vm.dbManager.Current().Database,
+		vm.stateSyncDB(),
+		config.StateSyncSummaryFrequency,
+		appSender,
+		stateSyncExcludedPrefixes()...,
+	)
+	if err != nil {
+		return err
+	}
+	vm.stateSyncServer = server
+	return nil
+}
+
+func (vm *VM) StateSyncEnabled(context.Context) (bool, error) {
+	return vm.stateSyncEnabled, nil
+}
+
+// GetOngoingSyncStateSummary always returns database.ErrNotFound, as
+// interrupted syncs are started over.
+func (*VM) GetOngoingSyncStateSummary(context.Context) (block.StateSummary, error) {
+	return nil, database.ErrNotFound
+}
+
+func (vm *VM) GetLastStateSummary(context.Context) (block.StateSummary, error) {
+	summary, err := vm.stateSyncServer.GetLastSummary()
+	if err != nil {
+		return nil, err
+	}
+	return &stateSummary{Summary: summary, vm: vm}, nil
+}
+
+func (vm *VM) ParseStateSummary(_ context.Context, summaryBytes []byte) (block.StateSummary, error) {
+	summary, err := statesync.ParseSummary(summaryBytes)
+	if err != nil {
+		return nil, err
+	}
+	return &stateSummary{Summary: summary, vm: vm}, nil
+}
+
+func (vm *VM) GetStateSummary(_ context.Context, summaryHeight uint64) (block.StateSummary, error) {
+	summary, err := vm.stateSyncServer.GetSummary(summaryHeight)
+	if err != nil {
+		return nil, err
+	}
+	return &stateSummary{Summary: summary, vm: vm}, nil
+}
+
+type stateSummary struct {
+	*statesync.Summary
+	vm *VM
+}
+
+// Accept starts downloading the summary in the background, if it's ahead of
+// the local chain state. The engine is notified, when the download is done.
+// The chain state is replaced with the downloaded one, when the engine moves
+// on to bootstrapping.
+func (s *stateSummary) Accept(context.Context) (bool, error) {
+	vm := s.vm
+	lastAccepted, _, err := vm.state.GetStatelessBlock(vm.state.GetLastAccepted())
+	if err != nil {
+		return false, err
+	}
+	if !vm.stateSyncEnabled || s.BlockHeight <= lastAccepted.Height() {
+		vm.ctx.Log.Info("skipping state sync",
+			zap.Uint64("summaryHeight", s.BlockHeight),
+			zap.Uint64("lastAcceptedHeight", lastAccepted.Height()),
+		)
+		return false, nil
+	}
+
+	vm.ctx.Log.Info("starting state sync",
+		zap.Stringer("summaryID", s.ID()),
+		zap.Uint64("summaryHeight", s.BlockHeight),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	vm.stateSyncCancel = cancel
+	vm.stateSyncWG.Add(1)
+	go func() {
+		defer vm.stateSyncWG.Done()
+
+		start := time.Now()
+		if err := vm.stateSyncer.Sync(ctx, s.Summary); err != nil {
+			vm.ctx.Log.Warn("state sync failed",
+				zap.Stringer("summaryID", s.ID()),
+				zap.Error(err),
+			)
+			if err := vm.stateSyncer.Abort(); err != nil {
+				vm.ctx.Log.Warn("failed to drop synced state", zap.Error(err))
+			}
+		} else {
+			vm.ctx.Log.Info("state sync downloaded summary",
+				zap.Stringer("summaryID", s.ID()),
+				zap.Duration("duration", time.Since(start)),
+			)
+			vm.stateSynced.SetValue(true)
+		}
+
+		// The engine continues with bootstrapping in both cases
+		select {
+		case vm.toEngine <- common.StateSyncDone:
+		case <-ctx.Done():
+		}
+	}()
+	return true, nil
+}
+
+// finishStateSync replaces the chain state with the downloaded one, if the
+// state sync succeeded.
+func (vm *VM) finishStateSync(ctx context.Context) error {
+	if !vm.stateSynced.GetValue() {
+		return nil
+	}
+	vm.stateSynced.SetValue(false)
+
+	if err := vm.stateSyncer.Apply(vm.dbManager.Current().Database, stateSyncExcludedPrefixes()...); err != nil {
+		return err
+	}
+	if err := vm.state.Reload(); err != nil {
+		return err
+	}
+
+	lastAcceptedID := vm.state.GetLastAccepted()
+	vm.manager.SetLastAccepted(lastAcceptedID)
+	vm.validatorSetCaches = make(map[ids.ID]cache.Cacher)
+	if err := vm.initBlockchains(); err != nil {
+		return err
+	}
+
+	vm.ctx.Log.Info("replaced chain state with synced one",
+		zap.Stringer("blkID", lastAcceptedID),
+	)
+	return vm.SetPreference(ctx, lastAcceptedID)
+}
+
+// AppRequest routes teleporter signature requests to the teleporter handler
+// and all other requests to the state sync server. Both request formats are
+// strictly parsed, so a request can't be valid for both of them.
+func (vm *VM) AppRequest(ctx context.Context, nodeID ids.NodeID, requestID uint32, deadline time.Time, request []byte) error {
+	if _, err := teleporter.ParseSignatureRequest(request); err == nil {
+		return vm.teleporterHandler.AppRequest(ctx, nodeID, requestID, deadline, request)
+	}
+	return vm.stateSyncServer.AppRequest(ctx, nodeID, requestID, deadline, request)
+}
+
+func (vm *VM) AppResponse(ctx context.Context, nodeID ids.NodeID, requestID uint32, response []byte) error {
+	if vm.teleporterSignatureGetter.AppResponse(requestID, response) {
+		return nil
+	}
+	if vm.stateSyncer.AppResponse(requestID, response) {
+		return nil
+	}
+	return vm.Builder.AppResponse(ctx, nodeID, requestID, response)
+}
+
+func (vm *VM) AppRequestFailed(ctx context.Context, nodeID ids.NodeID, requestID uint32) error {
+	if vm.teleporterSignatureGetter.AppRequestFailed(requestID) {
+		return nil
+	}
+	if vm.stateSyncer.AppRequestFailed(requestID) {
+		return nil
+	}
+	return vm.Builder.AppRequestFailed(ctx, nodeID, requestID)
+}
+
+// shutdownStateSync stops building of state summaries and the ongoing state
+// sync.
+func (vm *VM) shutdownStateSync() {
+	if vm.stateSyncCancel != nil {
+		vm.stateSyncCancel()
+	}
+	vm.stateSyncWG.Wait()
+	if vm.stateSyncServer != nil {
+		vm.stateSyncServer.Shutdown()
+	}
+}
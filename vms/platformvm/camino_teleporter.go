@@ -0,0 +1,22 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/vms/platformvm/teleporter"
+)
+
+// teleporterFirstRequestID is the id of the first signature request sent by
+// the VM. Ids below it are used by the state syncer.
+const teleporterFirstRequestID = 1 << 31
+
+// initTeleporter initializes the handler, that signs teleporter messages on
+// requests of other nodes, and the aggregator, that collects signatures of
+// other validators over teleporter messages.
+func (vm *VM) initTeleporter(appSender common.AppSender) {
+	vm.teleporterHandler = teleporter.NewHandler(vm.ctx.Log, vm.ctx.TeleporterSigner, appSender)
+	vm.teleporterSignatureGetter = teleporter.NewNetworkSignatureGetter(appSender, teleporterFirstRequestID)
+	vm.teleporterAggregator = teleporter.NewAggregator(vm.ctx.Log, vm, vm.teleporterSignatureGetter)
+}
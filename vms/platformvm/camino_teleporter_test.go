@@ -0,0 +1,58 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/platformvm/teleporter"
+)
+
+func TestTeleporterSignatureRequests(t *testing.T) {
+	require := require.New(t)
+
+	sk, err := bls.NewSecretKey()
+	require.NoError(err)
+	vm := &VM{ctx: snow.DefaultContextTest()}
+	vm.ctx.TeleporterSigner = teleporter.NewSigner(sk, vm.ctx.ChainID)
+
+	// Requests and responses are looped back into the same VM, so it serves
+	// its own signature requests.
+	appSender := &common.SenderTest{T: t}
+	appSender.SendAppRequestF = func(ctx context.Context, nodeIDs set.Set[ids.NodeID], requestID uint32, request []byte) error {
+		require.GreaterOrEqual(requestID, uint32(teleporterFirstRequestID))
+		for nodeID := range nodeIDs {
+			require.NoError(vm.AppRequest(ctx, nodeID, requestID, time.Time{}, request))
+		}
+		return nil
+	}
+	appSender.SendAppResponseF = func(ctx context.Context, nodeID ids.NodeID, requestID uint32, response []byte) error {
+		return vm.AppResponse(ctx, nodeID, requestID, response)
+	}
+	vm.initTeleporter(appSender)
+
+	msg, err := teleporter.NewUnsignedMessage(vm.ctx.ChainID, ids.GenerateTestID(), []byte("payload"))
+	require.NoError(err)
+	nodeID := ids.GenerateTestNodeID()
+
+	// not signable messages aren't signed
+	_, err = vm.teleporterSignatureGetter.GetSignature(context.Background(), nodeID, msg)
+	require.Error(err)
+
+	vm.teleporterHandler.MarkSignable(msg)
+	expectedSig, err := vm.ctx.TeleporterSigner.Sign(msg)
+	require.NoError(err)
+	sig, err := vm.teleporterSignatureGetter.GetSignature(context.Background(), nodeID, msg)
+	require.NoError(err)
+	require.Equal(expectedSig, sig)
+}
@@ -0,0 +1,197 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/uptime"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/platformvm/message"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/uptimeproof"
+)
+
+const (
+	defaultUptimeProofFrequency = 2 * time.Minute
+	defaultUptimeProofMaxAge    = 10 * time.Minute
+
+	// Uptime estimates are used for reward voting, only if their observers
+	// hold at least [uptimeEstimateMinWeightRatio] of the weight of the other
+	// validators.
+	uptimeEstimateMinWeightRatio = 0.5
+)
+
+// initUptimeProofs initializes the tracker of the uptime proofs of other
+// validators. Returns the uptime manager, which is used for reward voting.
+func (vm *VM) initUptimeProofs(config ChainConfig) uptime.Manager {
+	vm.uptimeProofsEnabled = config.UptimeProofsEnabled
+	vm.uptimeProofFrequency = config.UptimeProofFrequency
+	vm.uptimeProofTracker = uptimeproof.NewTracker(vm.ctx.ChainID, vm.Validators, config.UptimeProofMaxAge)
+
+	if config.UptimeProofRewardVoting {
+		vm.ctx.Log.Info("using uptime estimates of other validators for reward voting")
+		return uptimeproof.NewManager(vm.uptimeManager, vm.uptimeProofTracker, uptimeEstimateMinWeightRatio)
+	}
+	return vm.uptimeManager
+}
+
+// startUptimeProofs starts signing and gossiping the uptime proofs of this
+// node every [vm.uptimeProofFrequency], if it's enabled.
+func (vm *VM) startUptimeProofs() {
+	if !vm.uptimeProofsEnabled || vm.uptimeProofsCancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	vm.uptimeProofsCancel = cancel
+	vm.uptimeProofsWG.Add(1)
+	go func() {
+		defer vm.uptimeProofsWG.Done()
+
+		ticker := time.NewTicker(vm.uptimeProofFrequency)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := vm.gossipUptimeProof(ctx); err != nil {
+					vm.ctx.Log.Warn("failed to gossip uptime proof",
+						zap.Error(err),
+					)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// shutdownUptimeProofs stops signing and gossiping the uptime proofs.
+func (vm *VM) shutdownUptimeProofs() {
+	if vm.uptimeProofsCancel != nil {
+		vm.uptimeProofsCancel()
+	}
+	vm.uptimeProofsWG.Wait()
+}
+
+// gossipUptimeProof signs the uptimes of the primary network validators, that
+// are observed by this node, and gossips them. Nothing is gossiped, if this
+// node isn't a primary network validator.
+func (vm *VM) gossipUptimeProof(ctx context.Context) error {
+	unsignedProof, err := vm.buildUnsignedUptimeProof()
+	if err != nil || unsignedProof == nil {
+		return err
+	}
+
+	proof, err := uptimeproof.NewProof(vm.ctx.ChainID, unsignedProof, vm.ctx.TeleporterSigner)
+	if err != nil {
+		return err
+	}
+	if _, err := vm.uptimeProofTracker.Add(vm.ctx.NodeID, proof); err != nil {
+		vm.ctx.Log.Debug("own uptime proof was rejected",
+			zap.Error(err),
+		)
+	}
+
+	msgBytes, err := message.Build(&message.UptimeProof{Proof: proof.Bytes()})
+	if err != nil {
+		return fmt.Errorf("failed to build UptimeProof message: %w", err)
+	}
+	return vm.appSender.SendAppGossip(ctx, msgBytes)
+}
+
+func (vm *VM) buildUnsignedUptimeProof() (*uptimeproof.UnsignedProof, error) {
+	vm.ctx.Lock.Lock()
+	defer vm.ctx.Lock.Unlock()
+
+	if !vm.bootstrapped.GetValue() {
+		return nil, nil
+	}
+	primaryVdrs, exists := vm.Validators.Get(constants.PrimaryNetworkID)
+	if !exists {
+		return nil, errMissingValidatorSet
+	}
+	if !primaryVdrs.Contains(vm.ctx.NodeID) {
+		return nil, nil
+	}
+
+	currentStakerIterator, err := vm.state.GetCurrentStakerIterator()
+	if err != nil {
+		return nil, err
+	}
+	defer currentStakerIterator.Release()
+
+	unsignedProof := &uptimeproof.UnsignedProof{
+		Signer:    vm.ctx.NodeID,
+		Timestamp: vm.clock.Unix(),
+	}
+	for currentStakerIterator.Next() {
+		staker := currentStakerIterator.Value()
+		if staker.SubnetID != constants.PrimaryNetworkID ||
+			staker.Priority != txs.PrimaryNetworkValidatorCurrentPriority ||
+			staker.NodeID == vm.ctx.NodeID {
+			continue
+		}
+		uptime, err := vm.uptimeManager.CalculateUptimePercentFrom(staker.NodeID, staker.SubnetID, staker.StartTime)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate uptime of %s: %w", staker.NodeID, err)
+		}
+		unsignedProof.Observations = append(unsignedProof.Observations, uptimeproof.Observation{
+			NodeID: staker.NodeID,
+			Uptime: uint32(uptime * reward.PercentDenominator),
+		})
+	}
+	utils.Sort(unsignedProof.Observations)
+	return unsignedProof, nil
+}
+
+func (vm *VM) AppGossip(ctx context.Context, nodeID ids.NodeID, msgBytes []byte) error {
+	msgIntf, err := message.Parse(msgBytes)
+	if err == nil {
+		if msg, ok := msgIntf.(*message.UptimeProof); ok {
+			vm.handleUptimeProof(ctx, nodeID, msg)
+			return nil
+		}
+	}
+	return vm.Builder.AppGossip(ctx, nodeID, msgBytes)
+}
+
+// handleUptimeProof verifies the uptime proof received from [nodeID] and
+// gossips it further, if it wasn't known yet. Invalid proofs are dropped.
+func (vm *VM) handleUptimeProof(ctx context.Context, nodeID ids.NodeID, msg *message.UptimeProof) {
+	proof, err := uptimeproof.ParseProof(msg.Proof)
+	if err != nil {
+		vm.ctx.Log.Debug("dropping malformed uptime proof",
+			zap.Stringer("nodeID", nodeID),
+			zap.Error(err),
+		)
+		return
+	}
+	added, err := vm.uptimeProofTracker.Add(nodeID, proof)
+	if err != nil {
+		vm.ctx.Log.Debug("dropping invalid uptime proof",
+			zap.Stringer("nodeID", nodeID),
+			zap.Stringer("signer", proof.Unsigned().Signer),
+			zap.Error(err),
+		)
+		return
+	}
+	if !added {
+		return
+	}
+	if err := vm.appSender.SendAppGossip(ctx, msg.Bytes()); err != nil {
+		vm.ctx.Log.Debug("failed to gossip uptime proof",
+			zap.Stringer("signer", proof.Unsigned().Signer),
+			zap.Error(err),
+		)
+	}
+}
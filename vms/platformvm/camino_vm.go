@@ -0,0 +1,111 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/database/versiondb"
+	"github.com/ava-labs/avalanchego/vms/components/index"
+
+	blockexecutor "github.com/ava-labs/avalanchego/vms/platformvm/blocks/executor"
+)
+
+var (
+	addressTxsIndexPrefix = []byte("addressTxsIndex")
+
+	errNonPositiveUptimeProofFrequency = errors.New("uptime proof frequency must be > 0")
+	errNonPositiveUptimeProofMaxAge    = errors.New("uptime proof max age must be > 0")
+)
+
+// ChainConfig is the P-chain configuration, that could be provided with the
+// chain config file.
+type ChainConfig struct {
+	IndexTransactions    bool `json:"index-transactions"`
+	IndexAllowIncomplete bool `json:"index-allow-incomplete"`
+
+	// StateSyncEnabled allows this node to sync the chain state from other
+	// nodes instead of executing all blocks since genesis.
+	StateSyncEnabled bool `json:"state-sync-enabled"`
+	// StateSyncSummaryFrequency is the number of accepted blocks, after which
+	// the state summary is built and served to syncing nodes. Zero disables
+	// building of summaries.
+	StateSyncSummaryFrequency uint64 `json:"state-sync-summary-frequency"`
+
+	// UptimeProofsEnabled enables signing and gossiping of the uptimes, that
+	// are observed by this node, if it's a primary network validator.
+	UptimeProofsEnabled bool `json:"uptime-proofs-enabled"`
+	// UptimeProofFrequency is the interval between the uptime proofs of this
+	// node.
+	UptimeProofFrequency time.Duration `json:"uptime-proof-frequency"`
+	// UptimeProofMaxAge is the age, after which the uptime proofs of other
+	// validators are ignored.
+	UptimeProofMaxAge time.Duration `json:"uptime-proof-max-age"`
+	// UptimeProofRewardVoting makes this node vote on validator rewards with
+	// the uptimes estimated from the uptime proofs of other validators,
+	// instead of the locally observed ones. Proofs are only accepted from
+	// validators with registered BLS keys, otherwise the locally observed
+	// uptimes are used.
+	UptimeProofRewardVoting bool `json:"uptime-proof-reward-voting"`
+}
+
+func parseChainConfig(configBytes []byte) (ChainConfig, error) {
+	config := ChainConfig{
+		StateSyncSummaryFrequency: defaultStateSyncSummaryFrequency,
+		UptimeProofFrequency:      defaultUptimeProofFrequency,
+		UptimeProofMaxAge:         defaultUptimeProofMaxAge,
+	}
+	if len(configBytes) == 0 {
+		return config, nil
+	}
+	if err := json.Unmarshal(configBytes, &config); err != nil {
+		return config, fmt.Errorf("failed to parse chain config: %w", err)
+	}
+	if config.UptimeProofFrequency <= 0 {
+		return config, errNonPositiveUptimeProofFrequency
+	}
+	if config.UptimeProofMaxAge <= 0 {
+		return config, errNonPositiveUptimeProofMaxAge
+	}
+	return config, nil
+}
+
+// initAddressTxsIndex initializes the address txs index. No-op indexer is used,
+// if indexing is disabled in [config].
+func (vm *VM) initAddressTxsIndex(config ChainConfig, registerer prometheus.Registerer) error {
+	db := versiondb.New(prefixdb.New(addressTxsIndexPrefix, vm.dbManager.Current().Database))
+
+	var (
+		indexer index.AddressTxsIndexer
+		err     error
+	)
+	if config.IndexTransactions {
+		vm.ctx.Log.Info("address transaction indexing is enabled")
+		indexer, err = index.NewIndexer(db, vm.ctx.Log, "", registerer, config.IndexAllowIncomplete)
+		if err != nil {
+			return fmt.Errorf("failed to initialize address transaction indexer: %w", err)
+		}
+	} else {
+		vm.ctx.Log.Info("address transaction indexing is disabled")
+		indexer, err = index.NewNoIndexer(db, config.IndexAllowIncomplete)
+		if err != nil {
+			return fmt.Errorf("failed to initialize disabled indexer: %w", err)
+		}
+	}
+	if err := db.Commit(); err != nil {
+		return err
+	}
+
+	vm.addressTxsIndex = &blockexecutor.AddressTxsIndex{
+		Indexer: indexer,
+		DB:      db,
+	}
+	return nil
+}
@@ -3,6 +3,28 @@
 
 package config
 
+import (
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/math"
+)
+
 type CaminoConfig struct {
-	DaoProposalBondAmount uint64
+	// RegisteredNodes, if not nil, is kept up to date with the nodes
+	// registered to consortium members in the committed state. It should be
+	// the same set, that is given to the network config.
+	RegisteredNodes validators.RegisteredNodes
+}
+
+// ScaleFee returns [staticFee] of some tx type scaled by the ratio of
+// [baseFee], which can be changed by DAO proposals, to the default tx fee.
+// This way base fee changes apply to fees of all tx types proportionally.
+func (c *Config) ScaleFee(staticFee, baseFee uint64) (uint64, error) {
+	if baseFee == c.TxFee || c.TxFee == 0 {
+		return staticFee, nil
+	}
+	fee, err := math.Mul64(staticFee, baseFee)
+	if err != nil {
+		return 0, err
+	}
+	return fee / c.TxFee, nil
 }
@@ -0,0 +1,190 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+)
+
+const (
+	// MaxBaseFeeOptions is the maximum number of options that base fee proposal can have
+	MaxBaseFeeOptions = 3
+
+	// AddDepositOfferOptionAccept is the option index voting for adding proposed deposit offer
+	AddDepositOfferOptionAccept uint32 = 0
+	// AddDepositOfferOptionReject is the option index voting against adding proposed deposit offer
+	AddDepositOfferOptionReject uint32 = 1
+)
+
+var (
+	_ Proposal = (*BaseFeeProposal)(nil)
+	_ Proposal = (*AddDepositOfferProposal)(nil)
+
+	ErrStartNotBeforeEnd = errors.New("proposal start time is not before its end time")
+	ErrWrongOptionsCount = errors.New("wrong proposal options count")
+	ErrZeroFee           = errors.New("base fee option is zero")
+	ErrNotUniqueOption   = errors.New("proposal options aren't unique")
+	ErrNilDepositOffer   = errors.New("deposit offer is nil")
+	ErrWrongOptionIndex  = errors.New("option index is out of options range")
+	ErrAlreadyVoted      = errors.New("voter already voted for this proposal")
+	ErrNotActiveProposal = errors.New("proposal isn't active")
+)
+
+type Proposal interface {
+	verify.Verifiable
+
+	StartTime() time.Time
+	EndTime() time.Time
+	// OptionsCount returns number of options that voters can vote for.
+	OptionsCount() int
+}
+
+// BaseFeeProposal is a proposal to change the base tx fee to one of its options.
+type BaseFeeProposal struct {
+	Start   uint64   `serialize:"true" json:"start"`
+	End     uint64   `serialize:"true" json:"end"`
+	Options []uint64 `serialize:"true" json:"options"`
+}
+
+func (p *BaseFeeProposal) StartTime() time.Time {
+	return time.Unix(int64(p.Start), 0)
+}
+
+func (p *BaseFeeProposal) EndTime() time.Time {
+	return time.Unix(int64(p.End), 0)
+}
+
+func (p *BaseFeeProposal) OptionsCount() int {
+	return len(p.Options)
+}
+
+func (p *BaseFeeProposal) Verify() error {
+	switch {
+	case p.Start >= p.End:
+		return fmt.Errorf("%w: %d >= %d", ErrStartNotBeforeEnd, p.Start, p.End)
+	case len(p.Options) == 0 || len(p.Options) > MaxBaseFeeOptions:
+		return fmt.Errorf("%w: expected [1, %d], got %d", ErrWrongOptionsCount, MaxBaseFeeOptions, len(p.Options))
+	}
+
+	for i, option := range p.Options {
+		if option == 0 {
+			return ErrZeroFee
+		}
+		for _, prevOption := range p.Options[:i] {
+			if prevOption == option {
+				return ErrNotUniqueOption
+			}
+		}
+	}
+	return nil
+}
+
+// AddDepositOfferProposal is a proposal to add new deposit offer. Voters can
+// either accept or reject it.
+type AddDepositOfferProposal struct {
+	Start        uint64         `serialize:"true" json:"start"`
+	End          uint64         `serialize:"true" json:"end"`
+	DepositOffer *deposit.Offer `serialize:"true" json:"depositOffer"`
+}
+
+func (p *AddDepositOfferProposal) StartTime() time.Time {
+	return time.Unix(int64(p.Start), 0)
+}
+
+func (p *AddDepositOfferProposal) EndTime() time.Time {
+	return time.Unix(int64(p.End), 0)
+}
+
+func (*AddDepositOfferProposal) OptionsCount() int {
+	return 2
+}
+
+func (p *AddDepositOfferProposal) Verify() error {
+	switch {
+	case p.Start >= p.End:
+		return fmt.Errorf("%w: %d >= %d", ErrStartNotBeforeEnd, p.Start, p.End)
+	case p.DepositOffer == nil:
+		return ErrNilDepositOffer
+	}
+	return p.DepositOffer.Verify()
+}
+
+// ProposalState is a proposal with its voting progress.
+type ProposalState struct {
+	ID ids.ID `json:"id"`
+
+	Proposal Proposal `serialize:"true" json:"proposal"`
+	// Votes count for each proposal option
+	Votes []uint64 `serialize:"true" json:"votes"`
+	// Sorted addresses that already voted
+	Voters []ids.ShortID `serialize:"true" json:"voters"`
+}
+
+func NewProposalState(proposalID ids.ID, proposal Proposal) *ProposalState {
+	return &ProposalState{
+		ID:       proposalID,
+		Proposal: proposal,
+		Votes:    make([]uint64, proposal.OptionsCount()),
+		Voters:   []ids.ShortID{},
+	}
+}
+
+// IsActiveAt returns true if proposal is accepting votes at [chainTime].
+func (p *ProposalState) IsActiveAt(chainTime time.Time) bool {
+	return !chainTime.Before(p.Proposal.StartTime()) && chainTime.Before(p.Proposal.EndTime())
+}
+
+// AddVote returns updated copy of proposal state with [voter] vote for option [optionIndex].
+func (p *ProposalState) AddVote(voter ids.ShortID, optionIndex uint32) (*ProposalState, error) {
+	if int(optionIndex) >= len(p.Votes) {
+		return nil, fmt.Errorf("%w: %d >= %d", ErrWrongOptionIndex, optionIndex, len(p.Votes))
+	}
+
+	voters := make([]ids.ShortID, 0, len(p.Voters)+1)
+	for _, existingVoter := range p.Voters {
+		if existingVoter == voter {
+			return nil, ErrAlreadyVoted
+		}
+		voters = append(voters, existingVoter)
+	}
+	voters = append(voters, voter)
+	utils.Sort(voters)
+
+	votes := make([]uint64, len(p.Votes))
+	copy(votes, p.Votes)
+	votes[optionIndex]++
+
+	return &ProposalState{
+		ID:       p.ID,
+		Proposal: p.Proposal,
+		Votes:    votes,
+		Voters:   voters,
+	}, nil
+}
+
+// Outcome returns index of the option that got more than a half of all votes.
+// If there is no such option or proposal got less than [quorum] votes,
+// returns false.
+func (p *ProposalState) Outcome(quorum uint64) (uint32, bool) {
+	totalVotes := uint64(0)
+	for _, votes := range p.Votes {
+		totalVotes += votes
+	}
+	if totalVotes < quorum {
+		return 0, false
+	}
+	for optionIndex, votes := range p.Votes {
+		if votes > totalVotes/2 {
+			return uint32(optionIndex), true
+		}
+	}
+	return 0, false
+}
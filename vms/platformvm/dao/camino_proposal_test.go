@@ -0,0 +1,88 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+func TestBaseFeeProposalVerify(t *testing.T) {
+	tests := map[string]struct {
+		proposal    *BaseFeeProposal
+		expectedErr error
+	}{
+		"Start not before end": {
+			proposal:    &BaseFeeProposal{Start: 2, End: 2, Options: []uint64{1}},
+			expectedErr: ErrStartNotBeforeEnd,
+		},
+		"No options": {
+			proposal:    &BaseFeeProposal{Start: 1, End: 2},
+			expectedErr: ErrWrongOptionsCount,
+		},
+		"Too many options": {
+			proposal:    &BaseFeeProposal{Start: 1, End: 2, Options: []uint64{1, 2, 3, 4}},
+			expectedErr: ErrWrongOptionsCount,
+		},
+		"Zero fee option": {
+			proposal:    &BaseFeeProposal{Start: 1, End: 2, Options: []uint64{1, 0}},
+			expectedErr: ErrZeroFee,
+		},
+		"Not unique options": {
+			proposal:    &BaseFeeProposal{Start: 1, End: 2, Options: []uint64{1, 2, 1}},
+			expectedErr: ErrNotUniqueOption,
+		},
+		"OK": {
+			proposal: &BaseFeeProposal{Start: 1, End: 2, Options: []uint64{1, 2, 3}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.proposal.Verify(), tt.expectedErr)
+		})
+	}
+}
+
+func TestProposalStateAddVote(t *testing.T) {
+	require := require.New(t)
+
+	voter1, voter2, voter3 := ids.ShortID{1}, ids.ShortID{2}, ids.ShortID{3}
+	proposal := NewProposalState(ids.ID{1}, &BaseFeeProposal{Start: 1, End: 2, Options: []uint64{1, 2}})
+
+	_, err := proposal.AddVote(voter1, 2)
+	require.ErrorIs(err, ErrWrongOptionIndex)
+
+	_, ok := proposal.Outcome(0)
+	require.False(ok)
+
+	updatedProposal, err := proposal.AddVote(voter2, 1)
+	require.NoError(err)
+	require.Equal([]uint64{0, 0}, proposal.Votes) // original state isn't modified
+	require.Equal([]uint64{0, 1}, updatedProposal.Votes)
+
+	_, err = updatedProposal.AddVote(voter2, 0)
+	require.ErrorIs(err, ErrAlreadyVoted)
+
+	updatedProposal, err = updatedProposal.AddVote(voter1, 0)
+	require.NoError(err)
+	require.Equal([]ids.ShortID{voter1, voter2}, updatedProposal.Voters)
+	_, ok = updatedProposal.Outcome(0)
+	require.False(ok)
+
+	updatedProposal, err = updatedProposal.AddVote(voter3, 1)
+	require.NoError(err)
+	outcome, ok := updatedProposal.Outcome(0)
+	require.True(ok)
+	require.Equal(uint32(1), outcome)
+
+	// not enough votes to reach quorum
+	_, ok = updatedProposal.Outcome(4)
+	require.False(ok)
+	outcome, ok = updatedProposal.Outcome(3)
+	require.True(ok)
+	require.Equal(uint32(1), outcome)
+}
@@ -4,13 +4,14 @@
 package deposit
 
 import (
+	"errors"
+	"fmt"
 	"math/big"
 	"time"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/hashing"
 	"github.com/ava-labs/avalanchego/utils/math"
-	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
 )
 
 const (
@@ -20,25 +21,33 @@ const (
 	OfferFlagLocked uint64 = 0b1
 )
 
-var bigInterestRateDenominator = (&big.Int{}).SetInt64(interestRateDenominator)
+var (
+	bigInterestRateDenominator = (&big.Int{}).SetInt64(interestRateDenominator)
+
+	ErrOfferStartNotBeforeEnd         = errors.New("deposit offer starttime is not before its endtime")
+	ErrOfferMinDurationGreaterThanMax = errors.New("deposit offer minimum duration is greater than maximum duration")
+	ErrOfferZeroMinDuration           = errors.New("deposit offer has zero minimum duration")
+	ErrOfferNoRewardsPeriodTooBig     = errors.New("deposit offer minimum duration is less than no-rewards period duration")
+	ErrOfferUnlockPeriodTooBig        = errors.New("deposit offer minimum duration is less than unlock period duration")
+)
 
 type Offer struct {
-	ID ids.ID
-
-	InterestRateNominator   uint64 `serialize:"true"`
-	Start                   uint64 `serialize:"true"`
-	End                     uint64 `serialize:"true"`
-	MinAmount               uint64 `serialize:"true"`
-	MinDuration             uint32 `serialize:"true"`
-	MaxDuration             uint32 `serialize:"true"`
-	UnlockPeriodDuration    uint32 `serialize:"true"`
-	NoRewardsPeriodDuration uint32 `serialize:"true"`
-	Flags                   uint64 `serialize:"true"`
+	ID ids.ID `json:"id"`
+
+	InterestRateNominator   uint64 `serialize:"true" json:"interestRateNominator"`
+	Start                   uint64 `serialize:"true" json:"start"`
+	End                     uint64 `serialize:"true" json:"end"`
+	MinAmount               uint64 `serialize:"true" json:"minAmount"`
+	MinDuration             uint32 `serialize:"true" json:"minDuration"`
+	MaxDuration             uint32 `serialize:"true" json:"maxDuration"`
+	UnlockPeriodDuration    uint32 `serialize:"true" json:"unlockPeriodDuration"`
+	NoRewardsPeriodDuration uint32 `serialize:"true" json:"noRewardsPeriodDuration"`
+	Flags                   uint64 `serialize:"true" json:"flags"`
 }
 
 // Sets offer id from its bytes hash
 func (o *Offer) SetID() error {
-	bytes, err := blocks.GenesisCodec.Marshal(blocks.Version, o)
+	bytes, err := c.Marshal(codecVersion, o)
 	if err != nil {
 		return err
 	}
@@ -56,6 +65,29 @@ func (o *Offer) EndTime() time.Time {
 	return time.Unix(int64(o.End), 0)
 }
 
+// IsActiveAt returns true if deposits can be created with this offer
+// at [timestamp] (seconds)
+func (o *Offer) IsActiveAt(timestamp uint64) bool {
+	return o.Flags&OfferFlagLocked == 0 && o.Start <= timestamp && timestamp <= o.End
+}
+
+// Verify returns nil if offer bounds are consistent with each other
+func (o *Offer) Verify() error {
+	switch {
+	case o.Start >= o.End:
+		return fmt.Errorf("%w: %d >= %d", ErrOfferStartNotBeforeEnd, o.Start, o.End)
+	case o.MinDuration > o.MaxDuration:
+		return ErrOfferMinDurationGreaterThanMax
+	case o.MinDuration == 0:
+		return ErrOfferZeroMinDuration
+	case o.MinDuration < o.NoRewardsPeriodDuration:
+		return fmt.Errorf("%w: %d < %d", ErrOfferNoRewardsPeriodTooBig, o.MinDuration, o.NoRewardsPeriodDuration)
+	case o.MinDuration < o.UnlockPeriodDuration:
+		return fmt.Errorf("%w: %d < %d", ErrOfferUnlockPeriodTooBig, o.MinDuration, o.UnlockPeriodDuration)
+	}
+	return nil
+}
+
 func (o *Offer) InterestRateFloat64() float64 {
 	return float64(o.InterestRateNominator) / float64(interestRateDenominator)
 }
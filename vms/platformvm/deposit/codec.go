@@ -0,0 +1,25 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package deposit
+
+import (
+	"math"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/codec/linearcodec"
+)
+
+const codecVersion = 0
+
+// c is used to calculate deposit offers ids. Offers don't contain interfaces,
+// so this codec produces the same bytes as platformvm genesis codec, but it
+// doesn't depend on txs package and offers can be used as part of txs.
+var c codec.Manager
+
+func init() {
+	c = codec.NewManager(math.MaxInt32)
+	if err := c.RegisterCodec(codecVersion, linearcodec.NewCustomMaxLength(math.MaxInt32)); err != nil {
+		panic(err)
+	}
+}
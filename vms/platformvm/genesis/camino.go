@@ -26,6 +26,26 @@ type Camino struct {
 	Deposits                 []*txs.Tx                `serialize:"true"`
 	ConsortiumMembersNodeIDs []ConsortiumMemberNodeID `serialize:"true"`
 	InitialMultisigAddresses []MultisigAlias          `serialize:"true"`
+	// ValidatorRewardRate is the fixed annual rate, in reward.PercentDenominator
+	// units, at which primary network validators are rewarded in
+	// LockModeBondDeposit mode after the AthensPhase upgrade.
+	ValidatorRewardRate uint64 `serializeV1:"true"`
+	// DaoProposalBondAmount is the amount, which is bonded by proposer to
+	// place DAO proposal. DAO proposals can't be placed if it's zero.
+	DaoProposalBondAmount uint64 `serializeV1:"true"`
+	// DaoProposalQuorum is the minimal number of votes a DAO proposal
+	// must receive to have an outcome.
+	DaoProposalQuorum uint64 `serializeV1:"true"`
+}
+
+// CodecVersion returns the codec version genesis with [c] must be marshaled
+// with. Genesis without params added by later upgrades keeps its original
+// encoding, so that ids of already existing genesis don't change.
+func (c *Camino) CodecVersion() uint16 {
+	if c.ValidatorRewardRate != 0 || c.DaoProposalBondAmount != 0 || c.DaoProposalQuorum != 0 {
+		return blocks.GenesisVersion1
+	}
+	return blocks.Version
 }
 
 type ConsortiumMemberNodeID struct {
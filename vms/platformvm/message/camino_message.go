@@ -0,0 +1,21 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package message
+
+import (
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+var _ Message = (*UptimeProof)(nil)
+
+// UptimeProof gossips the signed uptime observations of a validator.
+type UptimeProof struct {
+	message
+
+	Proof []byte `serialize:"true"`
+}
+
+func (msg *UptimeProof) Handle(handler Handler, nodeID ids.NodeID, requestID uint32) error {
+	return handler.HandleUptimeProof(nodeID, requestID, msg)
+}
@@ -26,6 +26,7 @@ func init() {
 	errs := wrappers.Errs{}
 	errs.Add(
 		lc.RegisterType(&Tx{}),
+		lc.RegisterType(&UptimeProof{}),
 		c.RegisterCodec(codecVersion, lc),
 	)
 	if errs.Errored() {
@@ -14,6 +14,7 @@ var _ Handler = NoopHandler{}
 
 type Handler interface {
 	HandleTx(nodeID ids.NodeID, requestID uint32, msg *Tx) error
+	HandleUptimeProof(nodeID ids.NodeID, requestID uint32, msg *UptimeProof) error
 }
 
 type NoopHandler struct {
@@ -27,3 +28,11 @@ func (h NoopHandler) HandleTx(nodeID ids.NodeID, requestID uint32, _ *Tx) error
 	)
 	return nil
 }
+
+func (h NoopHandler) HandleUptimeProof(nodeID ids.NodeID, requestID uint32, _ *UptimeProof) error {
+	h.Log.Debug("dropping unexpected UptimeProof message",
+		zap.Stringer("nodeID", nodeID),
+		zap.Uint32("requestID", requestID),
+	)
+	return nil
+}
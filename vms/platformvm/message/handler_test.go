@@ -13,7 +13,8 @@ import (
 )
 
 type CounterHandler struct {
-	Tx int
+	Tx          int
+	UptimeProof int
 }
 
 func (h *CounterHandler) HandleTx(ids.NodeID, uint32, *Tx) error {
@@ -21,6 +22,11 @@ func (h *CounterHandler) HandleTx(ids.NodeID, uint32, *Tx) error {
 	return nil
 }
 
+func (h *CounterHandler) HandleUptimeProof(ids.NodeID, uint32, *UptimeProof) error {
+	h.UptimeProof++
+	return nil
+}
+
 func TestHandleTx(t *testing.T) {
 	require := require.New(t)
 
@@ -32,6 +38,17 @@ func TestHandleTx(t *testing.T) {
 	require.Equal(1, handler.Tx)
 }
 
+func TestHandleUptimeProof(t *testing.T) {
+	require := require.New(t)
+
+	handler := CounterHandler{}
+	msg := UptimeProof{}
+
+	err := msg.Handle(&handler, ids.EmptyNodeID, 0)
+	require.NoError(err)
+	require.Equal(1, handler.UptimeProof)
+}
+
 func TestNoopHandler(t *testing.T) {
 	require := require.New(t)
 
@@ -41,4 +58,7 @@ func TestNoopHandler(t *testing.T) {
 
 	err := handler.HandleTx(ids.EmptyNodeID, 0, nil)
 	require.NoError(err)
+
+	err = handler.HandleUptimeProof(ids.EmptyNodeID, 0, nil)
+	require.NoError(err)
 }
@@ -16,7 +16,15 @@ type caminoTxMetrics struct {
 	numAddAddressStateTxs,
 	numDepositTxs,
 	numUnlockDepositTxs,
-	numRegisterNodeTx prometheus.Counter
+	numRegisterNodeTx,
+	numAddDepositOfferTxs,
+	numClaimTxs,
+	numAddProposalTxs,
+	numAddVoteTxs,
+	numFinishProposalsTxs,
+	numMultisigAliasTxs,
+	numMultisigAliasPolicyTxs,
+	numSetKycExpirationTxs prometheus.Counter
 }
 
 func newCaminoTxMetrics(
@@ -32,10 +40,18 @@ func newCaminoTxMetrics(
 	m := &caminoTxMetrics{
 		txMetrics: *txm,
 		// Camino specific tx metrics
-		numAddAddressStateTxs: newTxMetric(namespace, "add_address_state", registerer, &errs),
-		numDepositTxs:         newTxMetric(namespace, "deposit", registerer, &errs),
-		numUnlockDepositTxs:   newTxMetric(namespace, "unlock_deposit", registerer, &errs),
-		numRegisterNodeTx:     newTxMetric(namespace, "register_node", registerer, &errs),
+		numAddAddressStateTxs:     newTxMetric(namespace, "add_address_state", registerer, &errs),
+		numDepositTxs:             newTxMetric(namespace, "deposit", registerer, &errs),
+		numUnlockDepositTxs:       newTxMetric(namespace, "unlock_deposit", registerer, &errs),
+		numRegisterNodeTx:         newTxMetric(namespace, "register_node", registerer, &errs),
+		numAddDepositOfferTxs:     newTxMetric(namespace, "add_deposit_offer", registerer, &errs),
+		numClaimTxs:               newTxMetric(namespace, "claim", registerer, &errs),
+		numAddProposalTxs:         newTxMetric(namespace, "add_proposal", registerer, &errs),
+		numAddVoteTxs:             newTxMetric(namespace, "add_vote", registerer, &errs),
+		numFinishProposalsTxs:     newTxMetric(namespace, "finish_proposals", registerer, &errs),
+		numMultisigAliasTxs:       newTxMetric(namespace, "multisig_alias", registerer, &errs),
+		numMultisigAliasPolicyTxs: newTxMetric(namespace, "multisig_alias_policy", registerer, &errs),
+		numSetKycExpirationTxs:    newTxMetric(namespace, "set_kyc_expiration", registerer, &errs),
 	}
 	return m, errs.Err
 }
@@ -58,6 +74,38 @@ func (*txMetrics) RegisterNodeTx(*txs.RegisterNodeTx) error {
 	return nil
 }
 
+func (*txMetrics) AddDepositOfferTx(*txs.AddDepositOfferTx) error {
+	return nil
+}
+
+func (*txMetrics) ClaimTx(*txs.ClaimTx) error {
+	return nil
+}
+
+func (*txMetrics) AddProposalTx(*txs.AddProposalTx) error {
+	return nil
+}
+
+func (*txMetrics) AddVoteTx(*txs.AddVoteTx) error {
+	return nil
+}
+
+func (*txMetrics) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	return nil
+}
+
+func (*txMetrics) MultisigAliasTx(*txs.MultisigAliasTx) error {
+	return nil
+}
+
+func (*txMetrics) MultisigAliasPolicyTx(*txs.MultisigAliasPolicyTx) error {
+	return nil
+}
+
+func (*txMetrics) SetKycExpirationTx(*txs.SetKycExpirationTx) error {
+	return nil
+}
+
 // camino metrics
 
 func (m *caminoTxMetrics) AddAddressStateTx(*txs.AddAddressStateTx) error {
@@ -79,3 +127,43 @@ func (m *caminoTxMetrics) RegisterNodeTx(*txs.RegisterNodeTx) error {
 	m.numRegisterNodeTx.Inc()
 	return nil
 }
+
+func (m *caminoTxMetrics) AddDepositOfferTx(*txs.AddDepositOfferTx) error {
+	m.numAddDepositOfferTxs.Inc()
+	return nil
+}
+
+func (m *caminoTxMetrics) ClaimTx(*txs.ClaimTx) error {
+	m.numClaimTxs.Inc()
+	return nil
+}
+
+func (m *caminoTxMetrics) AddProposalTx(*txs.AddProposalTx) error {
+	m.numAddProposalTxs.Inc()
+	return nil
+}
+
+func (m *caminoTxMetrics) AddVoteTx(*txs.AddVoteTx) error {
+	m.numAddVoteTxs.Inc()
+	return nil
+}
+
+func (m *caminoTxMetrics) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	m.numFinishProposalsTxs.Inc()
+	return nil
+}
+
+func (m *caminoTxMetrics) MultisigAliasTx(*txs.MultisigAliasTx) error {
+	m.numMultisigAliasTxs.Inc()
+	return nil
+}
+
+func (m *caminoTxMetrics) MultisigAliasPolicyTx(*txs.MultisigAliasPolicyTx) error {
+	m.numMultisigAliasPolicyTxs.Inc()
+	return nil
+}
+
+func (m *caminoTxMetrics) SetKycExpirationTx(*txs.SetKycExpirationTx) error {
+	m.numSetKycExpirationTxs.Inc()
+	return nil
+}
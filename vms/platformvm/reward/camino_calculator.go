@@ -0,0 +1,57 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package reward
+
+import (
+	"math/big"
+	"time"
+)
+
+var _ Calculator = (*caminoCalculator)(nil)
+
+type caminoCalculator struct {
+	rewardRate    *big.Int
+	mintingPeriod *big.Int
+	supplyCap     uint64
+}
+
+// NewCaminoCalculator returns a calculator that rewards stakers with a fixed
+// annual [rewardRate] of their stake. [rewardRate] is expressed in
+// [PercentDenominator] units and applies per [c.MintingPeriod].
+func NewCaminoCalculator(rewardRate uint64, c Config) Calculator {
+	return &caminoCalculator{
+		rewardRate:    new(big.Int).SetUint64(rewardRate),
+		mintingPeriod: new(big.Int).SetUint64(uint64(c.MintingPeriod)),
+		supplyCap:     c.SupplyCap,
+	}
+}
+
+// Calculate returns the amount of tokens to reward the staker with.
+//
+// Reward = StakedAmount * RewardRate * StakingDuration / MintingPeriod
+//
+// Reward is capped by SupplyCap - ExistingSupply.
+func (c *caminoCalculator) Calculate(stakedDuration time.Duration, stakedAmount, currentSupply uint64) uint64 {
+	if currentSupply >= c.supplyCap || c.mintingPeriod.Sign() == 0 {
+		return 0
+	}
+	remainingSupply := c.supplyCap - currentSupply
+
+	reward := new(big.Int).SetUint64(stakedAmount)
+	reward.Mul(reward, c.rewardRate)
+	reward.Mul(reward, new(big.Int).SetUint64(uint64(stakedDuration)))
+	reward.Div(reward, consumptionRateDenominator)
+	reward.Div(reward, c.mintingPeriod)
+
+	if !reward.IsUint64() {
+		return remainingSupply
+	}
+
+	finalReward := reward.Uint64()
+	if finalReward > remainingSupply {
+		return remainingSupply
+	}
+
+	return finalReward
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package reward
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/utils/units"
+)
+
+func TestCaminoCalculator(t *testing.T) {
+	tests := map[string]struct {
+		rewardRate     uint64
+		stakedDuration time.Duration
+		stakedAmount   uint64
+		currentSupply  uint64
+		expectedReward uint64
+	}{
+		"Full minting period": {
+			rewardRate:     .10 * PercentDenominator,
+			stakedDuration: defaultConfig.MintingPeriod,
+			stakedAmount:   units.KiloAvax,
+			currentSupply:  360 * units.MegaAvax,
+			expectedReward: 100 * units.Avax,
+		},
+		"Half minting period": {
+			rewardRate:     .10 * PercentDenominator,
+			stakedDuration: defaultConfig.MintingPeriod / 2,
+			stakedAmount:   units.KiloAvax,
+			currentSupply:  360 * units.MegaAvax,
+			expectedReward: 50 * units.Avax,
+		},
+		"Zero rate": {
+			rewardRate:     0,
+			stakedDuration: defaultConfig.MintingPeriod,
+			stakedAmount:   units.KiloAvax,
+			currentSupply:  360 * units.MegaAvax,
+			expectedReward: 0,
+		},
+		"Capped by remaining supply": {
+			rewardRate:     .10 * PercentDenominator,
+			stakedDuration: defaultConfig.MintingPeriod,
+			stakedAmount:   units.KiloAvax,
+			currentSupply:  defaultConfig.SupplyCap - units.Avax,
+			expectedReward: units.Avax,
+		},
+		"Supply cap reached": {
+			rewardRate:     .10 * PercentDenominator,
+			stakedDuration: defaultConfig.MintingPeriod,
+			stakedAmount:   units.KiloAvax,
+			currentSupply:  defaultConfig.SupplyCap,
+			expectedReward: 0,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewCaminoCalculator(tt.rewardRate, defaultConfig)
+			reward := c.Calculate(tt.stakedDuration, tt.stakedAmount, tt.currentSupply)
+			require.Equal(t, tt.expectedReward, reward)
+		})
+	}
+}
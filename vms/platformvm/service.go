@@ -762,6 +762,7 @@ func (s *Service) GetCurrentValidators(_ *http.Request, args *GetCurrentValidato
 			vdr := platformapi.PermissionlessValidator{
 				Staker:                apiStaker,
 				Uptime:                uptime,
+				UptimeEstimate:        s.getAPIUptimeEstimate(currentStaker),
 				Connected:             connected,
 				PotentialReward:       &potentialReward,
 				RewardOwner:           validationRewardOwner,
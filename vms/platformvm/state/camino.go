@@ -6,6 +6,7 @@ package state
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/ava-labs/avalanchego/cache"
 	"github.com/ava-labs/avalanchego/cache/metercacher"
@@ -14,14 +15,17 @@ import (
 	"github.com/ava-labs/avalanchego/database/prefixdb"
 	"github.com/ava-labs/avalanchego/database/versiondb"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/math"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/wrappers"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/genesis"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/prometheus/client_golang/prometheus"
@@ -40,11 +44,23 @@ var (
 	addressStatePrefix          = []byte("addressState")
 	depositOffersPrefix         = []byte("depositOffers")
 	depositsPrefix              = []byte("deposits")
+	depositOwnersPrefix         = []byte("depositOwners")
+	depositIDsByAddressPrefix   = []byte("depositIDsByAddress")
 	multisigOwnersPrefix        = []byte("multisigOwners")
+	multisigPoliciesPrefix      = []byte("multisigPolicies")
 	ConsortiumMemberNodesPrefix = []byte("consortiumMemberNodes")
-
-	nodeSignatureKey   = []byte("nodeSignature")
-	depositBondModeKey = []byte("depositBondMode")
+	nodesByConsortiumPrefix     = []byte("nodesByConsortiumMember")
+	proposalsPrefix             = []byte("proposals")
+	kycExpirationsPrefix        = []byte("kycExpirations")
+
+	nodeSignatureKey       = []byte("nodeSignature")
+	depositBondModeKey     = []byte("depositBondMode")
+	baseFeeKey             = []byte("baseFee")
+	validatorRewardRateKey = []byte("validatorRewardRate")
+	daoProposalBondKey     = []byte("daoProposalBond")
+	daoProposalQuorumKey   = []byte("daoProposalQuorum")
+	depositsIndexedKey     = []byte("depositsIndexed")
+	nodesIndexedKey        = []byte("nodesIndexed")
 
 	errWrongTxType      = errors.New("unexpected tx type")
 	errNonExistingOffer = errors.New("deposit offer doesn't exist")
@@ -69,18 +85,51 @@ type CaminoDiff interface {
 
 	// Deposits
 
+	// AddDeposit adds new deposit and indexes it by its [owners] addresses
+	AddDeposit(depositTxID ids.ID, deposit *deposit.Deposit, owners []ids.ShortID)
 	UpdateDeposit(depositTxID ids.ID, deposit *deposit.Deposit)
 	GetDeposit(depositTxID ids.ID) (*deposit.Deposit, error)
+	// GetDepositIDsByAddress returns sorted ids of deposit txs,
+	// which rewards owner or deposited outputs owner contains [address]
+	GetDepositIDsByAddress(address ids.ShortID) ([]ids.ID, error)
 
 	// Multisig Owners
 
 	GetMultisigOwner(ids.ShortID) (*MultisigOwner, error)
 	SetMultisigOwner(*MultisigOwner)
+	GetMultisigPolicy(ids.ShortID) (*MultisigPolicy, error)
+	// SetMultisigPolicy sets alias spending policy, empty policy removes it
+	SetMultisigPolicy(*MultisigPolicy)
 
 	// Consortium member nodes
 
 	SetNodeConsortiumMember(nodeID ids.NodeID, addr *ids.ShortID)
 	GetNodeConsortiumMember(nodeID ids.NodeID) (ids.ShortID, error)
+	// GetConsortiumMemberNode returns id of the node registered by
+	// consortium member with [addr]
+	GetConsortiumMemberNode(addr ids.ShortID) (ids.NodeID, error)
+
+	// DAO proposals
+
+	// precondition: proposal.ID must be set
+	SetProposal(proposal *dao.ProposalState)
+	RemoveProposal(proposalID ids.ID)
+	GetProposal(proposalID ids.ID) (*dao.ProposalState, error)
+	GetAllProposals() ([]*dao.ProposalState, error)
+
+	// KYC expirations
+
+	SetKycExpiration(address ids.ShortID, expiration uint64)
+	RemoveKycExpiration(address ids.ShortID)
+	GetKycExpiration(address ids.ShortID) (uint64, error)
+	// GetAllKycExpirations returns expiration timestamps of all addresses
+	// with expiring KYC verification
+	GetAllKycExpirations() (map[ids.ShortID]uint64, error)
+
+	// Base fee
+
+	SetBaseFee(baseFee uint64)
+	GetBaseFee() (uint64, error)
 }
 
 // For state and diff
@@ -97,22 +146,33 @@ type CaminoState interface {
 
 	CaminoConfig() *CaminoConfig
 	SyncGenesis(*state, *genesis.State) error
-	Load() error
+	Load(*state) error
+	Reset()
 	Write() error
+	Committed()
+	Abort()
 	Close() error
 }
 
 type CaminoConfig struct {
-	VerifyNodeSignature bool
-	LockModeBondDeposit bool
+	VerifyNodeSignature   bool
+	LockModeBondDeposit   bool
+	ValidatorRewardRate   uint64
+	DaoProposalBondAmount uint64
+	DaoProposalQuorum     uint64
 }
 
 type caminoDiff struct {
 	modifiedAddressStates         map[ids.ShortID]uint64
 	modifiedDepositOffers         map[ids.ID]*deposit.Offer
 	modifiedDeposits              map[ids.ID]*deposit.Deposit
+	modifiedDepositOwners         map[ids.ID][]ids.ShortID
 	modifiedMultisigOwners        map[ids.ShortID]*MultisigOwner
+	modifiedMultisigPolicies      map[ids.ShortID]*MultisigPolicy
 	modifiedConsortiumMemberNodes map[ids.NodeID]*ids.ShortID
+	modifiedProposals             map[ids.ID]*dao.ProposalState
+	modifiedKycExpirations        map[ids.ShortID]uint64
+	modifiedBaseFee               *uint64
 }
 
 type caminoState struct {
@@ -122,6 +182,9 @@ type caminoState struct {
 	genesisSynced       bool
 	verifyNodeSignature bool
 	lockModeBondDeposit bool
+	validatorRewardRate uint64
+	daoProposalBond     uint64
+	daoProposalQuorum   uint64
 
 	// Address State
 	addressStateCache cache.Cacher
@@ -133,15 +196,36 @@ type caminoState struct {
 	depositOffersDB   database.Database
 
 	// Deposits
-	depositsCache cache.Cacher
-	depositsDB    database.Database
+	depositsCache         cache.Cacher
+	depositsDB            database.Database
+	depositOwnersDB       database.Database
+	depositIDsByAddressDB database.Database
 
 	// MSIG aliases
-	multisigOwnersDB database.Database
+	multisigOwnersDB   database.Database
+	multisigPoliciesDB database.Database
 
 	// Consortium member nodes
 	consortiumMemberNodesCache cache.Cacher
 	consortiumMemberNodesDB    database.Database
+	nodesByConsortiumMemberDB  database.Database
+	// Kept up to date with the committed consortium member nodes, can be nil
+	registeredNodes validators.RegisteredNodes
+	// Consortium member nodes, that are written, but not yet committed to
+	// the database, nil address means that node was unregistered
+	uncommittedRegisteredNodes map[ids.NodeID]*ids.ShortID
+
+	// DAO proposals
+	proposals     map[ids.ID]*dao.ProposalState
+	proposalsList linkeddb.LinkedDB
+	proposalsDB   database.Database
+
+	// KYC expirations
+	kycExpirations   map[ids.ShortID]uint64
+	kycExpirationsDB database.Database
+
+	// Base fee, nil if it wasn't changed yet
+	baseFee *uint64
 }
 
 func newCaminoDiff() *caminoDiff {
@@ -149,8 +233,12 @@ func newCaminoDiff() *caminoDiff {
 		modifiedAddressStates:         make(map[ids.ShortID]uint64),
 		modifiedDepositOffers:         make(map[ids.ID]*deposit.Offer),
 		modifiedDeposits:              make(map[ids.ID]*deposit.Deposit),
+		modifiedDepositOwners:         make(map[ids.ID][]ids.ShortID),
 		modifiedMultisigOwners:        make(map[ids.ShortID]*MultisigOwner),
+		modifiedMultisigPolicies:      make(map[ids.ShortID]*MultisigPolicy),
 		modifiedConsortiumMemberNodes: make(map[ids.NodeID]*ids.ShortID),
+		modifiedProposals:             make(map[ids.ID]*dao.ProposalState),
+		modifiedKycExpirations:        make(map[ids.ShortID]uint64),
 	}
 }
 
@@ -183,6 +271,7 @@ func newCaminoState(baseDB *versiondb.Database, metricsReg prometheus.Registerer
 	}
 
 	depositOffersDB := prefixdb.New(depositOffersPrefix, baseDB)
+	proposalsDB := prefixdb.New(proposalsPrefix, baseDB)
 
 	return &caminoState{
 		addressStateDB:    prefixdb.New(addressStatePrefix, baseDB),
@@ -192,13 +281,25 @@ func newCaminoState(baseDB *versiondb.Database, metricsReg prometheus.Registerer
 		depositOffersDB:   depositOffersDB,
 		depositOffersList: linkeddb.NewDefault(depositOffersDB),
 
-		depositsCache: depositsCache,
-		depositsDB:    prefixdb.New(depositsPrefix, baseDB),
+		depositsCache:         depositsCache,
+		depositsDB:            prefixdb.New(depositsPrefix, baseDB),
+		depositOwnersDB:       prefixdb.New(depositOwnersPrefix, baseDB),
+		depositIDsByAddressDB: prefixdb.New(depositIDsByAddressPrefix, baseDB),
 
-		multisigOwnersDB: prefixdb.New(multisigOwnersPrefix, baseDB),
+		multisigOwnersDB:   prefixdb.New(multisigOwnersPrefix, baseDB),
+		multisigPoliciesDB: prefixdb.New(multisigPoliciesPrefix, baseDB),
 
 		consortiumMemberNodesCache: consortiumMemberNodesCache,
 		consortiumMemberNodesDB:    prefixdb.New(ConsortiumMemberNodesPrefix, baseDB),
+		nodesByConsortiumMemberDB:  prefixdb.New(nodesByConsortiumPrefix, baseDB),
+		uncommittedRegisteredNodes: make(map[ids.NodeID]*ids.ShortID),
+
+		proposals:     make(map[ids.ID]*dao.ProposalState),
+		proposalsDB:   proposalsDB,
+		proposalsList: linkeddb.NewDefault(proposalsDB),
+
+		kycExpirations:   make(map[ids.ShortID]uint64),
+		kycExpirationsDB: prefixdb.New(kycExpirationsPrefix, baseDB),
 
 		caminoDB: prefixdb.New(caminoPrefix, baseDB),
 
@@ -209,8 +310,11 @@ func newCaminoState(baseDB *versiondb.Database, metricsReg prometheus.Registerer
 // Return current genesis args
 func (cs *caminoState) CaminoConfig() *CaminoConfig {
 	return &CaminoConfig{
-		VerifyNodeSignature: cs.verifyNodeSignature,
-		LockModeBondDeposit: cs.lockModeBondDeposit,
+		VerifyNodeSignature:   cs.verifyNodeSignature,
+		LockModeBondDeposit:   cs.lockModeBondDeposit,
+		ValidatorRewardRate:   cs.validatorRewardRate,
+		DaoProposalBondAmount: cs.daoProposalBond,
+		DaoProposalQuorum:     cs.daoProposalQuorum,
 	}
 }
 
@@ -219,8 +323,18 @@ func (cs *caminoState) SyncGenesis(s *state, g *genesis.State) error {
 	cs.genesisSynced = true
 	cs.lockModeBondDeposit = g.Camino.LockModeBondDeposit
 	cs.verifyNodeSignature = g.Camino.VerifyNodeSignature
-
-	if cs.lockModeBondDeposit {
+	cs.validatorRewardRate = g.Camino.ValidatorRewardRate
+	cs.daoProposalBond = g.Camino.DaoProposalBondAmount
+	cs.daoProposalQuorum = g.Camino.DaoProposalQuorum
+
+	switch {
+	case cs.lockModeBondDeposit && s.cfg.IsAthensPhaseActivated(time.Unix(int64(g.Timestamp), 0)):
+		// overwriting initial supply and potential validator rewards because
+		// state.SyncGenesis calculated them with avax reward calculator
+		if err := syncGenesisValidatorRewards(s, g, cs.validatorRewardRate); err != nil {
+			return err
+		}
+	case cs.lockModeBondDeposit:
 		// overwriting initial supply because state.SyncGenesis
 		// added potential avax validator rewards to it
 		s.SetCurrentSupply(constants.PrimaryNetworkID, g.InitialSupply)
@@ -313,7 +427,7 @@ func (cs *caminoState) SyncGenesis(s *state, g *genesis.State) error {
 		}
 
 		s.SetCurrentSupply(constants.PrimaryNetworkID, newCurrentSupply)
-		cs.UpdateDeposit(tx.ID(), deposit)
+		cs.AddDeposit(tx.ID(), deposit, depositTx.Owners())
 		s.AddTx(tx, status.Committed)
 	}
 
@@ -325,7 +439,43 @@ func (cs *caminoState) SyncGenesis(s *state, g *genesis.State) error {
 	return s.write(false, 0)
 }
 
-func (cs *caminoState) Load() error {
+// syncGenesisValidatorRewards recalculates genesis validators potential
+// rewards with camino reward calculator.
+func syncGenesisValidatorRewards(s *state, g *genesis.State, rewardRate uint64) error {
+	rewards := reward.NewCaminoCalculator(rewardRate, s.cfg.RewardConfig)
+	currentSupply := g.InitialSupply
+
+	for _, vdrTx := range g.Validators {
+		tx, ok := vdrTx.Unsigned.(txs.ValidatorTx)
+		if !ok {
+			return fmt.Errorf("expected tx type txs.ValidatorTx but got %T", vdrTx.Unsigned)
+		}
+
+		staker, err := s.GetCurrentValidator(constants.PrimaryNetworkID, tx.NodeID())
+		if err != nil {
+			return err
+		}
+
+		newStaker := *staker
+		newStaker.PotentialReward = rewards.Calculate(
+			staker.EndTime.Sub(staker.StartTime),
+			staker.Weight,
+			currentSupply,
+		)
+		currentSupply, err = math.Add64(currentSupply, newStaker.PotentialReward)
+		if err != nil {
+			return err
+		}
+
+		s.DeleteCurrentValidator(staker)
+		s.PutCurrentValidator(&newStaker)
+	}
+
+	s.SetCurrentSupply(constants.PrimaryNetworkID, currentSupply)
+	return nil
+}
+
+func (cs *caminoState) Load(s *state) error {
 	// Read the singletons
 	nodeSig, err := database.GetBool(cs.caminoDB, nodeSignatureKey)
 	if err != nil {
@@ -339,7 +489,37 @@ func (cs *caminoState) Load() error {
 	}
 	cs.lockModeBondDeposit = mode
 
-	return cs.loadDepositOffers()
+	// singletons added with the AthensPhase upgrade can be missing
+	// in the state of already existing chains
+	if cs.validatorRewardRate, err = getUInt64OrZero(cs.caminoDB, validatorRewardRateKey); err != nil {
+		return err
+	}
+	if cs.daoProposalBond, err = getUInt64OrZero(cs.caminoDB, daoProposalBondKey); err != nil {
+		return err
+	}
+	if cs.daoProposalQuorum, err = getUInt64OrZero(cs.caminoDB, daoProposalQuorumKey); err != nil {
+		return err
+	}
+
+	if err := cs.loadBaseFee(); err != nil {
+		return err
+	}
+	if err := cs.loadDepositOffers(); err != nil {
+		return err
+	}
+	if err := cs.loadProposals(); err != nil {
+		return err
+	}
+	if err := cs.loadKycExpirations(); err != nil {
+		return err
+	}
+	if err := cs.indexDeposits(s.GetTx); err != nil {
+		return err
+	}
+	if err := cs.indexConsortiumMemberNodes(); err != nil {
+		return err
+	}
+	return cs.loadRegisteredNodes()
 }
 
 func (cs *caminoState) Write() error {
@@ -351,6 +531,15 @@ func (cs *caminoState) Write() error {
 		if err := database.PutBool(cs.caminoDB, depositBondModeKey, cs.lockModeBondDeposit); err != nil {
 			return fmt.Errorf("failed to write lockModeBondDeposit: %w", err)
 		}
+		if err := database.PutUInt64(cs.caminoDB, validatorRewardRateKey, cs.validatorRewardRate); err != nil {
+			return fmt.Errorf("failed to write validatorRewardRate: %w", err)
+		}
+		if err := database.PutUInt64(cs.caminoDB, daoProposalBondKey, cs.daoProposalBond); err != nil {
+			return fmt.Errorf("failed to write daoProposalBond: %w", err)
+		}
+		if err := database.PutUInt64(cs.caminoDB, daoProposalQuorumKey, cs.daoProposalQuorum); err != nil {
+			return fmt.Errorf("failed to write daoProposalQuorum: %w", err)
+		}
 	}
 
 	if err := cs.writeAddressStates(); err != nil {
@@ -365,13 +554,34 @@ func (cs *caminoState) Write() error {
 	if err := cs.writeMultisigOwners(); err != nil {
 		return err
 	}
+	if err := cs.writeMultisigPolicies(); err != nil {
+		return err
+	}
 	if err := cs.writeNodeConsortiumMembers(); err != nil {
 		return err
 	}
+	if err := cs.writeProposals(); err != nil {
+		return err
+	}
+	if err := cs.writeBaseFee(); err != nil {
+		return err
+	}
+	if err := cs.writeKycExpirations(); err != nil {
+		return err
+	}
 
 	return nil
 }
 
+// getUInt64OrZero returns 0, if there is no value for [key] in [db]
+func getUInt64OrZero(db database.KeyValueReader, key []byte) (uint64, error) {
+	value, err := database.GetUInt64(db, key)
+	if err == database.ErrNotFound {
+		return 0, nil
+	}
+	return value, err
+}
+
 func (cs *caminoState) Close() error {
 	errs := wrappers.Errs{}
 	errs.Add(
@@ -379,8 +589,14 @@ func (cs *caminoState) Close() error {
 		cs.addressStateDB.Close(),
 		cs.depositOffersDB.Close(),
 		cs.depositsDB.Close(),
+		cs.depositOwnersDB.Close(),
+		cs.depositIDsByAddressDB.Close(),
 		cs.multisigOwnersDB.Close(),
+		cs.multisigPoliciesDB.Close(),
 		cs.consortiumMemberNodesDB.Close(),
+		cs.nodesByConsortiumMemberDB.Close(),
+		cs.proposalsDB.Close(),
+		cs.kycExpirationsDB.Close(),
 	)
 	return errs.Err
 }
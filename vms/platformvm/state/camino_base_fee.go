@@ -0,0 +1,47 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"github.com/ava-labs/avalanchego/database"
+)
+
+func (cs *caminoState) SetBaseFee(baseFee uint64) {
+	cs.modifiedBaseFee = &baseFee
+}
+
+// GetBaseFee returns database.ErrNotFound if base fee wasn't changed yet
+func (cs *caminoState) GetBaseFee() (uint64, error) {
+	if cs.modifiedBaseFee != nil {
+		return *cs.modifiedBaseFee, nil
+	}
+	if cs.baseFee == nil {
+		return 0, database.ErrNotFound
+	}
+	return *cs.baseFee, nil
+}
+
+func (cs *caminoState) loadBaseFee() error {
+	baseFee, err := database.GetUInt64(cs.caminoDB, baseFeeKey)
+	switch {
+	case err == database.ErrNotFound:
+		return nil
+	case err != nil:
+		return err
+	}
+	cs.baseFee = &baseFee
+	return nil
+}
+
+func (cs *caminoState) writeBaseFee() error {
+	if cs.modifiedBaseFee == nil {
+		return nil
+	}
+	if err := database.PutUInt64(cs.caminoDB, baseFeeKey, *cs.modifiedBaseFee); err != nil {
+		return err
+	}
+	cs.baseFee = cs.modifiedBaseFee
+	cs.modifiedBaseFee = nil
+	return nil
+}
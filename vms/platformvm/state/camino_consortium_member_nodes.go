@@ -6,11 +6,29 @@ package state
 import (
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
 )
 
 func (cs *caminoState) writeNodeConsortiumMembers() error {
+	// Previous consortium members are unlinked from all modified nodes first,
+	// so unlinking of one node doesn't remove the link of another node, that
+	// was linked to the same consortium member.
+	for nodeID := range cs.modifiedConsortiumMemberNodes {
+		oldAddrBytes, err := cs.consortiumMemberNodesDB.Get(nodeID[:])
+		switch err {
+		case nil:
+			if err := cs.nodesByConsortiumMemberDB.Delete(oldAddrBytes); err != nil {
+				return err
+			}
+		case database.ErrNotFound:
+		default:
+			return err
+		}
+	}
+
 	for nodeID, addr := range cs.modifiedConsortiumMemberNodes {
 		delete(cs.modifiedConsortiumMemberNodes, nodeID)
+
 		if addr == nil {
 			if err := cs.consortiumMemberNodesDB.Delete(nodeID[:]); err != nil {
 				return err
@@ -19,6 +37,104 @@ func (cs *caminoState) writeNodeConsortiumMembers() error {
 			if err := cs.consortiumMemberNodesDB.Put(nodeID[:], addr[:]); err != nil {
 				return err
 			}
+			if err := cs.nodesByConsortiumMemberDB.Put(addr[:], nodeID[:]); err != nil {
+				return err
+			}
+		}
+
+		// [cs.registeredNodes] is only updated once the change is committed
+		if cs.registeredNodes != nil {
+			cs.uncommittedRegisteredNodes[nodeID] = addr
+		}
+	}
+	return nil
+}
+
+// Committed updates [cs.registeredNodes] with the consortium member nodes, that
+// were written and committed to the database.
+func (cs *caminoState) Committed() {
+	for nodeID, addr := range cs.uncommittedRegisteredNodes {
+		delete(cs.uncommittedRegisteredNodes, nodeID)
+		if addr == nil {
+			cs.registeredNodes.Remove(nodeID)
+		} else {
+			cs.registeredNodes.Add(nodeID, *addr)
+		}
+	}
+}
+
+// Abort discards the consortium member nodes, that were written, but not
+// committed to the database.
+func (cs *caminoState) Abort() {
+	for nodeID := range cs.uncommittedRegisteredNodes {
+		delete(cs.uncommittedRegisteredNodes, nodeID)
+	}
+}
+
+// indexConsortiumMemberNodes indexes by consortium member address nodes, that
+// were registered before nodes were indexed. It only runs once per database.
+func (cs *caminoState) indexConsortiumMemberNodes() error {
+	indexed, err := cs.caminoDB.Has(nodesIndexedKey)
+	if err != nil || indexed {
+		return err
+	}
+
+	nodesByConsortiumMember := map[ids.ShortID]ids.NodeID{}
+	it := cs.consortiumMemberNodesDB.NewIterator()
+	defer it.Release()
+	for it.Next() {
+		nodeID, err := ids.ToNodeID(it.Key())
+		if err != nil {
+			return err
+		}
+		addr, err := ids.ToShortID(it.Value())
+		if err != nil {
+			return err
+		}
+		nodesByConsortiumMember[addr] = nodeID
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	for addr, nodeID := range nodesByConsortiumMember {
+		if err := cs.nodesByConsortiumMemberDB.Put(addr[:], nodeID[:]); err != nil {
+			return err
+		}
+	}
+
+	return cs.caminoDB.Put(nodesIndexedKey, nil)
+}
+
+// loadRegisteredNodes replaces the content of [cs.registeredNodes] with the
+// consortium member nodes stored in the database.
+func (cs *caminoState) loadRegisteredNodes() error {
+	if cs.registeredNodes == nil {
+		return nil
+	}
+
+	storedNodes := set.Set[ids.NodeID]{}
+	it := cs.consortiumMemberNodesDB.NewIterator()
+	defer it.Release()
+	for it.Next() {
+		nodeID, err := ids.ToNodeID(it.Key())
+		if err != nil {
+			return err
+		}
+		addr, err := ids.ToShortID(it.Value())
+		if err != nil {
+			return err
+		}
+		storedNodes.Add(nodeID)
+		cs.registeredNodes.Add(nodeID, addr)
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	for _, nodeID := range cs.registeredNodes.List() {
+		if !storedNodes.Contains(nodeID) {
+			cs.registeredNodes.Remove(nodeID)
 		}
 	}
 	return nil
@@ -47,3 +163,28 @@ func (cs *caminoState) GetNodeConsortiumMember(nodeID ids.NodeID) (ids.ShortID,
 
 	return ids.ToShortID(addrBytes)
 }
+
+func (cs *caminoState) GetConsortiumMemberNode(addr ids.ShortID) (ids.NodeID, error) {
+	for nodeID, modifiedAddr := range cs.modifiedConsortiumMemberNodes {
+		if modifiedAddr != nil && *modifiedAddr == addr {
+			return nodeID, nil
+		}
+	}
+
+	nodeIDBytes, err := cs.nodesByConsortiumMemberDB.Get(addr[:])
+	if err != nil {
+		return ids.EmptyNodeID, err
+	}
+
+	nodeID, err := ids.ToNodeID(nodeIDBytes)
+	if err != nil {
+		return ids.EmptyNodeID, err
+	}
+
+	// node was unlinked or linked to another consortium member
+	if _, ok := cs.modifiedConsortiumMemberNodes[nodeID]; ok {
+		return ids.EmptyNodeID, database.ErrNotFound
+	}
+
+	return nodeID, nil
+}
@@ -0,0 +1,141 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/versiondb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/version"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	db_manager "github.com/ava-labs/avalanchego/database/manager"
+)
+
+func TestGetConsortiumMemberNode(t *testing.T) {
+	require := require.New(t)
+	baseDBManager := db_manager.NewMemDB(version.Semantic1_0_0)
+	cs, err := newCaminoState(versiondb.New(baseDBManager.Current().Database), prometheus.NewRegistry())
+	require.NoError(err)
+
+	addr1, addr2 := ids.ShortID{1}, ids.ShortID{2}
+	nodeID1, nodeID2 := ids.NodeID{1}, ids.NodeID{2}
+
+	// registered node is found before write
+	cs.SetNodeConsortiumMember(nodeID1, &addr1)
+	nodeID, err := cs.GetConsortiumMemberNode(addr1)
+	require.NoError(err)
+	require.Equal(nodeID1, nodeID)
+
+	// and after write
+	require.NoError(cs.writeNodeConsortiumMembers())
+	nodeID, err = cs.GetConsortiumMemberNode(addr1)
+	require.NoError(err)
+	require.Equal(nodeID1, nodeID)
+	_, err = cs.GetConsortiumMemberNode(addr2)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	// node linked to another consortium member isn't found by previous one
+	cs.SetNodeConsortiumMember(nodeID1, &addr2)
+	_, err = cs.GetConsortiumMemberNode(addr1)
+	require.ErrorIs(err, database.ErrNotFound)
+	nodeID, err = cs.GetConsortiumMemberNode(addr2)
+	require.NoError(err)
+	require.Equal(nodeID1, nodeID)
+
+	require.NoError(cs.writeNodeConsortiumMembers())
+	_, err = cs.GetConsortiumMemberNode(addr1)
+	require.ErrorIs(err, database.ErrNotFound)
+	nodeID, err = cs.GetConsortiumMemberNode(addr2)
+	require.NoError(err)
+	require.Equal(nodeID1, nodeID)
+
+	// consortium member can replace its node
+	cs.SetNodeConsortiumMember(nodeID1, nil)
+	cs.SetNodeConsortiumMember(nodeID2, &addr2)
+	require.NoError(cs.writeNodeConsortiumMembers())
+	nodeID, err = cs.GetConsortiumMemberNode(addr2)
+	require.NoError(err)
+	require.Equal(nodeID2, nodeID)
+
+	// unlinked node isn't found
+	cs.SetNodeConsortiumMember(nodeID2, nil)
+	_, err = cs.GetConsortiumMemberNode(addr2)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	require.NoError(cs.writeNodeConsortiumMembers())
+	_, err = cs.GetConsortiumMemberNode(addr2)
+	require.ErrorIs(err, database.ErrNotFound)
+}
+
+func TestIndexConsortiumMemberNodes(t *testing.T) {
+	require := require.New(t)
+	baseDBManager := db_manager.NewMemDB(version.Semantic1_0_0)
+	cs, err := newCaminoState(versiondb.New(baseDBManager.Current().Database), prometheus.NewRegistry())
+	require.NoError(err)
+
+	addr1, addr2 := ids.ShortID{1}, ids.ShortID{2}
+	nodeID1, nodeID2 := ids.NodeID{1}, ids.NodeID{2}
+
+	// node registered before nodes were indexed
+	require.NoError(cs.consortiumMemberNodesDB.Put(nodeID1[:], addr1[:]))
+	// node registered with index
+	cs.SetNodeConsortiumMember(nodeID2, &addr2)
+	require.NoError(cs.writeNodeConsortiumMembers())
+
+	_, err = cs.GetConsortiumMemberNode(addr1)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	require.NoError(cs.indexConsortiumMemberNodes())
+
+	nodeID, err := cs.GetConsortiumMemberNode(addr1)
+	require.NoError(err)
+	require.Equal(nodeID1, nodeID)
+	nodeID, err = cs.GetConsortiumMemberNode(addr2)
+	require.NoError(err)
+	require.Equal(nodeID2, nodeID)
+
+	// index is only built once
+	require.NoError(cs.consortiumMemberNodesDB.Delete(nodeID1[:]))
+	require.NoError(cs.nodesByConsortiumMemberDB.Delete(addr1[:]))
+	require.NoError(cs.consortiumMemberNodesDB.Put(nodeID1[:], addr1[:]))
+	require.NoError(cs.indexConsortiumMemberNodes())
+	_, err = cs.GetConsortiumMemberNode(addr1)
+	require.ErrorIs(err, database.ErrNotFound)
+}
+
+func TestRegisteredNodesUpdatedOnCommit(t *testing.T) {
+	require := require.New(t)
+	baseDBManager := db_manager.NewMemDB(version.Semantic1_0_0)
+	cs, err := newCaminoState(versiondb.New(baseDBManager.Current().Database), prometheus.NewRegistry())
+	require.NoError(err)
+	registeredNodes := validators.NewRegisteredNodes()
+	cs.registeredNodes = registeredNodes
+
+	addr := ids.ShortID{1}
+	nodeID := ids.NodeID{1}
+
+	// registered node isn't visible until it's committed
+	cs.SetNodeConsortiumMember(nodeID, &addr)
+	require.NoError(cs.writeNodeConsortiumMembers())
+	require.False(registeredNodes.Contains(nodeID))
+	cs.Committed()
+	require.True(registeredNodes.Contains(nodeID))
+
+	// aborted unregistration isn't applied
+	cs.SetNodeConsortiumMember(nodeID, nil)
+	require.NoError(cs.writeNodeConsortiumMembers())
+	cs.Abort()
+	cs.Committed()
+	require.True(registeredNodes.Contains(nodeID))
+
+	cs.SetNodeConsortiumMember(nodeID, nil)
+	require.NoError(cs.writeNodeConsortiumMembers())
+	cs.Committed()
+	require.False(registeredNodes.Contains(nodeID))
+}
@@ -8,10 +8,20 @@ import (
 
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 )
 
+func (cs *caminoState) AddDeposit(depositTxID ids.ID, deposit *deposit.Deposit, owners []ids.ShortID) {
+	cs.modifiedDeposits[depositTxID] = deposit
+	cs.modifiedDepositOwners[depositTxID] = owners
+}
+
 func (cs *caminoState) UpdateDeposit(depositTxID ids.ID, deposit *deposit.Deposit) {
 	cs.modifiedDeposits[depositTxID] = deposit
 }
@@ -48,24 +58,177 @@ func (cs *caminoState) GetDeposit(depositTxID ids.ID) (*deposit.Deposit, error)
 	return d, nil
 }
 
+func (cs *caminoState) GetDepositIDsByAddress(address ids.ShortID) ([]ids.ID, error) {
+	depositTxIDs := set.Set[ids.ID]{}
+
+	depositIDsIt := cs.depositIDsByAddressDB.NewIteratorWithPrefix(address[:])
+	defer depositIDsIt.Release()
+	for depositIDsIt.Next() {
+		depositTxID, err := ids.ToID(depositIDsIt.Key()[hashing.AddrLen:])
+		if err != nil {
+			return nil, err
+		}
+		depositTxIDs.Add(depositTxID)
+	}
+	if err := depositIDsIt.Error(); err != nil {
+		return nil, err
+	}
+
+	applyModifiedDepositIDs(depositTxIDs, address, cs.modifiedDeposits, cs.modifiedDepositOwners)
+
+	depositTxIDsList := depositTxIDs.List()
+	utils.Sort(depositTxIDsList)
+	return depositTxIDsList, nil
+}
+
+// applyModifiedDepositIDs adds to [depositTxIDs] ids of added deposits owned by
+// [address] and removes from it ids of removed deposits.
+func applyModifiedDepositIDs(
+	depositTxIDs set.Set[ids.ID],
+	address ids.ShortID,
+	modifiedDeposits map[ids.ID]*deposit.Deposit,
+	modifiedDepositOwners map[ids.ID][]ids.ShortID,
+) {
+	for depositTxID, owners := range modifiedDepositOwners {
+		for _, owner := range owners {
+			if owner == address {
+				depositTxIDs.Add(depositTxID)
+				break
+			}
+		}
+	}
+	for depositTxID, deposit := range modifiedDeposits {
+		if deposit == nil {
+			depositTxIDs.Remove(depositTxID)
+		}
+	}
+}
+
 func (cs *caminoState) writeDeposits() error {
 	for depositTxID, deposit := range cs.modifiedDeposits {
 		delete(cs.modifiedDeposits, depositTxID)
+		owners, isNew := cs.modifiedDepositOwners[depositTxID]
+		delete(cs.modifiedDepositOwners, depositTxID)
 
 		if deposit == nil {
 			if err := cs.depositsDB.Delete(depositTxID[:]); err != nil {
 				return err
 			}
-		} else {
-			depositBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, deposit)
-			if err != nil {
-				return fmt.Errorf("failed to serialize deposit: %w", err)
+			cs.depositsCache.Evict(depositTxID)
+			if isNew {
+				continue
+			}
+			if err := cs.removeDepositOwners(depositTxID); err != nil {
+				return err
 			}
+			continue
+		}
 
-			if err := cs.depositsDB.Put(depositTxID[:], depositBytes); err != nil {
+		depositBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, deposit)
+		if err != nil {
+			return fmt.Errorf("failed to serialize deposit: %w", err)
+		}
+
+		if err := cs.depositsDB.Put(depositTxID[:], depositBytes); err != nil {
+			return err
+		}
+		cs.depositsCache.Put(depositTxID, deposit)
+
+		if isNew {
+			if err := cs.putDepositOwners(depositTxID, owners); err != nil {
 				return err
 			}
 		}
 	}
 	return nil
 }
+
+func (cs *caminoState) putDepositOwners(depositTxID ids.ID, owners []ids.ShortID) error {
+	ownersBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, owners)
+	if err != nil {
+		return fmt.Errorf("failed to serialize deposit owners: %w", err)
+	}
+	if err := cs.depositOwnersDB.Put(depositTxID[:], ownersBytes); err != nil {
+		return err
+	}
+	for _, owner := range owners {
+		if err := cs.depositIDsByAddressDB.Put(depositIDByAddressKey(owner, depositTxID), nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cs *caminoState) removeDepositOwners(depositTxID ids.ID) error {
+	ownersBytes, err := cs.depositOwnersDB.Get(depositTxID[:])
+	if err == database.ErrNotFound {
+		// deposit wasn't indexed
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	owners := []ids.ShortID{}
+	if _, err := blocks.GenesisCodec.Unmarshal(ownersBytes, &owners); err != nil {
+		return err
+	}
+
+	for _, owner := range owners {
+		if err := cs.depositIDsByAddressDB.Delete(depositIDByAddressKey(owner, depositTxID)); err != nil {
+			return err
+		}
+	}
+	return cs.depositOwnersDB.Delete(depositTxID[:])
+}
+
+// indexDeposits indexes by owners addresses deposits, that were written
+// before deposits were indexed. It only runs once per database.
+func (cs *caminoState) indexDeposits(getTx func(ids.ID) (*txs.Tx, status.Status, error)) error {
+	indexed, err := cs.caminoDB.Has(depositsIndexedKey)
+	if err != nil || indexed {
+		return err
+	}
+
+	notIndexedDepositTxIDs := []ids.ID{}
+	depositsIt := cs.depositsDB.NewIterator()
+	defer depositsIt.Release()
+	for depositsIt.Next() {
+		depositTxID, err := ids.ToID(depositsIt.Key())
+		if err != nil {
+			return err
+		}
+		hasOwners, err := cs.depositOwnersDB.Has(depositTxID[:])
+		if err != nil {
+			return err
+		}
+		if !hasOwners {
+			notIndexedDepositTxIDs = append(notIndexedDepositTxIDs, depositTxID)
+		}
+	}
+	if err := depositsIt.Error(); err != nil {
+		return err
+	}
+
+	for _, depositTxID := range notIndexedDepositTxIDs {
+		tx, _, err := getTx(depositTxID)
+		if err != nil {
+			return fmt.Errorf("couldn't get deposit tx %s: %w", depositTxID, err)
+		}
+		depositTx, ok := tx.Unsigned.(*txs.DepositTx)
+		if !ok {
+			return errWrongTxType
+		}
+		if err := cs.putDepositOwners(depositTxID, depositTx.Owners()); err != nil {
+			return err
+		}
+	}
+
+	return cs.caminoDB.Put(depositsIndexedKey, nil)
+}
+
+func depositIDByAddressKey(address ids.ShortID, depositTxID ids.ID) []byte {
+	key := make([]byte, hashing.AddrLen+hashing.HashLen)
+	copy(key, address[:])
+	copy(key[hashing.AddrLen:], depositTxID[:])
+	return key
+}
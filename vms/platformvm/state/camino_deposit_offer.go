@@ -87,6 +87,8 @@ func (cs *caminoState) writeDepositOffers() error {
 		if err := cs.depositOffersList.Put(offerID[:], offerBytes); err != nil {
 			return err
 		}
+
+		cs.depositOffers[offerID] = offer
 	}
 	return nil
 }
@@ -9,8 +9,13 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/version"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
 
@@ -221,3 +226,102 @@ func TestWriteDeposits(t *testing.T) {
 		})
 	}
 }
+
+func TestGetDepositIDsByAddress(t *testing.T) {
+	require := require.New(t)
+	baseDBManager := db_manager.NewMemDB(version.Semantic1_0_0)
+	cs, err := newCaminoState(versiondb.New(baseDBManager.Current().Database), prometheus.NewRegistry())
+	require.NoError(err)
+
+	owner1, owner2 := ids.ShortID{1}, ids.ShortID{2}
+	depositTxID1, depositTxID2, depositTxID3 := ids.ID{1}, ids.ID{2}, ids.ID{3}
+	deposit1 := &deposit.Deposit{Amount: 1}
+
+	// added deposits are indexed before write
+	cs.AddDeposit(depositTxID1, deposit1, []ids.ShortID{owner1})
+	cs.AddDeposit(depositTxID2, deposit1, []ids.ShortID{owner1, owner2})
+	depositTxIDs, err := cs.GetDepositIDsByAddress(owner1)
+	require.NoError(err)
+	require.Equal([]ids.ID{depositTxID1, depositTxID2}, depositTxIDs)
+
+	// and after write
+	require.NoError(cs.writeDeposits())
+	depositTxIDs, err = cs.GetDepositIDsByAddress(owner1)
+	require.NoError(err)
+	require.Equal([]ids.ID{depositTxID1, depositTxID2}, depositTxIDs)
+	depositTxIDs, err = cs.GetDepositIDsByAddress(owner2)
+	require.NoError(err)
+	require.Equal([]ids.ID{depositTxID2}, depositTxIDs)
+
+	// updated deposit stays indexed, removed deposit isn't indexed anymore
+	cs.UpdateDeposit(depositTxID1, &deposit.Deposit{Amount: 1, UnlockedAmount: 1})
+	cs.UpdateDeposit(depositTxID2, nil)
+	cs.AddDeposit(depositTxID3, deposit1, []ids.ShortID{owner2})
+	depositTxIDs, err = cs.GetDepositIDsByAddress(owner2)
+	require.NoError(err)
+	require.Equal([]ids.ID{depositTxID3}, depositTxIDs)
+
+	require.NoError(cs.writeDeposits())
+	depositTxIDs, err = cs.GetDepositIDsByAddress(owner1)
+	require.NoError(err)
+	require.Equal([]ids.ID{depositTxID1}, depositTxIDs)
+	depositTxIDs, err = cs.GetDepositIDsByAddress(owner2)
+	require.NoError(err)
+	require.Equal([]ids.ID{depositTxID3}, depositTxIDs)
+
+	has, err := cs.depositOwnersDB.Has(depositTxID2[:])
+	require.NoError(err)
+	require.False(has)
+}
+
+func TestIndexDeposits(t *testing.T) {
+	require := require.New(t)
+	baseDBManager := db_manager.NewMemDB(version.Semantic1_0_0)
+	cs, err := newCaminoState(versiondb.New(baseDBManager.Current().Database), prometheus.NewRegistry())
+	require.NoError(err)
+
+	rewardsOwner, depositOwner := ids.ShortID{1}, ids.ShortID{2}
+	notIndexedDepositTxID, indexedDepositTxID := ids.ID{1}, ids.ID{2}
+	depositTx := &txs.Tx{Unsigned: &txs.DepositTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			Outs: []*avax.TransferableOutput{{
+				Out: &locked.Out{
+					IDs: locked.IDs{DepositTxID: locked.ThisTxID, BondTxID: ids.Empty},
+					TransferableOut: &secp256k1fx.TransferOutput{
+						Amt:          1,
+						OutputOwners: secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{depositOwner}},
+					},
+				},
+			}},
+		}},
+		RewardsOwner: &secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{rewardsOwner}},
+	}}
+
+	// deposit written before deposits were indexed
+	depositBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, &deposit.Deposit{Amount: 1})
+	require.NoError(err)
+	require.NoError(cs.depositsDB.Put(notIndexedDepositTxID[:], depositBytes))
+	// deposit written with index
+	cs.AddDeposit(indexedDepositTxID, &deposit.Deposit{Amount: 1}, []ids.ShortID{rewardsOwner})
+	require.NoError(cs.writeDeposits())
+
+	getTx := func(txID ids.ID) (*txs.Tx, status.Status, error) {
+		require.Equal(notIndexedDepositTxID, txID)
+		return depositTx, status.Committed, nil
+	}
+
+	require.NoError(cs.indexDeposits(getTx))
+
+	depositTxIDs, err := cs.GetDepositIDsByAddress(rewardsOwner)
+	require.NoError(err)
+	require.Equal([]ids.ID{notIndexedDepositTxID, indexedDepositTxID}, depositTxIDs)
+	depositTxIDs, err = cs.GetDepositIDsByAddress(depositOwner)
+	require.NoError(err)
+	require.Equal([]ids.ID{notIndexedDepositTxID}, depositTxIDs)
+
+	// index is only built once
+	require.NoError(cs.indexDeposits(func(ids.ID) (*txs.Tx, status.Status, error) {
+		require.FailNow("deposits must be indexed only once")
+		return nil, status.Unknown, nil
+	}))
+}
@@ -8,8 +8,10 @@ import (
 
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 )
@@ -142,6 +144,11 @@ func (d *diff) GetAllDepositOffers() ([]*deposit.Offer, error) {
 	return offers, nil
 }
 
+func (d *diff) AddDeposit(depositTxID ids.ID, deposit *deposit.Deposit, owners []ids.ShortID) {
+	d.caminoDiff.modifiedDeposits[depositTxID] = deposit
+	d.caminoDiff.modifiedDepositOwners[depositTxID] = owners
+}
+
 func (d *diff) UpdateDeposit(depositTxID ids.ID, deposit *deposit.Deposit) {
 	d.caminoDiff.modifiedDeposits[depositTxID] = deposit
 }
@@ -159,6 +166,26 @@ func (d *diff) GetDeposit(depositTxID ids.ID) (*deposit.Deposit, error) {
 	return parentState.GetDeposit(depositTxID)
 }
 
+func (d *diff) GetDepositIDsByAddress(address ids.ShortID) ([]ids.ID, error) {
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	parentDepositTxIDs, err := parentState.GetDepositIDsByAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	depositTxIDs := set.NewSet[ids.ID](len(parentDepositTxIDs))
+	depositTxIDs.Add(parentDepositTxIDs...)
+	applyModifiedDepositIDs(depositTxIDs, address, d.caminoDiff.modifiedDeposits, d.caminoDiff.modifiedDepositOwners)
+
+	depositTxIDsList := depositTxIDs.List()
+	utils.Sort(depositTxIDsList)
+	return depositTxIDsList, nil
+}
+
 func (d *diff) SetMultisigOwner(owner *MultisigOwner) {
 	d.caminoDiff.modifiedMultisigOwners[owner.Alias] = owner
 }
@@ -176,6 +203,23 @@ func (d *diff) GetMultisigOwner(alias ids.ShortID) (*MultisigOwner, error) {
 	return parentState.GetMultisigOwner(alias)
 }
 
+func (d *diff) SetMultisigPolicy(policy *MultisigPolicy) {
+	d.caminoDiff.modifiedMultisigPolicies[policy.Alias] = policy
+}
+
+func (d *diff) GetMultisigPolicy(alias ids.ShortID) (*MultisigPolicy, error) {
+	if policy, ok := d.caminoDiff.modifiedMultisigPolicies[alias]; ok {
+		return policy, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	return parentState.GetMultisigPolicy(alias)
+}
+
 func (d *diff) SetNodeConsortiumMember(nodeID ids.NodeID, addr *ids.ShortID) {
 	d.caminoDiff.modifiedConsortiumMemberNodes[nodeID] = addr
 }
@@ -196,6 +240,146 @@ func (d *diff) GetNodeConsortiumMember(nodeID ids.NodeID) (ids.ShortID, error) {
 	return parentState.GetNodeConsortiumMember(nodeID)
 }
 
+func (d *diff) GetConsortiumMemberNode(addr ids.ShortID) (ids.NodeID, error) {
+	for nodeID, modifiedAddr := range d.caminoDiff.modifiedConsortiumMemberNodes {
+		if modifiedAddr != nil && *modifiedAddr == addr {
+			return nodeID, nil
+		}
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return ids.EmptyNodeID, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	nodeID, err := parentState.GetConsortiumMemberNode(addr)
+	if err != nil {
+		return ids.EmptyNodeID, err
+	}
+
+	// node was unlinked or linked to another consortium member
+	if _, ok := d.caminoDiff.modifiedConsortiumMemberNodes[nodeID]; ok {
+		return ids.EmptyNodeID, database.ErrNotFound
+	}
+
+	return nodeID, nil
+}
+
+func (d *diff) SetProposal(proposal *dao.ProposalState) {
+	d.caminoDiff.modifiedProposals[proposal.ID] = proposal
+}
+
+func (d *diff) RemoveProposal(proposalID ids.ID) {
+	d.caminoDiff.modifiedProposals[proposalID] = nil
+}
+
+func (d *diff) GetProposal(proposalID ids.ID) (*dao.ProposalState, error) {
+	if proposal, ok := d.caminoDiff.modifiedProposals[proposalID]; ok {
+		if proposal == nil {
+			return nil, database.ErrNotFound
+		}
+		return proposal, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	return parentState.GetProposal(proposalID)
+}
+
+func (d *diff) GetAllProposals() ([]*dao.ProposalState, error) {
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	parentProposals, err := parentState.GetAllProposals()
+	if err != nil {
+		return nil, err
+	}
+
+	var proposals []*dao.ProposalState
+
+	for _, proposal := range d.caminoDiff.modifiedProposals {
+		if proposal != nil {
+			proposals = append(proposals, proposal)
+		}
+	}
+
+	for _, proposal := range parentProposals {
+		if _, ok := d.caminoDiff.modifiedProposals[proposal.ID]; !ok {
+			proposals = append(proposals, proposal)
+		}
+	}
+
+	return proposals, nil
+}
+
+func (d *diff) SetKycExpiration(address ids.ShortID, expiration uint64) {
+	d.caminoDiff.modifiedKycExpirations[address] = expiration
+}
+
+func (d *diff) RemoveKycExpiration(address ids.ShortID) {
+	d.caminoDiff.modifiedKycExpirations[address] = 0
+}
+
+func (d *diff) GetKycExpiration(address ids.ShortID) (uint64, error) {
+	if expiration, ok := d.caminoDiff.modifiedKycExpirations[address]; ok {
+		if expiration == 0 {
+			return 0, database.ErrNotFound
+		}
+		return expiration, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	return parentState.GetKycExpiration(address)
+}
+
+func (d *diff) GetAllKycExpirations() (map[ids.ShortID]uint64, error) {
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	expirations, err := parentState.GetAllKycExpirations()
+	if err != nil {
+		return nil, err
+	}
+
+	for address, expiration := range d.caminoDiff.modifiedKycExpirations {
+		if expiration == 0 {
+			delete(expirations, address)
+		} else {
+			expirations[address] = expiration
+		}
+	}
+
+	return expirations, nil
+}
+
+func (d *diff) SetBaseFee(baseFee uint64) {
+	d.caminoDiff.modifiedBaseFee = &baseFee
+}
+
+func (d *diff) GetBaseFee() (uint64, error) {
+	if d.caminoDiff.modifiedBaseFee != nil {
+		return *d.caminoDiff.modifiedBaseFee, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	return parentState.GetBaseFee()
+}
+
 // Finally apply all changes
 func (d *diff) ApplyCaminoState(baseState State) {
 	for k, v := range d.caminoDiff.modifiedAddressStates {
@@ -207,14 +391,42 @@ func (d *diff) ApplyCaminoState(baseState State) {
 	}
 
 	for depositTxID, deposit := range d.caminoDiff.modifiedDeposits {
-		baseState.UpdateDeposit(depositTxID, deposit)
+		if owners, ok := d.caminoDiff.modifiedDepositOwners[depositTxID]; ok && deposit != nil {
+			baseState.AddDeposit(depositTxID, deposit, owners)
+		} else {
+			baseState.UpdateDeposit(depositTxID, deposit)
+		}
 	}
 
 	for _, v := range d.caminoDiff.modifiedMultisigOwners {
 		baseState.SetMultisigOwner(v)
 	}
 
+	for _, v := range d.caminoDiff.modifiedMultisigPolicies {
+		baseState.SetMultisigPolicy(v)
+	}
+
 	for nodeID, addr := range d.caminoDiff.modifiedConsortiumMemberNodes {
 		baseState.SetNodeConsortiumMember(nodeID, addr)
 	}
+
+	for proposalID, proposal := range d.caminoDiff.modifiedProposals {
+		if proposal == nil {
+			baseState.RemoveProposal(proposalID)
+		} else {
+			baseState.SetProposal(proposal)
+		}
+	}
+
+	for address, expiration := range d.caminoDiff.modifiedKycExpirations {
+		if expiration == 0 {
+			baseState.RemoveKycExpiration(address)
+		} else {
+			baseState.SetKycExpiration(address, expiration)
+		}
+	}
+
+	if d.caminoDiff.modifiedBaseFee != nil {
+		baseState.SetBaseFee(*d.caminoDiff.modifiedBaseFee)
+	}
 }
@@ -0,0 +1,87 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"encoding/binary"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+func (cs *caminoState) SetKycExpiration(address ids.ShortID, expiration uint64) {
+	cs.modifiedKycExpirations[address] = expiration
+}
+
+func (cs *caminoState) RemoveKycExpiration(address ids.ShortID) {
+	cs.modifiedKycExpirations[address] = 0
+}
+
+func (cs *caminoState) GetKycExpiration(address ids.ShortID) (uint64, error) {
+	// Try to get from modified state
+	expiration, ok := cs.modifiedKycExpirations[address]
+	// Try to get it from state
+	if !ok {
+		expiration, ok = cs.kycExpirations[address]
+	}
+	// expiration doesn't exist or was removed
+	if !ok || expiration == 0 {
+		return 0, database.ErrNotFound
+	}
+	return expiration, nil
+}
+
+func (cs *caminoState) GetAllKycExpirations() (map[ids.ShortID]uint64, error) {
+	expirations := make(map[ids.ShortID]uint64, len(cs.kycExpirations))
+
+	for address, expiration := range cs.kycExpirations {
+		expirations[address] = expiration
+	}
+
+	for address, expiration := range cs.modifiedKycExpirations {
+		if expiration == 0 {
+			delete(expirations, address)
+		} else {
+			expirations[address] = expiration
+		}
+	}
+
+	return expirations, nil
+}
+
+func (cs *caminoState) loadKycExpirations() error {
+	kycExpirationsIt := cs.kycExpirationsDB.NewIterator()
+	defer kycExpirationsIt.Release()
+	for kycExpirationsIt.Next() {
+		address, err := ids.ToShortID(kycExpirationsIt.Key())
+		if err != nil {
+			return err
+		}
+		cs.kycExpirations[address] = binary.LittleEndian.Uint64(kycExpirationsIt.Value())
+	}
+	return kycExpirationsIt.Error()
+}
+
+func (cs *caminoState) writeKycExpirations() error {
+	for address, expiration := range cs.modifiedKycExpirations {
+		delete(cs.modifiedKycExpirations, address)
+
+		if expiration == 0 {
+			if err := cs.kycExpirationsDB.Delete(address[:]); err != nil {
+				return err
+			}
+			delete(cs.kycExpirations, address)
+			continue
+		}
+
+		buf := make([]byte, 8)
+		binary.LittleEndian.PutUint64(buf, expiration)
+		if err := cs.kycExpirationsDB.Put(address[:], buf); err != nil {
+			return err
+		}
+
+		cs.kycExpirations[address] = expiration
+	}
+	return nil
+}
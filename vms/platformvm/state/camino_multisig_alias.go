@@ -13,6 +13,10 @@ import (
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
+type MultisigOwnerGetter interface {
+	GetMultisigOwner(alias ids.ShortID) (*MultisigOwner, error)
+}
+
 type MultisigOwner struct {
 	Alias  ids.ShortID
 	Owners secp256k1fx.OutputOwners `serialize:"true" json:"owners"`
@@ -0,0 +1,139 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+)
+
+// MultisigPolicyPeriod is the duration in seconds of the period, during which
+// spendings of multisig alias are limited by [MultisigPolicy.DailyLimit]
+const MultisigPolicyPeriod = uint64(24 * time.Hour / time.Second)
+
+// MultisigState is used to verify spendings of multisig aliases
+// against their spending policies.
+type MultisigState interface {
+	MultisigOwnerGetter
+
+	GetMultisigPolicy(alias ids.ShortID) (*MultisigPolicy, error)
+	GetTimestamp() time.Time
+}
+
+// MultisigPolicy restricts spendings of AVAX asset owned by multisig alias
+type MultisigPolicy struct {
+	Alias ids.ShortID
+	// Max amount, that can be spent from alias during one policy period.
+	// Zero means no limit.
+	DailyLimit uint64 `serialize:"true" json:"dailyLimit"`
+	// Spendings, that together with amount already spent during the current
+	// policy period exceed this amount, are large. Zero means there are no
+	// large spendings.
+	LargeAmount uint64 `serialize:"true" json:"largeAmount"`
+	// Number of alias owners signatures, required for large spendings.
+	// Alias threshold is required, if it's greater.
+	LargeThreshold uint32 `serialize:"true" json:"largeThreshold"`
+	// Duration in seconds, for which outputs of large spendings,
+	// that aren't owned by alias, must be timelocked.
+	LargeDelay uint64 `serialize:"true" json:"largeDelay"`
+	// Start of the current policy period
+	PeriodStart uint64 `serialize:"true" json:"periodStart"`
+	// Amount spent from alias since [PeriodStart]
+	Spent uint64 `serialize:"true" json:"spent"`
+}
+
+// IsEmpty returns true if policy doesn't restrict spendings.
+// Empty policies aren't stored.
+func (p *MultisigPolicy) IsEmpty() bool {
+	return p.DailyLimit == 0 && p.LargeAmount == 0
+}
+
+// IsLarge returns true if spending [amount] at [timestamp] is large.
+// Amount already spent during policy period of [timestamp] is counted too,
+// so large spending can't be split into several small ones.
+func (p *MultisigPolicy) IsLarge(timestamp, amount uint64) bool {
+	if p.LargeAmount == 0 {
+		return false
+	}
+	spent, err := math.Add64(p.SpentAt(timestamp), amount)
+	return err != nil || spent > p.LargeAmount
+}
+
+// SpentAt returns amount spent from alias during policy period of [timestamp]
+func (p *MultisigPolicy) SpentAt(timestamp uint64) uint64 {
+	if p.periodStart(timestamp) != p.PeriodStart {
+		return 0
+	}
+	return p.Spent
+}
+
+// Spend returns policy copy with [amount] spent at [timestamp].
+// Doesn't check daily limit. Precondition: SpentAt(timestamp) + amount
+// doesn't overflow.
+func (p *MultisigPolicy) Spend(timestamp, amount uint64) *MultisigPolicy {
+	newPolicy := *p
+	newPolicy.Spent = p.SpentAt(timestamp) + amount
+	newPolicy.PeriodStart = p.periodStart(timestamp)
+	return &newPolicy
+}
+
+// ThresholdFor returns number of owners signatures required to spend
+// [amount] at [timestamp], if alias threshold is [threshold]
+func (p *MultisigPolicy) ThresholdFor(timestamp, amount uint64, threshold uint32) uint32 {
+	if p.IsLarge(timestamp, amount) && p.LargeThreshold > threshold {
+		return p.LargeThreshold
+	}
+	return threshold
+}
+
+func (*MultisigPolicy) periodStart(timestamp uint64) uint64 {
+	return timestamp - timestamp%MultisigPolicyPeriod
+}
+
+func (cs *caminoState) SetMultisigPolicy(policy *MultisigPolicy) {
+	cs.modifiedMultisigPolicies[policy.Alias] = policy
+}
+
+func (cs *caminoState) GetMultisigPolicy(alias ids.ShortID) (*MultisigPolicy, error) {
+	if policy, exist := cs.modifiedMultisigPolicies[alias]; exist {
+		return policy, nil
+	}
+
+	policyBytes, err := cs.multisigPoliciesDB.Get(alias[:])
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &MultisigPolicy{}
+	if _, err := blocks.GenesisCodec.Unmarshal(policyBytes, policy); err != nil {
+		return nil, err
+	}
+	policy.Alias = alias
+
+	return policy, nil
+}
+
+func (cs *caminoState) writeMultisigPolicies() error {
+	for alias, policy := range cs.modifiedMultisigPolicies {
+		delete(cs.modifiedMultisigPolicies, alias)
+		if policy.IsEmpty() {
+			if err := cs.multisigPoliciesDB.Delete(alias[:]); err != nil {
+				return err
+			}
+			continue
+		}
+		policyBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, policy)
+		if err != nil {
+			return fmt.Errorf("failed to serialize multisig policy: %w", err)
+		}
+		if err := cs.multisigPoliciesDB.Put(alias[:], policyBytes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
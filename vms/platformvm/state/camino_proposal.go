@@ -0,0 +1,105 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
+)
+
+func (cs *caminoState) SetProposal(proposal *dao.ProposalState) {
+	cs.modifiedProposals[proposal.ID] = proposal
+}
+
+func (cs *caminoState) RemoveProposal(proposalID ids.ID) {
+	cs.modifiedProposals[proposalID] = nil
+}
+
+func (cs *caminoState) GetProposal(proposalID ids.ID) (*dao.ProposalState, error) {
+	// Try to get from modified state
+	proposal, ok := cs.modifiedProposals[proposalID]
+	// proposal was removed
+	if ok && proposal == nil {
+		return nil, database.ErrNotFound
+	}
+	// Try to get it from state
+	if !ok {
+		if proposal, ok = cs.proposals[proposalID]; !ok {
+			return nil, database.ErrNotFound
+		}
+	}
+	return proposal, nil
+}
+
+func (cs *caminoState) GetAllProposals() ([]*dao.ProposalState, error) {
+	var proposals []*dao.ProposalState
+
+	for _, proposal := range cs.modifiedProposals {
+		if proposal != nil {
+			proposals = append(proposals, proposal)
+		}
+	}
+
+	for proposalID, proposal := range cs.proposals {
+		if _, ok := cs.modifiedProposals[proposalID]; !ok {
+			proposals = append(proposals, proposal)
+		}
+	}
+
+	return proposals, nil
+}
+
+func (cs *caminoState) loadProposals() error {
+	proposalsIt := cs.proposalsList.NewIterator()
+	defer proposalsIt.Release()
+	for proposalsIt.Next() {
+		proposalIDBytes := proposalsIt.Key()
+		proposalID, err := ids.ToID(proposalIDBytes)
+		if err != nil {
+			return err
+		}
+
+		proposalBytes := proposalsIt.Value()
+		proposal := &dao.ProposalState{
+			ID: proposalID,
+		}
+		if _, err := blocks.GenesisCodec.Unmarshal(proposalBytes, proposal); err != nil {
+			return err
+		}
+
+		cs.proposals[proposalID] = proposal
+	}
+
+	return nil
+}
+
+func (cs *caminoState) writeProposals() error {
+	for proposalID, proposal := range cs.modifiedProposals {
+		delete(cs.modifiedProposals, proposalID)
+
+		if proposal == nil {
+			if err := cs.proposalsList.Delete(proposalID[:]); err != nil {
+				return err
+			}
+			delete(cs.proposals, proposalID)
+			continue
+		}
+
+		proposalBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, proposal)
+		if err != nil {
+			return fmt.Errorf("failed to serialize proposal: %w", err)
+		}
+
+		if err := cs.proposalsList.Put(proposalID[:], proposalBytes); err != nil {
+			return err
+		}
+
+		cs.proposals[proposalID] = proposal
+	}
+	return nil
+}
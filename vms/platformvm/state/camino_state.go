@@ -6,9 +6,11 @@ package state
 import (
 	"math"
 
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 )
@@ -61,6 +63,10 @@ func (s *state) GetAllDepositOffers() ([]*deposit.Offer, error) {
 	return s.caminoState.GetAllDepositOffers()
 }
 
+func (s *state) AddDeposit(depositTxID ids.ID, deposit *deposit.Deposit, owners []ids.ShortID) {
+	s.caminoState.AddDeposit(depositTxID, deposit, owners)
+}
+
 func (s *state) UpdateDeposit(depositTxID ids.ID, deposit *deposit.Deposit) {
 	s.caminoState.UpdateDeposit(depositTxID, deposit)
 }
@@ -69,6 +75,10 @@ func (s *state) GetDeposit(depositTxID ids.ID) (*deposit.Deposit, error) {
 	return s.caminoState.GetDeposit(depositTxID)
 }
 
+func (s *state) GetDepositIDsByAddress(address ids.ShortID) ([]ids.ID, error) {
+	return s.caminoState.GetDepositIDsByAddress(address)
+}
+
 func (s *state) SetMultisigOwner(owner *MultisigOwner) {
 	s.caminoState.SetMultisigOwner(owner)
 }
@@ -77,6 +87,14 @@ func (s *state) GetMultisigOwner(alias ids.ShortID) (*MultisigOwner, error) {
 	return s.caminoState.GetMultisigOwner(alias)
 }
 
+func (s *state) SetMultisigPolicy(policy *MultisigPolicy) {
+	s.caminoState.SetMultisigPolicy(policy)
+}
+
+func (s *state) GetMultisigPolicy(alias ids.ShortID) (*MultisigPolicy, error) {
+	return s.caminoState.GetMultisigPolicy(alias)
+}
+
 func (s *state) SetNodeConsortiumMember(nodeID ids.NodeID, addr *ids.ShortID) {
 	s.caminoState.SetNodeConsortiumMember(nodeID, addr)
 }
@@ -84,3 +102,53 @@ func (s *state) SetNodeConsortiumMember(nodeID ids.NodeID, addr *ids.ShortID) {
 func (s *state) GetNodeConsortiumMember(nodeID ids.NodeID) (ids.ShortID, error) {
 	return s.caminoState.GetNodeConsortiumMember(nodeID)
 }
+
+func (s *state) GetConsortiumMemberNode(addr ids.ShortID) (ids.NodeID, error) {
+	return s.caminoState.GetConsortiumMemberNode(addr)
+}
+
+func (s *state) SetProposal(proposal *dao.ProposalState) {
+	s.caminoState.SetProposal(proposal)
+}
+
+func (s *state) RemoveProposal(proposalID ids.ID) {
+	s.caminoState.RemoveProposal(proposalID)
+}
+
+func (s *state) GetProposal(proposalID ids.ID) (*dao.ProposalState, error) {
+	return s.caminoState.GetProposal(proposalID)
+}
+
+func (s *state) GetAllProposals() ([]*dao.ProposalState, error) {
+	return s.caminoState.GetAllProposals()
+}
+
+func (s *state) SetKycExpiration(address ids.ShortID, expiration uint64) {
+	s.caminoState.SetKycExpiration(address, expiration)
+}
+
+func (s *state) RemoveKycExpiration(address ids.ShortID) {
+	s.caminoState.RemoveKycExpiration(address)
+}
+
+func (s *state) GetKycExpiration(address ids.ShortID) (uint64, error) {
+	return s.caminoState.GetKycExpiration(address)
+}
+
+func (s *state) GetAllKycExpirations() (map[ids.ShortID]uint64, error) {
+	return s.caminoState.GetAllKycExpirations()
+}
+
+func (s *state) SetBaseFee(baseFee uint64) {
+	s.caminoState.SetBaseFee(baseFee)
+}
+
+// GetBaseFee returns base fee changed by DAO proposal or
+// default tx fee from config, if it wasn't changed yet.
+func (s *state) GetBaseFee() (uint64, error) {
+	baseFee, err := s.caminoState.GetBaseFee()
+	if err == database.ErrNotFound {
+		return s.cfg.TxFee, nil
+	}
+	return baseFee, err
+}
@@ -0,0 +1,599 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanchego/cache"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/linkeddb"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+var errUnexpectedCaminoState = errors.New("unexpected camino state type")
+
+// syncFlushSize is the size of the canonical records, after which they are
+// passed to the sync writer.
+const syncFlushSize = 4 * units.MiB
+
+// State is stored with prefixdb on top of versiondb, so the raw keys of all
+// blocks start with the hash of the block prefix.
+var rawBlockPrefix = hashing.ComputeHash256(blockPrefix)
+
+// isSyncableKey returns true if the raw [key] of the chain state database
+// belongs to the state, that is replaced by state sync.
+func isSyncableKey(key []byte, excludedPrefixes [][]byte) bool {
+	if bytes.HasPrefix(key, rawBlockPrefix) {
+		return false
+	}
+	for _, prefix := range excludedPrefixes {
+		if bytes.HasPrefix(key, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// CopySyncableState copies the raw chain state records from [it] to [db]. The
+// only copied block is [blkID]. Records with [excludedPrefixes] are skipped.
+func CopySyncableState(db database.Database, it database.Iterator, blkID ids.ID, excludedPrefixes ...[]byte) error {
+	blkKey := make([]byte, 0, len(rawBlockPrefix)+len(blkID))
+	blkKey = append(blkKey, rawBlockPrefix...)
+	blkKey = append(blkKey, blkID[:]...)
+
+	batch := db.NewBatch()
+	for it.Next() {
+		key := it.Key()
+		if !isSyncableKey(key, excludedPrefixes) && !bytes.Equal(key, blkKey) {
+			continue
+		}
+		if err := batch.Put(key, it.Value()); err != nil {
+			return err
+		}
+		if batch.Size() < syncFlushSize {
+			continue
+		}
+		if err := batch.Write(); err != nil {
+			return err
+		}
+		batch.Reset()
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// ClearSyncableState deletes the chain state from [db], so it could be
+// replaced by the synced one. Blocks and records with [excludedPrefixes] are
+// kept.
+func ClearSyncableState(db database.Database, excludedPrefixes ...[]byte) error {
+	it := db.NewIterator()
+	defer it.Release()
+
+	batch := db.NewBatch()
+	for it.Next() {
+		key := it.Key()
+		if !isSyncableKey(key, excludedPrefixes) {
+			continue
+		}
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+		if batch.Size() < syncFlushSize {
+			continue
+		}
+		if err := batch.Write(); err != nil {
+			return err
+		}
+		batch.Reset()
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// WriteSyncableState passes the canonical records of the chain state stored in
+// [db] to [write]. Block [blkID] at [height] must be the last accepted block of
+// that state.
+//
+// Raw records can't be synced as they are, because linked lists are written in
+// map iteration order and validator uptimes are measured by every node on its
+// own. Canonical records are equal on all nodes with the same last accepted
+// block: linked lists are rebuilt in key order and current validators have
+// uptimes as if they were just added. Written records could be put into an
+// empty database as they are to restore the state.
+func WriteSyncableState(db database.Database, blkID ids.ID, height uint64, write func(key, value []byte) error) error {
+	src, err := new(db, nil, nil, nil, prometheus.NewRegistry(), nil)
+	if err != nil {
+		return err
+	}
+	mirror := memdb.New()
+	dst, err := new(mirror, nil, nil, nil, prometheus.NewRegistry(), nil)
+	if err != nil {
+		return err
+	}
+	w := &syncableStateWriter{
+		src:    src,
+		dst:    dst,
+		mirror: mirror,
+		write:  write,
+	}
+	return w.writeAll(blkID, height)
+}
+
+type syncableStateWriter struct {
+	src, dst *state
+	// mirror is the database of [dst], from which records are passed to
+	// [write] when they are flushed
+	mirror      *memdb.Database
+	write       func(key, value []byte) error
+	pendingSize int
+}
+
+func (w *syncableStateWriter) writeAll(blkID ids.ID, height uint64) error {
+	srcCamino, ok := w.src.caminoState.(*caminoState)
+	if !ok {
+		return fmt.Errorf("%w: %T", errUnexpectedCaminoState, w.src.caminoState)
+	}
+	dstCamino, ok := w.dst.caminoState.(*caminoState)
+	if !ok {
+		return fmt.Errorf("%w: %T", errUnexpectedCaminoState, w.dst.caminoState)
+	}
+
+	subnetIDs, err := w.subnetIDs()
+	if err != nil {
+		return err
+	}
+
+	// Order of the written families must never change, as it defines the
+	// state root.
+	for _, db := range [][2]database.Database{
+		{w.src.singletonDB, w.dst.singletonDB},
+		{w.src.txDB, w.dst.txDB},
+		{w.src.transformedSubnetDB, w.dst.transformedSubnetDB},
+		{w.src.supplyDB, w.dst.supplyDB},
+	} {
+		if err := w.writeFlat(db[0], db[1]); err != nil {
+			return err
+		}
+	}
+
+	if err := w.writeUTXOs(); err != nil {
+		return err
+	}
+	if err := w.writeRewardUTXOs(); err != nil {
+		return err
+	}
+
+	for _, db := range [][2]database.Database{
+		{w.src.currentValidatorBaseDB, w.dst.currentValidatorBaseDB},
+		{w.src.currentSubnetValidatorBaseDB, w.dst.currentSubnetValidatorBaseDB},
+	} {
+		if err := w.writeLinked(db[0], db[1], w.canonicalUptime); err != nil {
+			return err
+		}
+	}
+	for _, db := range [][2]database.Database{
+		{w.src.currentDelegatorBaseDB, w.dst.currentDelegatorBaseDB},
+		{w.src.currentSubnetDelegatorBaseDB, w.dst.currentSubnetDelegatorBaseDB},
+		{w.src.pendingValidatorBaseDB, w.dst.pendingValidatorBaseDB},
+		{w.src.pendingDelegatorBaseDB, w.dst.pendingDelegatorBaseDB},
+		{w.src.pendingSubnetValidatorBaseDB, w.dst.pendingSubnetValidatorBaseDB},
+		{w.src.pendingSubnetDelegatorBaseDB, w.dst.pendingSubnetDelegatorBaseDB},
+		{w.src.subnetBaseDB, w.dst.subnetBaseDB},
+	} {
+		if err := w.writeLinked(db[0], db[1], nil); err != nil {
+			return err
+		}
+	}
+
+	for _, subnetID := range subnetIDs {
+		if err := w.writeLinked(
+			prefixdb.New(subnetID[:], w.src.chainDB),
+			prefixdb.New(subnetID[:], w.dst.chainDB),
+			nil,
+		); err != nil {
+			return err
+		}
+	}
+
+	if err := w.writeValidatorDiffs(height, subnetIDs); err != nil {
+		return err
+	}
+
+	for _, db := range [][2]database.Database{
+		{srcCamino.caminoDB, dstCamino.caminoDB},
+		{srcCamino.addressStateDB, dstCamino.addressStateDB},
+		{srcCamino.depositsDB, dstCamino.depositsDB},
+		{srcCamino.depositOwnersDB, dstCamino.depositOwnersDB},
+		{srcCamino.depositIDsByAddressDB, dstCamino.depositIDsByAddressDB},
+		{srcCamino.multisigOwnersDB, dstCamino.multisigOwnersDB},
+		{srcCamino.multisigPoliciesDB, dstCamino.multisigPoliciesDB},
+		{srcCamino.consortiumMemberNodesDB, dstCamino.consortiumMemberNodesDB},
+		{srcCamino.nodesByConsortiumMemberDB, dstCamino.nodesByConsortiumMemberDB},
+		{srcCamino.kycExpirationsDB, dstCamino.kycExpirationsDB},
+	} {
+		if err := w.writeFlat(db[0], db[1]); err != nil {
+			return err
+		}
+	}
+	for _, db := range [][2]database.Database{
+		{srcCamino.depositOffersDB, dstCamino.depositOffersDB},
+		{srcCamino.proposalsDB, dstCamino.proposalsDB},
+	} {
+		if err := w.writeLinked(db[0], db[1], nil); err != nil {
+			return err
+		}
+	}
+
+	blkBytes, err := w.src.blockDB.Get(blkID[:])
+	if err != nil {
+		return fmt.Errorf("failed to get block %s: %w", blkID, err)
+	}
+	if err := w.dst.blockDB.Put(blkID[:], blkBytes); err != nil {
+		return err
+	}
+	return w.flush()
+}
+
+// subnetIDs returns the primary network ID and the IDs of all subnets.
+func (w *syncableStateWriter) subnetIDs() ([]ids.ID, error) {
+	subnetIDs := []ids.ID{constants.PrimaryNetworkID}
+	it := w.src.subnetDB.NewIterator()
+	defer it.Release()
+	for it.Next() {
+		subnetID, err := ids.ToID(it.Key())
+		if err != nil {
+			return nil, err
+		}
+		subnetIDs = append(subnetIDs, subnetID)
+	}
+	return subnetIDs, it.Error()
+}
+
+// writeFlat copies all records of [src] to [dst].
+func (w *syncableStateWriter) writeFlat(src, dst database.Database) error {
+	it := src.NewIterator()
+	defer it.Release()
+	for it.Next() {
+		key := it.Key()
+		value := it.Value()
+		if err := dst.Put(key, value); err != nil {
+			return err
+		}
+		// Flat records are flushed in key order, so they could be flushed
+		// at any time.
+		w.pendingSize += len(key) + len(value)
+		if w.pendingSize < syncFlushSize {
+			continue
+		}
+		if err := w.flush(); err != nil {
+			return err
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	return w.flush()
+}
+
+// writeLinked rebuilds the linked list stored in [src] in [dst], inserting
+// elements in the descending key order, so the rebuilt list is iterated in
+// the ascending key order. If [canonicalize] isn't nil, it is applied to the
+// list values.
+func (w *syncableStateWriter) writeLinked(src, dst database.Database, canonicalize func(key, value []byte) ([]byte, error)) error {
+	if err := w.putLinked(src, dst, canonicalize); err != nil {
+		return err
+	}
+	if w.pendingSize == 0 {
+		return nil
+	}
+	// Linked list nodes are updated on every insertion, so the whole list is
+	// flushed at once.
+	return w.flush()
+}
+
+// putLinked rebuilds the linked list the same way as writeLinked, but
+// doesn't flush it.
+func (w *syncableStateWriter) putLinked(src, dst database.Database, canonicalize func(key, value []byte) ([]byte, error)) error {
+	it := linkeddb.NewDefault(src).NewIterator()
+	defer it.Release()
+
+	var keys, values [][]byte
+	for it.Next() {
+		key := it.Key()
+		value := it.Value()
+		if canonicalize != nil {
+			var err error
+			value, err = canonicalize(key, value)
+			if err != nil {
+				return err
+			}
+		}
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	indices := make([]int, len(keys))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.Slice(indices, func(i, j int) bool {
+		return bytes.Compare(keys[indices[i]], keys[indices[j]]) > 0
+	})
+
+	list := linkeddb.NewDefault(dst)
+	for _, i := range indices {
+		if err := list.Put(keys[i], values[i]); err != nil {
+			return err
+		}
+		w.pendingSize += len(keys[i]) + len(values[i])
+	}
+	return nil
+}
+
+// canonicalUptime replaces the uptime of the current validator with the
+// uptime of the validator, that was just added.
+func (w *syncableStateWriter) canonicalUptime(key, value []byte) ([]byte, error) {
+	txID, err := ids.ToID(key)
+	if err != nil {
+		return nil, err
+	}
+	uptime := &uptimeAndReward{}
+	if _, err := blocks.GenesisCodec.Unmarshal(value, uptime); err != nil {
+		return nil, err
+	}
+	tx, _, err := w.src.GetTx(txID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get staker tx %s: %w", txID, err)
+	}
+	staker, ok := tx.Unsigned.(txs.Staker)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected txs.Staker but got %T", errWrongTxType, tx.Unsigned)
+	}
+	return blocks.GenesisCodec.Marshal(blocks.Version, &uptimeAndReward{
+		UpDuration:      0,
+		LastUpdated:     uint64(staker.StartTime().Unix()),
+		PotentialReward: uptime.PotentialReward,
+	})
+}
+
+// writeUTXOs rebuilds the utxos and their address index.
+func (w *syncableStateWriter) writeUTXOs() error {
+	it := avax.NewUTXOIterator(w.src.utxoDB)
+	defer it.Release()
+	for it.Next() {
+		utxo := &avax.UTXO{}
+		if _, err := txs.GenesisCodec.Unmarshal(it.Value(), utxo); err != nil {
+			return err
+		}
+		if err := w.dst.utxoState.PutUTXO(utxo); err != nil {
+			return err
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	// Address index lists are updated on every insertion, so all utxos are
+	// flushed at once.
+	return w.flush()
+}
+
+func (w *syncableStateWriter) writeRewardUTXOs() error {
+	it := w.src.txDB.NewIterator()
+	defer it.Release()
+	for it.Next() {
+		txID := it.Key()
+		if err := w.writeLinked(
+			prefixdb.New(txID, w.src.rewardUTXODB),
+			prefixdb.New(txID, w.dst.rewardUTXODB),
+			nil,
+		); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// writeValidatorDiffs writes the validator weight diffs of [subnetIDs] and
+// the validator public key diffs for all heights up to [height].
+//
+// Diffs are stored in lists under hashed prefixes of their heights, which
+// aren't in the key range of [validatorWeightDiffsDB] and
+// [validatorPublicKeyDiffsDB], so the lists are looked up by height. Lists
+// are flushed by size once all lists of the height are written.
+func (w *syncableStateWriter) writeValidatorDiffs(height uint64, subnetIDs []ids.ID) error {
+	for h := uint64(0); h <= height; h++ {
+		for _, subnetID := range subnetIDs {
+			prefixBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, heightWithSubnet{
+				Height:   h,
+				SubnetID: subnetID,
+			})
+			if err != nil {
+				return err
+			}
+			if err := w.putLinked(
+				prefixdb.New(prefixBytes, w.src.validatorWeightDiffsDB),
+				prefixdb.New(prefixBytes, w.dst.validatorWeightDiffsDB),
+				nil,
+			); err != nil {
+				return err
+			}
+		}
+
+		heightBytes := database.PackUInt64(h)
+		if err := w.putLinked(
+			prefixdb.New(heightBytes, w.src.validatorPublicKeyDiffsDB),
+			prefixdb.New(heightBytes, w.dst.validatorPublicKeyDiffsDB),
+			nil,
+		); err != nil {
+			return err
+		}
+
+		if w.pendingSize < syncFlushSize {
+			continue
+		}
+		if err := w.flush(); err != nil {
+			return err
+		}
+	}
+	return w.flush()
+}
+
+// flush passes all pending records to [write] in key order.
+func (w *syncableStateWriter) flush() error {
+	w.pendingSize = 0
+	if err := w.dst.baseDB.Commit(); err != nil {
+		return err
+	}
+
+	it := w.mirror.NewIterator()
+	defer it.Release()
+	for it.Next() {
+		key := it.Key()
+		if err := w.write(key, it.Value()); err != nil {
+			return err
+		}
+		if err := w.mirror.Delete(key); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// Reload drops all cached and uncommitted changes and loads the state from the
+// database again. It's used after the database was replaced by state sync.
+func (s *state) Reload() error {
+	s.baseDB.Abort()
+
+	for _, c := range []cache.Cacher{
+		s.blockCache,
+		s.validatorWeightDiffsCache,
+		s.validatorPublicKeyDiffsCache,
+		s.txCache,
+		s.rewardUTXOsCache,
+		s.transformedSubnetCache,
+		s.supplyCache,
+		s.chainCache,
+		s.chainDBCache,
+	} {
+		c.Flush()
+	}
+
+	s.addedBlocks = make(map[ids.ID]stateBlk)
+	s.addedTxs = make(map[ids.ID]*txAndStatus)
+	s.addedRewardUTXOs = make(map[ids.ID][]*avax.UTXO)
+	s.modifiedUTXOs = make(map[ids.ID]*avax.UTXO)
+	s.cachedSubnets = nil
+	s.addedSubnets = nil
+	s.transformedSubnets = make(map[ids.ID]*txs.Tx)
+	s.modifiedSupplies = make(map[ids.ID]uint64)
+	s.addedChains = make(map[ids.ID][]*txs.Tx)
+	s.validatorUptimes = newValidatorUptimes()
+
+	// Linked lists cache their nodes, so they are created anew
+	s.currentValidatorList = linkeddb.NewDefault(s.currentValidatorBaseDB)
+	s.currentDelegatorList = linkeddb.NewDefault(s.currentDelegatorBaseDB)
+	s.currentSubnetValidatorList = linkeddb.NewDefault(s.currentSubnetValidatorBaseDB)
+	s.currentSubnetDelegatorList = linkeddb.NewDefault(s.currentSubnetDelegatorBaseDB)
+	s.pendingValidatorList = linkeddb.NewDefault(s.pendingValidatorBaseDB)
+	s.pendingDelegatorList = linkeddb.NewDefault(s.pendingDelegatorBaseDB)
+	s.pendingSubnetValidatorList = linkeddb.NewDefault(s.pendingSubnetValidatorBaseDB)
+	s.pendingSubnetDelegatorList = linkeddb.NewDefault(s.pendingSubnetDelegatorBaseDB)
+	s.subnetDB = linkeddb.NewDefault(s.subnetBaseDB)
+
+	// Metered caches can't be registered twice, so the reloaded utxo state
+	// isn't metered.
+	s.utxoState = avax.NewUTXOState(s.utxoDB, txs.GenesisCodec)
+
+	s.caminoState.Reset()
+
+	if err := s.loadMetadata(); err != nil {
+		return err
+	}
+	if err := s.loadCurrentValidators(); err != nil {
+		return err
+	}
+	if err := s.loadPendingValidators(); err != nil {
+		return err
+	}
+	if err := s.caminoState.Load(s); err != nil {
+		return err
+	}
+	return s.reloadValidatorSets()
+}
+
+// reloadValidatorSets replaces the validators of the tracked validator sets
+// with the current validators.
+func (s *state) reloadValidatorSets() error {
+	subnetIDs := append([]ids.ID{constants.PrimaryNetworkID}, s.cfg.WhitelistedSubnets.List()...)
+	for _, subnetID := range subnetIDs {
+		vdrs, ok := s.cfg.Validators.Get(subnetID)
+		if !ok {
+			return fmt.Errorf("%w: %s", errMissingValidatorSet, subnetID)
+		}
+		if err := clearValidatorSet(vdrs); err != nil {
+			return err
+		}
+		if err := s.ValidatorSet(subnetID, vdrs); err != nil {
+			return err
+		}
+	}
+
+	primaryValidators, _ := s.cfg.Validators.Get(constants.PrimaryNetworkID)
+	s.metrics.SetLocalStake(primaryValidators.GetWeight(s.ctx.NodeID))
+	s.metrics.SetTotalStake(primaryValidators.Weight())
+	return nil
+}
+
+func clearValidatorSet(vdrs validators.Set) error {
+	for _, vdr := range vdrs.List() {
+		if err := vdrs.RemoveWeight(vdr.NodeID, vdr.Weight); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Reset drops all cached and uncommitted changes of camino state. Load must be
+// called afterwards.
+func (cs *caminoState) Reset() {
+	cs.addressStateCache.Flush()
+	cs.depositsCache.Flush()
+	cs.consortiumMemberNodesCache.Flush()
+
+	cs.caminoDiff = newCaminoDiff()
+	cs.genesisSynced = false
+	cs.depositOffers = make(map[ids.ID]*deposit.Offer)
+	cs.depositOffersList = linkeddb.NewDefault(cs.depositOffersDB)
+	cs.proposals = make(map[ids.ID]*dao.ProposalState)
+	cs.proposalsList = linkeddb.NewDefault(cs.proposalsDB)
+	cs.kycExpirations = make(map[ids.ShortID]uint64)
+	cs.baseFee = nil
+}
@@ -93,8 +93,8 @@ func TestSyncGenesis(t *testing.T) {
 			Creds: nil,
 		},
 	}
-	depositTxs[0].Initialize(utils.RandomBytes(16), utils.RandomBytes(16))
-	depositTxs[1].Initialize(utils.RandomBytes(16), utils.RandomBytes(16))
+	depositTxs[0].SetBytes(utils.RandomBytes(16), utils.RandomBytes(16))
+	depositTxs[1].SetBytes(utils.RandomBytes(16), utils.RandomBytes(16))
 
 	type args struct {
 		s *state
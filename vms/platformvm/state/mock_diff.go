@@ -11,6 +11,7 @@ import (
 	ids "github.com/ava-labs/avalanchego/ids"
 	set "github.com/ava-labs/avalanchego/utils/set"
 	avax "github.com/ava-labs/avalanchego/vms/components/avax"
+	dao "github.com/ava-labs/avalanchego/vms/platformvm/dao"
 	deposit "github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	locked "github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	status "github.com/ava-labs/avalanchego/vms/platformvm/status"
@@ -55,6 +56,18 @@ func (mr *MockDiffMockRecorder) AddChain(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChain", reflect.TypeOf((*MockDiff)(nil).AddChain), arg0)
 }
 
+// AddDeposit mocks base method.
+func (m *MockDiff) AddDeposit(arg0 ids.ID, arg1 *deposit.Deposit, arg2 []ids.ShortID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddDeposit", arg0, arg1, arg2)
+}
+
+// AddDeposit indicates an expected call of AddDeposit.
+func (mr *MockDiffMockRecorder) AddDeposit(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDeposit", reflect.TypeOf((*MockDiff)(nil).AddDeposit), arg0, arg1, arg2)
+}
+
 // AddDepositOffer mocks base method.
 func (m *MockDiff) AddDepositOffer(arg0 *deposit.Offer) {
 	m.ctrl.T.Helper()
@@ -256,6 +269,96 @@ func (mr *MockDiffMockRecorder) GetAllDepositOffers() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDepositOffers", reflect.TypeOf((*MockDiff)(nil).GetAllDepositOffers))
 }
 
+// GetAllKycExpirations mocks base method.
+func (m *MockDiff) GetAllKycExpirations() (map[ids.ShortID]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllKycExpirations")
+	ret0, _ := ret[0].(map[ids.ShortID]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllKycExpirations indicates an expected call of GetAllKycExpirations.
+func (mr *MockDiffMockRecorder) GetAllKycExpirations() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllKycExpirations", reflect.TypeOf((*MockDiff)(nil).GetAllKycExpirations))
+}
+
+// GetAllProposals mocks base method.
+func (m *MockDiff) GetAllProposals() ([]*dao.ProposalState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllProposals")
+	ret0, _ := ret[0].([]*dao.ProposalState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllProposals indicates an expected call of GetAllProposals.
+func (mr *MockDiffMockRecorder) GetAllProposals() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllProposals", reflect.TypeOf((*MockDiff)(nil).GetAllProposals))
+}
+
+// GetBaseFee mocks base method.
+func (m *MockDiff) GetBaseFee() (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBaseFee")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBaseFee indicates an expected call of GetBaseFee.
+func (mr *MockDiffMockRecorder) GetBaseFee() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBaseFee", reflect.TypeOf((*MockDiff)(nil).GetBaseFee))
+}
+
+// GetConsortiumMemberNode mocks base method.
+func (m *MockDiff) GetConsortiumMemberNode(arg0 ids.ShortID) (ids.NodeID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsortiumMemberNode", arg0)
+	ret0, _ := ret[0].(ids.NodeID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsortiumMemberNode indicates an expected call of GetConsortiumMemberNode.
+func (mr *MockDiffMockRecorder) GetConsortiumMemberNode(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsortiumMemberNode", reflect.TypeOf((*MockDiff)(nil).GetConsortiumMemberNode), arg0)
+}
+
+// GetDepositIDsByAddress mocks base method.
+func (m *MockDiff) GetDepositIDsByAddress(arg0 ids.ShortID) ([]ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDepositIDsByAddress", arg0)
+	ret0, _ := ret[0].([]ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDepositIDsByAddress indicates an expected call of GetDepositIDsByAddress.
+func (mr *MockDiffMockRecorder) GetDepositIDsByAddress(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDepositIDsByAddress", reflect.TypeOf((*MockDiff)(nil).GetDepositIDsByAddress), arg0)
+}
+
+// GetKycExpiration mocks base method.
+func (m *MockDiff) GetKycExpiration(arg0 ids.ShortID) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKycExpiration", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKycExpiration indicates an expected call of GetKycExpiration.
+func (mr *MockDiffMockRecorder) GetKycExpiration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKycExpiration", reflect.TypeOf((*MockDiff)(nil).GetKycExpiration), arg0)
+}
+
 // GetMultisigOwner mocks base method.
 func (m *MockDiff) GetMultisigOwner(arg0 ids.ShortID) (*MultisigOwner, error) {
 	m.ctrl.T.Helper()
@@ -271,6 +374,84 @@ func (mr *MockDiffMockRecorder) GetMultisigOwner(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultisigOwner", reflect.TypeOf((*MockDiff)(nil).GetMultisigOwner), arg0)
 }
 
+// GetMultisigPolicy mocks base method.
+func (m *MockDiff) GetMultisigPolicy(arg0 ids.ShortID) (*MultisigPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMultisigPolicy", arg0)
+	ret0, _ := ret[0].(*MultisigPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMultisigPolicy indicates an expected call of GetMultisigPolicy.
+func (mr *MockDiffMockRecorder) GetMultisigPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultisigPolicy", reflect.TypeOf((*MockDiff)(nil).GetMultisigPolicy), arg0)
+}
+
+// GetProposal mocks base method.
+func (m *MockDiff) GetProposal(arg0 ids.ID) (*dao.ProposalState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProposal", arg0)
+	ret0, _ := ret[0].(*dao.ProposalState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProposal indicates an expected call of GetProposal.
+func (mr *MockDiffMockRecorder) GetProposal(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposal", reflect.TypeOf((*MockDiff)(nil).GetProposal), arg0)
+}
+
+// RemoveKycExpiration mocks base method.
+func (m *MockDiff) RemoveKycExpiration(arg0 ids.ShortID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveKycExpiration", arg0)
+}
+
+// RemoveKycExpiration indicates an expected call of RemoveKycExpiration.
+func (mr *MockDiffMockRecorder) RemoveKycExpiration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveKycExpiration", reflect.TypeOf((*MockDiff)(nil).RemoveKycExpiration), arg0)
+}
+
+// RemoveProposal mocks base method.
+func (m *MockDiff) RemoveProposal(arg0 ids.ID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveProposal", arg0)
+}
+
+// RemoveProposal indicates an expected call of RemoveProposal.
+func (mr *MockDiffMockRecorder) RemoveProposal(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveProposal", reflect.TypeOf((*MockDiff)(nil).RemoveProposal), arg0)
+}
+
+// SetBaseFee mocks base method.
+func (m *MockDiff) SetBaseFee(arg0 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetBaseFee", arg0)
+}
+
+// SetBaseFee indicates an expected call of SetBaseFee.
+func (mr *MockDiffMockRecorder) SetBaseFee(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBaseFee", reflect.TypeOf((*MockDiff)(nil).SetBaseFee), arg0)
+}
+
+// SetKycExpiration mocks base method.
+func (m *MockDiff) SetKycExpiration(arg0 ids.ShortID, arg1 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetKycExpiration", arg0, arg1)
+}
+
+// SetKycExpiration indicates an expected call of SetKycExpiration.
+func (mr *MockDiffMockRecorder) SetKycExpiration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKycExpiration", reflect.TypeOf((*MockDiff)(nil).SetKycExpiration), arg0, arg1)
+}
+
 // SetNodeConsortiumMember mocks base method.
 func (m *MockDiff) SetNodeConsortiumMember(arg0 ids.NodeID, arg1 *ids.ShortID) {
 	m.ctrl.T.Helper()
@@ -637,6 +818,30 @@ func (mr *MockDiffMockRecorder) SetMultisigOwner(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMultisigOwner", reflect.TypeOf((*MockDiff)(nil).SetMultisigOwner), arg0)
 }
 
+// SetMultisigPolicy mocks base method.
+func (m *MockDiff) SetMultisigPolicy(arg0 *MultisigPolicy) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMultisigPolicy", arg0)
+}
+
+// SetMultisigPolicy indicates an expected call of SetMultisigPolicy.
+func (mr *MockDiffMockRecorder) SetMultisigPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMultisigPolicy", reflect.TypeOf((*MockDiff)(nil).SetMultisigPolicy), arg0)
+}
+
+// SetProposal mocks base method.
+func (m *MockDiff) SetProposal(arg0 *dao.ProposalState) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetProposal", arg0)
+}
+
+// SetProposal indicates an expected call of SetProposal.
+func (mr *MockDiffMockRecorder) SetProposal(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProposal", reflect.TypeOf((*MockDiff)(nil).SetProposal), arg0)
+}
+
 // SetTimestamp mocks base method.
 func (m *MockDiff) SetTimestamp(arg0 time.Time) {
 	m.ctrl.T.Helper()
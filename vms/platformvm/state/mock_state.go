@@ -16,6 +16,7 @@ import (
 	set "github.com/ava-labs/avalanchego/utils/set"
 	avax "github.com/ava-labs/avalanchego/vms/components/avax"
 	blocks "github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+	dao "github.com/ava-labs/avalanchego/vms/platformvm/dao"
 	deposit "github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	locked "github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	status "github.com/ava-labs/avalanchego/vms/platformvm/status"
@@ -72,6 +73,18 @@ func (mr *MockStateMockRecorder) AddChain(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChain", reflect.TypeOf((*MockState)(nil).AddChain), arg0)
 }
 
+// AddDeposit mocks base method.
+func (m *MockState) AddDeposit(arg0 ids.ID, arg1 *deposit.Deposit, arg2 []ids.ShortID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddDeposit", arg0, arg1, arg2)
+}
+
+// AddDeposit indicates an expected call of AddDeposit.
+func (mr *MockStateMockRecorder) AddDeposit(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDeposit", reflect.TypeOf((*MockState)(nil).AddDeposit), arg0, arg1, arg2)
+}
+
 // AddDepositOffer mocks base method.
 func (m *MockState) AddDepositOffer(arg0 *deposit.Offer) {
 	m.ctrl.T.Helper()
@@ -214,6 +227,18 @@ func (mr *MockStateMockRecorder) CommitBatch() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitBatch", reflect.TypeOf((*MockState)(nil).CommitBatch))
 }
 
+// Committed mocks base method.
+func (m *MockState) Committed() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Committed")
+}
+
+// Committed indicates an expected call of Committed.
+func (mr *MockStateMockRecorder) Committed() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Committed", reflect.TypeOf((*MockState)(nil).Committed))
+}
+
 // DeleteCurrentDelegator mocks base method.
 func (m *MockState) DeleteCurrentDelegator(arg0 *Staker) {
 	m.ctrl.T.Helper()
@@ -304,6 +329,51 @@ func (mr *MockStateMockRecorder) GetAllDepositOffers() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDepositOffers", reflect.TypeOf((*MockState)(nil).GetAllDepositOffers))
 }
 
+// GetAllKycExpirations mocks base method.
+func (m *MockState) GetAllKycExpirations() (map[ids.ShortID]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllKycExpirations")
+	ret0, _ := ret[0].(map[ids.ShortID]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllKycExpirations indicates an expected call of GetAllKycExpirations.
+func (mr *MockStateMockRecorder) GetAllKycExpirations() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllKycExpirations", reflect.TypeOf((*MockState)(nil).GetAllKycExpirations))
+}
+
+// GetAllProposals mocks base method.
+func (m *MockState) GetAllProposals() ([]*dao.ProposalState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllProposals")
+	ret0, _ := ret[0].([]*dao.ProposalState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllProposals indicates an expected call of GetAllProposals.
+func (mr *MockStateMockRecorder) GetAllProposals() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllProposals", reflect.TypeOf((*MockState)(nil).GetAllProposals))
+}
+
+// GetBaseFee mocks base method.
+func (m *MockState) GetBaseFee() (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBaseFee")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBaseFee indicates an expected call of GetBaseFee.
+func (mr *MockStateMockRecorder) GetBaseFee() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBaseFee", reflect.TypeOf((*MockState)(nil).GetBaseFee))
+}
+
 // GetChains mocks base method.
 func (m *MockState) GetChains(arg0 ids.ID) ([]*txs.Tx, error) {
 	m.ctrl.T.Helper()
@@ -319,6 +389,21 @@ func (mr *MockStateMockRecorder) GetChains(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChains", reflect.TypeOf((*MockState)(nil).GetChains), arg0)
 }
 
+// GetConsortiumMemberNode mocks base method.
+func (m *MockState) GetConsortiumMemberNode(arg0 ids.ShortID) (ids.NodeID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsortiumMemberNode", arg0)
+	ret0, _ := ret[0].(ids.NodeID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsortiumMemberNode indicates an expected call of GetConsortiumMemberNode.
+func (mr *MockStateMockRecorder) GetConsortiumMemberNode(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsortiumMemberNode", reflect.TypeOf((*MockState)(nil).GetConsortiumMemberNode), arg0)
+}
+
 // GetCurrentDelegatorIterator mocks base method.
 func (m *MockState) GetCurrentDelegatorIterator(arg0 ids.ID, arg1 ids.NodeID) (StakerIterator, error) {
 	m.ctrl.T.Helper()
@@ -394,6 +479,21 @@ func (mr *MockStateMockRecorder) GetDeposit(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeposit", reflect.TypeOf((*MockState)(nil).GetDeposit), arg0)
 }
 
+// GetDepositIDsByAddress mocks base method.
+func (m *MockState) GetDepositIDsByAddress(arg0 ids.ShortID) ([]ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDepositIDsByAddress", arg0)
+	ret0, _ := ret[0].([]ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDepositIDsByAddress indicates an expected call of GetDepositIDsByAddress.
+func (mr *MockStateMockRecorder) GetDepositIDsByAddress(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDepositIDsByAddress", reflect.TypeOf((*MockState)(nil).GetDepositIDsByAddress), arg0)
+}
+
 // GetDepositOffer mocks base method.
 func (m *MockState) GetDepositOffer(arg0 ids.ID) (*deposit.Offer, error) {
 	m.ctrl.T.Helper()
@@ -409,6 +509,21 @@ func (mr *MockStateMockRecorder) GetDepositOffer(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDepositOffer", reflect.TypeOf((*MockState)(nil).GetDepositOffer), arg0)
 }
 
+// GetKycExpiration mocks base method.
+func (m *MockState) GetKycExpiration(arg0 ids.ShortID) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKycExpiration", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKycExpiration indicates an expected call of GetKycExpiration.
+func (mr *MockStateMockRecorder) GetKycExpiration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKycExpiration", reflect.TypeOf((*MockState)(nil).GetKycExpiration), arg0)
+}
+
 // GetLastAccepted mocks base method.
 func (m *MockState) GetLastAccepted() ids.ID {
 	m.ctrl.T.Helper()
@@ -438,6 +553,84 @@ func (mr *MockStateMockRecorder) GetMultisigOwner(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultisigOwner", reflect.TypeOf((*MockState)(nil).GetMultisigOwner), arg0)
 }
 
+// GetMultisigPolicy mocks base method.
+func (m *MockState) GetMultisigPolicy(arg0 ids.ShortID) (*MultisigPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMultisigPolicy", arg0)
+	ret0, _ := ret[0].(*MultisigPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMultisigPolicy indicates an expected call of GetMultisigPolicy.
+func (mr *MockStateMockRecorder) GetMultisigPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultisigPolicy", reflect.TypeOf((*MockState)(nil).GetMultisigPolicy), arg0)
+}
+
+// GetProposal mocks base method.
+func (m *MockState) GetProposal(arg0 ids.ID) (*dao.ProposalState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProposal", arg0)
+	ret0, _ := ret[0].(*dao.ProposalState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProposal indicates an expected call of GetProposal.
+func (mr *MockStateMockRecorder) GetProposal(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposal", reflect.TypeOf((*MockState)(nil).GetProposal), arg0)
+}
+
+// RemoveKycExpiration mocks base method.
+func (m *MockState) RemoveKycExpiration(arg0 ids.ShortID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveKycExpiration", arg0)
+}
+
+// RemoveKycExpiration indicates an expected call of RemoveKycExpiration.
+func (mr *MockStateMockRecorder) RemoveKycExpiration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveKycExpiration", reflect.TypeOf((*MockState)(nil).RemoveKycExpiration), arg0)
+}
+
+// RemoveProposal mocks base method.
+func (m *MockState) RemoveProposal(arg0 ids.ID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveProposal", arg0)
+}
+
+// RemoveProposal indicates an expected call of RemoveProposal.
+func (mr *MockStateMockRecorder) RemoveProposal(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveProposal", reflect.TypeOf((*MockState)(nil).RemoveProposal), arg0)
+}
+
+// SetBaseFee mocks base method.
+func (m *MockState) SetBaseFee(arg0 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetBaseFee", arg0)
+}
+
+// SetBaseFee indicates an expected call of SetBaseFee.
+func (mr *MockStateMockRecorder) SetBaseFee(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBaseFee", reflect.TypeOf((*MockState)(nil).SetBaseFee), arg0)
+}
+
+// SetKycExpiration mocks base method.
+func (m *MockState) SetKycExpiration(arg0 ids.ShortID, arg1 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetKycExpiration", arg0, arg1)
+}
+
+// SetKycExpiration indicates an expected call of SetKycExpiration.
+func (mr *MockStateMockRecorder) SetKycExpiration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKycExpiration", reflect.TypeOf((*MockState)(nil).SetKycExpiration), arg0, arg1)
+}
+
 // SetNodeConsortiumMember mocks base method.
 func (m *MockState) SetNodeConsortiumMember(arg0 ids.NodeID, arg1 *ids.ShortID) {
 	m.ctrl.T.Helper()
@@ -740,6 +933,20 @@ func (mr *MockStateMockRecorder) PutPendingValidator(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutPendingValidator", reflect.TypeOf((*MockState)(nil).PutPendingValidator), arg0)
 }
 
+// Reload mocks base method.
+func (m *MockState) Reload() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reload")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reload indicates an expected call of Reload.
+func (mr *MockStateMockRecorder) Reload() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reload", reflect.TypeOf((*MockState)(nil).Reload))
+}
+
 // SetAddressStates mocks base method.
 func (m *MockState) SetAddressStates(arg0 ids.ShortID, arg1 uint64) {
 	m.ctrl.T.Helper()
@@ -800,6 +1007,30 @@ func (mr *MockStateMockRecorder) SetMultisigOwner(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMultisigOwner", reflect.TypeOf((*MockState)(nil).SetMultisigOwner), arg0)
 }
 
+// SetMultisigPolicy mocks base method.
+func (m *MockState) SetMultisigPolicy(arg0 *MultisigPolicy) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMultisigPolicy", arg0)
+}
+
+// SetMultisigPolicy indicates an expected call of SetMultisigPolicy.
+func (mr *MockStateMockRecorder) SetMultisigPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMultisigPolicy", reflect.TypeOf((*MockState)(nil).SetMultisigPolicy), arg0)
+}
+
+// SetProposal mocks base method.
+func (m *MockState) SetProposal(arg0 *dao.ProposalState) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetProposal", arg0)
+}
+
+// SetProposal indicates an expected call of SetProposal.
+func (mr *MockStateMockRecorder) SetProposal(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProposal", reflect.TypeOf((*MockState)(nil).SetProposal), arg0)
+}
+
 // SetTimestamp mocks base method.
 func (m *MockState) SetTimestamp(arg0 time.Time) {
 	m.ctrl.T.Helper()
@@ -841,6 +1072,18 @@ func (mr *MockStateMockRecorder) UTXOIDs(arg0, arg1, arg2 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UTXOIDs", reflect.TypeOf((*MockState)(nil).UTXOIDs), arg0, arg1, arg2)
 }
 
+// UpdateDeposit mocks base method.
+func (m *MockState) UpdateDeposit(arg0 ids.ID, arg1 *deposit.Deposit) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateDeposit", arg0, arg1)
+}
+
+// UpdateDeposit indicates an expected call of UpdateDeposit.
+func (mr *MockStateMockRecorder) UpdateDeposit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeposit", reflect.TypeOf((*MockState)(nil).UpdateDeposit), arg0, arg1)
+}
+
 // ValidatorSet mocks base method.
 func (m *MockState) ValidatorSet(arg0 ids.ID, arg1 validators.Set) error {
 	m.ctrl.T.Helper()
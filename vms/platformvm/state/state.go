@@ -160,6 +160,15 @@ type State interface {
 	// all pending changes to the base database.
 	CommitBatch() (database.Batch, error)
 
+	// Committed must be called after the batch returned by CommitBatch was
+	// written. It publishes the changes, that must not be visible outside of
+	// the state before they are committed, e.g. registered nodes.
+	Committed()
+
+	// Discard all cached and uncommitted changes and load the state from
+	// the database again.
+	Reload() error
+
 	Close() error
 }
 
@@ -501,6 +510,7 @@ func new(
 	if err != nil {
 		return nil, err
 	}
+	caminoState.registeredNodes = cfg.CaminoConfig.RegisteredNodes
 
 	return &state{
 		validatorUptimes: newValidatorUptimes(),
@@ -1090,7 +1100,7 @@ func (s *state) load() error {
 		s.loadCurrentValidators(),
 		s.loadPendingValidators(),
 		s.initValidatorSets(),
-		s.caminoState.Load(),
+		s.caminoState.Load(s),
 	)
 	return errs.Err
 }
@@ -1517,11 +1527,20 @@ func (s *state) Commit() error {
 	if err != nil {
 		return err
 	}
-	return batch.Write()
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	s.Committed()
+	return nil
 }
 
 func (s *state) Abort() {
 	s.baseDB.Abort()
+	s.caminoState.Abort()
+}
+
+func (s *state) Committed() {
+	s.caminoState.Committed()
 }
 
 func (s *state) CommitBatch() (database.Batch, error) {
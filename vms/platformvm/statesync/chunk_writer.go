@@ -0,0 +1,60 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package statesync
+
+import (
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+// targetChunkSize is the size of the chunk records, after which the chunk is
+// completed. Chunks are larger, only if they consist of a single large record.
+const targetChunkSize = 256 * units.KiB
+
+// chunkWriter splits the stream of records into chunks.
+type chunkWriter struct {
+	onChunk func(index uint32, chunkBytes []byte) error
+
+	records []Record
+	size    int
+	hashes  []ids.ID
+}
+
+func newChunkWriter(onChunk func(index uint32, chunkBytes []byte) error) *chunkWriter {
+	return &chunkWriter{onChunk: onChunk}
+}
+
+func (w *chunkWriter) add(key, value []byte) error {
+	recordSize := 2*wrappers.IntLen + len(key) + len(value)
+	if len(w.records) > 0 && w.size+recordSize > targetChunkSize {
+		if err := w.flush(); err != nil {
+			return err
+		}
+	}
+	w.records = append(w.records, Record{
+		Key:   append([]byte(nil), key...),
+		Value: append([]byte(nil), value...),
+	})
+	w.size += recordSize
+	return nil
+}
+
+// flush completes the current chunk.
+func (w *chunkWriter) flush() error {
+	if len(w.records) == 0 {
+		return nil
+	}
+	chunkBytes, err := c.Marshal(codecVersion, &Chunk{Records: w.records})
+	if err != nil {
+		return err
+	}
+	if err := w.onChunk(uint32(len(w.hashes)), chunkBytes); err != nil {
+		return err
+	}
+	w.hashes = append(w.hashes, ChunkHash(chunkBytes))
+	w.records = nil
+	w.size = 0
+	return nil
+}
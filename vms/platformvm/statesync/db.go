@@ -0,0 +1,103 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package statesync
+
+import (
+	"context"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+// batchSize is the size of the batch, after which it's written to the
+// database.
+const batchSize = 4 * units.MiB
+
+var (
+	// Layout of the state sync database. Databases are nested, so all raw
+	// records share the prefix of the state sync database.
+	summaryPrefix   = []byte("summary")
+	chunkPrefix     = []byte("chunk")
+	chunkHashPrefix = []byte("chunkHash")
+	stagingPrefix   = []byte("staging")
+	downloadPrefix  = []byte("download")
+	applyingKey     = []byte("applying")
+)
+
+// chunkKey returns the key of the chunk (or its hash) with [index] of the
+// summary at [height]. Keys of the same summary share the height prefix.
+func chunkKey(height uint64, index uint32) []byte {
+	p := wrappers.Packer{Bytes: make([]byte, wrappers.LongLen+wrappers.IntLen)}
+	p.PackLong(height)
+	p.PackInt(index)
+	return p.Bytes
+}
+
+// clearDB deletes all records of [db].
+func clearDB(db database.Database) error {
+	it := db.NewIterator()
+	defer it.Release()
+
+	batch := db.NewBatch()
+	for it.Next() {
+		if err := batch.Delete(it.Key()); err != nil {
+			return err
+		}
+		if batch.Size() < batchSize {
+			continue
+		}
+		if err := batch.Write(); err != nil {
+			return err
+		}
+		batch.Reset()
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// copyDB puts all records of [src] into [dst].
+func copyDB(dst, src database.Database) error {
+	it := src.NewIterator()
+	defer it.Release()
+
+	batch := dst.NewBatch()
+	for it.Next() {
+		if err := batch.Put(it.Key(), it.Value()); err != nil {
+			return err
+		}
+		if batch.Size() < batchSize {
+			continue
+		}
+		if err := batch.Write(); err != nil {
+			return err
+		}
+		batch.Reset()
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+var _ database.Iterator = (*ctxIterator)(nil)
+
+// ctxIterator stops the iteration, when its context is done.
+type ctxIterator struct {
+	database.Iterator
+	ctx context.Context
+}
+
+func (it *ctxIterator) Next() bool {
+	return it.ctx.Err() == nil && it.Iterator.Next()
+}
+
+func (it *ctxIterator) Error() error {
+	if err := it.ctx.Err(); err != nil {
+		return err
+	}
+	return it.Iterator.Error()
+}
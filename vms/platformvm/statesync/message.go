@@ -0,0 +1,86 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package statesync
+
+import (
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+)
+
+// maxChunkHashesPerResponse is the maximum number of chunk hashes, that are
+// returned by a single ChunkHashesResponse.
+const maxChunkHashesPerResponse = 4096
+
+var (
+	_ Request = (*ChunkHashesRequest)(nil)
+	_ Request = (*ChunkRequest)(nil)
+)
+
+// Request is sent via AppRequest to request the parts of the state summary.
+type Request interface {
+	// Height of the requested summary
+	SummaryHeight() uint64
+}
+
+// ChunkHashesRequest requests chunk hashes of the summary at [Height],
+// starting with the chunk with index [Start].
+type ChunkHashesRequest struct {
+	Height uint64 `serialize:"true"`
+	Start  uint32 `serialize:"true"`
+}
+
+func (r *ChunkHashesRequest) SummaryHeight() uint64 {
+	return r.Height
+}
+
+// ChunkHashesResponse is the response to the ChunkHashesRequest. [Hashes] are
+// empty, if the summary isn't known.
+type ChunkHashesResponse struct {
+	Hashes []ids.ID `serialize:"true"`
+}
+
+// ChunkRequest requests the chunk with index [Index] of the summary at
+// [Height].
+type ChunkRequest struct {
+	Height uint64 `serialize:"true"`
+	Index  uint32 `serialize:"true"`
+}
+
+func (r *ChunkRequest) SummaryHeight() uint64 {
+	return r.Height
+}
+
+// ChunkResponse is the response to the ChunkRequest. [Chunk] is empty, if the
+// chunk isn't known.
+type ChunkResponse struct {
+	Chunk []byte `serialize:"true"`
+}
+
+// Record is the raw key-value pair of the chain state database.
+type Record struct {
+	Key   []byte `serialize:"true"`
+	Value []byte `serialize:"true"`
+}
+
+// Chunk is the part of the chain state, that is transferred at once.
+type Chunk struct {
+	Records []Record `serialize:"true"`
+}
+
+// ChunkHash returns the hash of the marshalled chunk.
+func ChunkHash(chunkBytes []byte) ids.ID {
+	return hashing.ComputeHash256Array(chunkBytes)
+}
+
+func ParseRequest(bytes []byte) (Request, error) {
+	var req Request
+	if _, err := c.Unmarshal(bytes, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func BuildRequest(req Request) ([]byte, error) {
+	return c.Marshal(codecVersion, &req)
+}
@@ -0,0 +1,374 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package statesync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks/executor"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+)
+
+// numKeptSummaries is the number of the most recent summaries, that are
+// served. The previous summary is kept, so nodes, that are syncing it, could
+// complete the sync after the new summary was built.
+const numKeptSummaries = 2
+
+var _ executor.AcceptedListener = (*Server)(nil)
+
+// AppResponseSender sends responses to AppRequests. It is implemented by
+// common.AppSender.
+type AppResponseSender interface {
+	SendAppResponse(ctx context.Context, nodeID ids.NodeID, requestID uint32, appResponseBytes []byte) error
+}
+
+// Server builds state summaries of the accepted blocks and serves them to
+// the syncing nodes.
+//
+// Summaries are built every [frequency] blocks in the background. The raw
+// chain state is copied from the database snapshot to the staging area first,
+// so the chain could progress while the summary is built. That temporarily
+// doubles the size of the chain state on disk.
+type Server struct {
+	log              logging.Logger
+	vmDB             database.Database
+	excludedPrefixes [][]byte
+	frequency        uint64
+	appSender        AppResponseSender
+
+	summaryDB   database.Database
+	chunkDB     database.Database
+	chunkHashDB database.Database
+	stagingDB   database.Database
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	lock     sync.Mutex
+	building bool
+}
+
+// NewServer returns a new server, that stores summaries in [db] and builds
+// them from the chain state stored in [vmDB]. Raw records of [vmDB] with
+// [excludedPrefixes] don't belong to the chain state. [db] must be one of them.
+// If [frequency] is 0, no summaries are built.
+func NewServer(
+	log logging.Logger,
+	vmDB database.Database,
+	db database.Database,
+	frequency uint64,
+	appSender AppResponseSender,
+	excludedPrefixes ...[]byte,
+) (*Server, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Server{
+		log:              log,
+		vmDB:             vmDB,
+		excludedPrefixes: excludedPrefixes,
+		frequency:        frequency,
+		appSender:        appSender,
+		summaryDB:        prefixdb.NewNested(summaryPrefix, db),
+		chunkDB:          prefixdb.NewNested(chunkPrefix, db),
+		chunkHashDB:      prefixdb.NewNested(chunkHashPrefix, db),
+		stagingDB:        prefixdb.NewNested(stagingPrefix, db),
+		ctx:              ctx,
+		cancel:           cancel,
+	}
+
+	// Drop leftovers of the summary, that was being built on shutdown
+	if err := clearDB(s.stagingDB); err != nil {
+		cancel()
+		return nil, err
+	}
+	if err := s.prune(); err != nil {
+		cancel()
+		return nil, err
+	}
+	return s, nil
+}
+
+// Accepted starts building the summary of [blk], if its height is a multiple
+// of the summary frequency. Must be called after the changes of [blk] were
+// committed to the chain state and before any other changes are committed.
+func (s *Server) Accepted(blk blocks.Block) {
+	height := blk.Height()
+	if s.frequency == 0 || height == 0 || height%s.frequency != 0 {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	blkID := blk.ID()
+	if s.building {
+		s.log.Info("skipping state summary, as the previous one is still being built",
+			zap.Stringer("blkID", blkID),
+			zap.Uint64("height", height),
+		)
+		return
+	}
+	if s.ctx.Err() != nil {
+		return
+	}
+	s.building = true
+
+	// Iterator is the snapshot of the chain state after [blk] was accepted
+	it := s.vmDB.NewIterator()
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		start := time.Now()
+		err := s.build(it, blkID, height)
+
+		s.lock.Lock()
+		s.building = false
+		s.lock.Unlock()
+
+		switch {
+		case errors.Is(err, context.Canceled):
+		case err != nil:
+			s.log.Warn("failed to build state summary",
+				zap.Stringer("blkID", blkID),
+				zap.Uint64("height", height),
+				zap.Error(err),
+			)
+		default:
+			s.log.Info("built state summary",
+				zap.Stringer("blkID", blkID),
+				zap.Uint64("height", height),
+				zap.Duration("duration", time.Since(start)),
+			)
+		}
+	}()
+}
+
+func (s *Server) build(it database.Iterator, blkID ids.ID, height uint64) error {
+	copyErr := state.CopySyncableState(
+		s.stagingDB,
+		&ctxIterator{Iterator: it, ctx: s.ctx},
+		blkID,
+		s.excludedPrefixes...,
+	)
+	it.Release()
+	if copyErr != nil {
+		return copyErr
+	}
+
+	w := newChunkWriter(func(index uint32, chunkBytes []byte) error {
+		key := chunkKey(height, index)
+		if err := s.chunkDB.Put(key, chunkBytes); err != nil {
+			return err
+		}
+		hash := ChunkHash(chunkBytes)
+		return s.chunkHashDB.Put(key, hash[:])
+	})
+	if err := state.WriteSyncableState(s.stagingDB, blkID, height, func(key, value []byte) error {
+		if err := s.ctx.Err(); err != nil {
+			return err
+		}
+		return w.add(key, value)
+	}); err != nil {
+		return err
+	}
+	if err := w.flush(); err != nil {
+		return err
+	}
+
+	summary, err := NewSummary(height, blkID, RootHash(w.hashes), uint32(len(w.hashes)))
+	if err != nil {
+		return err
+	}
+	if err := s.summaryDB.Put(database.PackUInt64(height), summary.Bytes()); err != nil {
+		return err
+	}
+	if err := s.prune(); err != nil {
+		return err
+	}
+	return clearDB(s.stagingDB)
+}
+
+// prune deletes all summaries except the most recent ones, and chunks, that
+// don't belong to the kept summaries.
+func (s *Server) prune() error {
+	heights, err := s.summaryHeights()
+	if err != nil {
+		return err
+	}
+	for len(heights) > numKeptSummaries {
+		if err := s.summaryDB.Delete(database.PackUInt64(heights[0])); err != nil {
+			return err
+		}
+		heights = heights[1:]
+	}
+
+	kept := make(map[uint64]struct{}, len(heights))
+	for _, height := range heights {
+		kept[height] = struct{}{}
+	}
+	for _, db := range []database.Database{s.chunkDB, s.chunkHashDB} {
+		if err := pruneChunks(db, kept); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func pruneChunks(db database.Database, kept map[uint64]struct{}) error {
+	it := db.NewIterator()
+	defer it.Release()
+
+	batch := db.NewBatch()
+	for it.Next() {
+		key := it.Key()
+		height, err := database.ParseUInt64(key[:8])
+		if err != nil {
+			return err
+		}
+		if _, ok := kept[height]; ok {
+			continue
+		}
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+		if batch.Size() < batchSize {
+			continue
+		}
+		if err := batch.Write(); err != nil {
+			return err
+		}
+		batch.Reset()
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// summaryHeights returns heights of the stored summaries in ascending order.
+func (s *Server) summaryHeights() ([]uint64, error) {
+	it := s.summaryDB.NewIterator()
+	defer it.Release()
+
+	var heights []uint64
+	for it.Next() {
+		height, err := database.ParseUInt64(it.Key())
+		if err != nil {
+			return nil, err
+		}
+		heights = append(heights, height)
+	}
+	return heights, it.Error()
+}
+
+// GetLastSummary returns the most recent summary. Returns
+// database.ErrNotFound, if there are no summaries.
+func (s *Server) GetLastSummary() (*Summary, error) {
+	heights, err := s.summaryHeights()
+	if err != nil {
+		return nil, err
+	}
+	if len(heights) == 0 {
+		return nil, database.ErrNotFound
+	}
+	return s.GetSummary(heights[len(heights)-1])
+}
+
+// GetSummary returns the summary at [height]. Returns database.ErrNotFound, if
+// there is no such summary.
+func (s *Server) GetSummary(height uint64) (*Summary, error) {
+	summaryBytes, err := s.summaryDB.Get(database.PackUInt64(height))
+	if err != nil {
+		return nil, err
+	}
+	return ParseSummary(summaryBytes)
+}
+
+// AppRequest handles the state sync request from [nodeID]. Malformed requests
+// are dropped, requests for unknown summaries are answered with empty
+// responses.
+func (s *Server) AppRequest(ctx context.Context, nodeID ids.NodeID, requestID uint32, _ time.Time, request []byte) error {
+	req, err := ParseRequest(request)
+	if err != nil {
+		s.log.Debug("dropping malformed state sync request",
+			zap.Stringer("nodeID", nodeID),
+			zap.Uint32("requestID", requestID),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	var resp interface{}
+	switch req := req.(type) {
+	case *ChunkHashesRequest:
+		resp, err = s.getChunkHashes(req)
+	case *ChunkRequest:
+		resp, err = s.getChunk(req)
+	default:
+		return fmt.Errorf("unexpected state sync request type %T", req)
+	}
+	if err != nil {
+		return err
+	}
+
+	respBytes, err := c.Marshal(codecVersion, resp)
+	if err != nil {
+		return err
+	}
+	return s.appSender.SendAppResponse(ctx, nodeID, requestID, respBytes)
+}
+
+func (s *Server) getChunkHashes(req *ChunkHashesRequest) (*ChunkHashesResponse, error) {
+	resp := &ChunkHashesResponse{}
+	if has, err := s.summaryDB.Has(database.PackUInt64(req.Height)); err != nil || !has {
+		return resp, err
+	}
+
+	heightPrefix := database.PackUInt64(req.Height)
+	it := s.chunkHashDB.NewIteratorWithStartAndPrefix(chunkKey(req.Height, req.Start), heightPrefix)
+	defer it.Release()
+	for len(resp.Hashes) < maxChunkHashesPerResponse && it.Next() {
+		hash, err := ids.ToID(it.Value())
+		if err != nil {
+			return nil, err
+		}
+		resp.Hashes = append(resp.Hashes, hash)
+	}
+	return resp, it.Error()
+}
+
+func (s *Server) getChunk(req *ChunkRequest) (*ChunkResponse, error) {
+	resp := &ChunkResponse{}
+	if has, err := s.summaryDB.Has(database.PackUInt64(req.Height)); err != nil || !has {
+		return resp, err
+	}
+
+	chunkBytes, err := s.chunkDB.Get(chunkKey(req.Height, req.Index))
+	switch {
+	case err == database.ErrNotFound:
+		return resp, nil
+	case err != nil:
+		return nil, err
+	}
+	resp.Chunk = chunkBytes
+	return resp, nil
+}
+
+// Shutdown stops building of the summary and waits until it's stopped.
+func (s *Server) Shutdown() {
+	s.cancel()
+	s.wg.Wait()
+}
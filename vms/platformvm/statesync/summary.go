@@ -0,0 +1,90 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package statesync
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+)
+
+var errInvalidChunkHashes = errors.New("invalid chunk hashes")
+
+// Summary describes the chain state after the block [BlockID] at
+// [BlockHeight] was accepted. The state is split into [NumChunks] chunks and
+// [Root] is the hash of the concatenated chunk hashes.
+type Summary struct {
+	BlockHeight uint64 `serialize:"true"`
+	BlockID     ids.ID `serialize:"true"`
+	Root        ids.ID `serialize:"true"`
+	NumChunks   uint32 `serialize:"true"`
+
+	id    ids.ID
+	bytes []byte
+}
+
+// NewSummary creates a new *Summary and initializes it.
+func NewSummary(blkHeight uint64, blkID, root ids.ID, numChunks uint32) (*Summary, error) {
+	s := &Summary{
+		BlockHeight: blkHeight,
+		BlockID:     blkID,
+		Root:        root,
+		NumChunks:   numChunks,
+	}
+	bytes, err := c.Marshal(codecVersion, s)
+	if err != nil {
+		return nil, err
+	}
+	s.initialize(bytes)
+	return s, nil
+}
+
+// ParseSummary converts a slice of bytes into an initialized *Summary.
+func ParseSummary(bytes []byte) (*Summary, error) {
+	s := &Summary{}
+	if _, err := c.Unmarshal(bytes, s); err != nil {
+		return nil, err
+	}
+	s.initialize(bytes)
+	return s, nil
+}
+
+func (s *Summary) initialize(bytes []byte) {
+	s.bytes = bytes
+	s.id = hashing.ComputeHash256Array(bytes)
+}
+
+func (s *Summary) ID() ids.ID {
+	return s.id
+}
+
+func (s *Summary) Height() uint64 {
+	return s.BlockHeight
+}
+
+func (s *Summary) Bytes() []byte {
+	return s.bytes
+}
+
+// Verify returns an error, if [hashes] don't belong to the summary.
+func (s *Summary) Verify(hashes []ids.ID) error {
+	if len(hashes) != int(s.NumChunks) {
+		return fmt.Errorf("%w: expected %d chunks but got %d", errInvalidChunkHashes, s.NumChunks, len(hashes))
+	}
+	if root := RootHash(hashes); root != s.Root {
+		return fmt.Errorf("%w: expected root %s but got %s", errInvalidChunkHashes, s.Root, root)
+	}
+	return nil
+}
+
+// RootHash returns the hash of concatenated chunk [hashes].
+func RootHash(hashes []ids.ID) ids.ID {
+	bytes := make([]byte, 0, len(hashes)*len(ids.Empty))
+	for _, hash := range hashes {
+		bytes = append(bytes, hash[:]...)
+	}
+	return hashing.ComputeHash256Array(bytes)
+}
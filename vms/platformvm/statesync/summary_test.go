@@ -0,0 +1,56 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package statesync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+func TestParseSummary(t *testing.T) {
+	require := require.New(t)
+
+	hashes := []ids.ID{ids.GenerateTestID(), ids.GenerateTestID()}
+	summary, err := NewSummary(1024, ids.GenerateTestID(), RootHash(hashes), uint32(len(hashes)))
+	require.NoError(err)
+
+	parsedSummary, err := ParseSummary(summary.Bytes())
+	require.NoError(err)
+	require.Equal(summary.ID(), parsedSummary.ID())
+	require.Equal(summary.Height(), parsedSummary.Height())
+	require.Equal(summary.BlockID, parsedSummary.BlockID)
+	require.Equal(summary.Root, parsedSummary.Root)
+	require.Equal(summary.NumChunks, parsedSummary.NumChunks)
+}
+
+func TestSummaryVerify(t *testing.T) {
+	hashes := []ids.ID{ids.GenerateTestID(), ids.GenerateTestID()}
+	summary, err := NewSummary(1024, ids.GenerateTestID(), RootHash(hashes), uint32(len(hashes)))
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		hashes      []ids.ID
+		expectedErr error
+	}{
+		"valid": {
+			hashes: hashes,
+		},
+		"missing hash": {
+			hashes:      hashes[:1],
+			expectedErr: errInvalidChunkHashes,
+		},
+		"wrong order": {
+			hashes:      []ids.ID{hashes[1], hashes[0]},
+			expectedErr: errInvalidChunkHashes,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, summary.Verify(tt.hashes), tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,331 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package statesync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+)
+
+// maxRequestAttempts is the number of peers, that are asked for the same part
+// of the summary, before the sync fails.
+const maxRequestAttempts = 16
+
+var (
+	errNoPeers            = errors.New("no peers to sync from")
+	errRequestFailed      = errors.New("state sync request failed")
+	errEmptyResponse      = errors.New("peer doesn't have requested summary")
+	errTooManyChunkHashes = errors.New("too many chunk hashes")
+	errWrongChunkHash     = errors.New("chunk doesn't match its hash")
+)
+
+// AppRequestSender sends AppRequests. It is implemented by common.AppSender.
+type AppRequestSender interface {
+	SendAppRequest(ctx context.Context, nodeIDs set.Set[ids.NodeID], requestID uint32, appRequestBytes []byte) error
+}
+
+// Syncer downloads summaries from other nodes and replaces the chain state with
+// them. The VM must forward AppResponse and AppRequestFailed messages, that
+// correspond to the requests sent by the syncer, and peer connection changes
+// to it.
+type Syncer struct {
+	log        logging.Logger
+	nodeID     ids.NodeID
+	db         database.Database
+	downloadDB database.Database
+	appSender  AppRequestSender
+
+	lock          sync.Mutex
+	peers         set.Set[ids.NodeID]
+	nextRequestID uint32
+	pending       map[uint32]chan []byte
+}
+
+// NewSyncer returns a new syncer of this node [nodeID], that downloads
+// summaries to [db] and sends requests with ids, that start from
+// [firstRequestID].
+func NewSyncer(
+	log logging.Logger,
+	nodeID ids.NodeID,
+	db database.Database,
+	appSender AppRequestSender,
+	firstRequestID uint32,
+) *Syncer {
+	return &Syncer{
+		log:           log,
+		nodeID:        nodeID,
+		db:            db,
+		downloadDB:    prefixdb.NewNested(downloadPrefix, db),
+		appSender:     appSender,
+		peers:         set.Set[ids.NodeID]{},
+		nextRequestID: firstRequestID,
+		pending:       make(map[uint32]chan []byte),
+	}
+}
+
+func (s *Syncer) Connected(nodeID ids.NodeID) {
+	if nodeID == s.nodeID {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.peers.Add(nodeID)
+}
+
+func (s *Syncer) Disconnected(nodeID ids.NodeID) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.peers.Remove(nodeID)
+}
+
+// Sync downloads and verifies the chain state of [summary]. Apply must be
+// called afterwards to replace the chain state with it.
+func (s *Syncer) Sync(ctx context.Context, summary *Summary) error {
+	if err := clearDB(s.downloadDB); err != nil {
+		return err
+	}
+
+	hashes, err := s.getChunkHashes(ctx, summary)
+	if err != nil {
+		return err
+	}
+	if err := summary.Verify(hashes); err != nil {
+		return err
+	}
+
+	for i, hash := range hashes {
+		chunk, err := s.getChunk(ctx, summary.BlockHeight, uint32(i), hash)
+		if err != nil {
+			return err
+		}
+		batch := s.downloadDB.NewBatch()
+		for _, record := range chunk.Records {
+			if err := batch.Put(record.Key, record.Value); err != nil {
+				return err
+			}
+		}
+		if err := batch.Write(); err != nil {
+			return err
+		}
+		s.log.Debug("downloaded state chunk",
+			zap.Int("index", i),
+			zap.Int("numChunks", len(hashes)),
+		)
+	}
+	return nil
+}
+
+func (s *Syncer) getChunkHashes(ctx context.Context, summary *Summary) ([]ids.ID, error) {
+	hashes := make([]ids.ID, 0, summary.NumChunks)
+	for len(hashes) < int(summary.NumChunks) {
+		req := &ChunkHashesRequest{
+			Height: summary.BlockHeight,
+			Start:  uint32(len(hashes)),
+		}
+		err := s.request(ctx, req, func(respBytes []byte) error {
+			resp := ChunkHashesResponse{}
+			if _, err := c.Unmarshal(respBytes, &resp); err != nil {
+				return err
+			}
+			if len(resp.Hashes) == 0 {
+				return errEmptyResponse
+			}
+			if len(hashes)+len(resp.Hashes) > int(summary.NumChunks) {
+				return errTooManyChunkHashes
+			}
+			hashes = append(hashes, resp.Hashes...)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get chunk hashes: %w", err)
+		}
+	}
+	return hashes, nil
+}
+
+func (s *Syncer) getChunk(ctx context.Context, height uint64, index uint32, hash ids.ID) (*Chunk, error) {
+	chunk := &Chunk{}
+	req := &ChunkRequest{
+		Height: height,
+		Index:  index,
+	}
+	err := s.request(ctx, req, func(respBytes []byte) error {
+		resp := ChunkResponse{}
+		if _, err := c.Unmarshal(respBytes, &resp); err != nil {
+			return err
+		}
+		if len(resp.Chunk) == 0 {
+			return errEmptyResponse
+		}
+		if ChunkHash(resp.Chunk) != hash {
+			return errWrongChunkHash
+		}
+		_, err := c.Unmarshal(resp.Chunk, chunk)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chunk %d: %w", index, err)
+	}
+	return chunk, nil
+}
+
+// request sends [req] to random peers until [handle] accepts the response.
+func (s *Syncer) request(ctx context.Context, req Request, handle func(respBytes []byte) error) error {
+	reqBytes, err := BuildRequest(req)
+	if err != nil {
+		return err
+	}
+
+	for attempt := 0; attempt < maxRequestAttempts; attempt++ {
+		nodeID, ok := s.samplePeer()
+		if !ok {
+			return errNoPeers
+		}
+
+		var respBytes []byte
+		respBytes, err = s.send(ctx, nodeID, reqBytes)
+		if err == nil {
+			err = handle(respBytes)
+		}
+		if err == nil {
+			return nil
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		s.log.Debug("state sync request failed",
+			zap.Stringer("nodeID", nodeID),
+			zap.Error(err),
+		)
+	}
+	return err
+}
+
+func (s *Syncer) samplePeer() (ids.NodeID, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	peers := s.peers.List()
+	if len(peers) == 0 {
+		return ids.EmptyNodeID, false
+	}
+	return peers[rand.Intn(len(peers))], true // #nosec G404
+}
+
+func (s *Syncer) send(ctx context.Context, nodeID ids.NodeID, reqBytes []byte) ([]byte, error) {
+	respChan := make(chan []byte, 1)
+	s.lock.Lock()
+	requestID := s.nextRequestID
+	s.nextRequestID++
+	s.pending[requestID] = respChan
+	s.lock.Unlock()
+	defer s.removePending(requestID)
+
+	if err := s.appSender.SendAppRequest(ctx, set.Set[ids.NodeID]{nodeID: struct{}{}}, requestID, reqBytes); err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case respBytes := <-respChan:
+		if respBytes == nil {
+			return nil, errRequestFailed
+		}
+		return respBytes, nil
+	}
+}
+
+// AppResponse delivers the response to the pending request. Returns false, if
+// [requestID] doesn't belong to the pending request of this syncer.
+func (s *Syncer) AppResponse(requestID uint32, response []byte) bool {
+	if response == nil {
+		response = []byte{}
+	}
+	return s.deliver(requestID, response)
+}
+
+// AppRequestFailed fails the pending request. Returns false, if [requestID]
+// doesn't belong to the pending request of this syncer.
+func (s *Syncer) AppRequestFailed(requestID uint32) bool {
+	return s.deliver(requestID, nil)
+}
+
+func (s *Syncer) deliver(requestID uint32, response []byte) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	respChan, ok := s.pending[requestID]
+	if !ok {
+		return false
+	}
+	delete(s.pending, requestID)
+	respChan <- response
+	return true
+}
+
+func (s *Syncer) removePending(requestID uint32) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.pending, requestID)
+}
+
+// Apply replaces the chain state stored in [vmDB] with the downloaded one.
+// Raw records of [vmDB] with [excludedPrefixes] don't belong to the chain
+// state, the database of the syncer must be one of them. The chain state must
+// be reloaded afterwards.
+//
+// If the node stops while the chain state is being replaced, Recover must be
+// called on startup to complete it.
+func (s *Syncer) Apply(vmDB database.Database, excludedPrefixes ...[]byte) error {
+	if err := s.db.Put(applyingKey, nil); err != nil {
+		return err
+	}
+	return s.apply(vmDB, excludedPrefixes)
+}
+
+// Recover completes replacing of the chain state, if it was interrupted.
+// Must be called before the chain state is loaded.
+func (s *Syncer) Recover(vmDB database.Database, excludedPrefixes ...[]byte) error {
+	applying, err := s.db.Has(applyingKey)
+	if err != nil || !applying {
+		return err
+	}
+	s.log.Info("completing interrupted state sync")
+	return s.apply(vmDB, excludedPrefixes)
+}
+
+func (s *Syncer) apply(vmDB database.Database, excludedPrefixes [][]byte) error {
+	if err := state.ClearSyncableState(vmDB, excludedPrefixes...); err != nil {
+		return err
+	}
+	if err := copyDB(vmDB, s.downloadDB); err != nil {
+		return err
+	}
+	if err := s.db.Delete(applyingKey); err != nil {
+		return err
+	}
+	return clearDB(s.downloadDB)
+}
+
+// Abort drops the downloaded chain state.
+func (s *Syncer) Abort() error {
+	return clearDB(s.downloadDB)
+}
@@ -0,0 +1,236 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package statesync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+var syncPrefix = []byte("stateSync")
+
+// testNetwork delivers requests of the syncer to the servers synchronously.
+// Chunks served by [tampered] nodes are modified.
+type testNetwork struct {
+	syncer   *Syncer
+	servers  map[ids.NodeID]*Server
+	tampered set.Set[ids.NodeID]
+}
+
+func (n *testNetwork) SendAppRequest(ctx context.Context, nodeIDs set.Set[ids.NodeID], requestID uint32, request []byte) error {
+	for nodeID := range nodeIDs {
+		server, ok := n.servers[nodeID]
+		if !ok {
+			n.syncer.AppRequestFailed(requestID)
+			continue
+		}
+		sender := &testResponseSender{network: n, tamper: n.tampered.Contains(nodeID)}
+		server.appSender = sender
+		if err := server.AppRequest(ctx, nodeID, requestID, time.Time{}, request); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type testResponseSender struct {
+	network *testNetwork
+	tamper  bool
+}
+
+func (s *testResponseSender) SendAppResponse(_ context.Context, _ ids.NodeID, requestID uint32, response []byte) error {
+	if s.tamper {
+		resp := ChunkResponse{}
+		if _, err := c.Unmarshal(response, &resp); err == nil && len(resp.Chunk) > 0 {
+			resp.Chunk[len(resp.Chunk)-1]++
+			var err error
+			response, err = c.Marshal(codecVersion, &resp)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	s.network.syncer.AppResponse(requestID, response)
+	return nil
+}
+
+// newTestServer returns a server with the summary of [records] at [height].
+func newTestServer(t *testing.T, height uint64, records []Record) (*Server, *Summary) {
+	require := require.New(t)
+
+	vmDB := memdb.New()
+	server, err := NewServer(logging.NoLog{}, vmDB, prefixdb.NewNested(syncPrefix, vmDB), 0, nil)
+	require.NoError(err)
+
+	w := newChunkWriter(func(index uint32, chunkBytes []byte) error {
+		key := chunkKey(height, index)
+		hash := ChunkHash(chunkBytes)
+		require.NoError(server.chunkDB.Put(key, chunkBytes))
+		return server.chunkHashDB.Put(key, hash[:])
+	})
+	for _, record := range records {
+		require.NoError(w.add(record.Key, record.Value))
+	}
+	require.NoError(w.flush())
+
+	summary, err := NewSummary(height, ids.GenerateTestID(), RootHash(w.hashes), uint32(len(w.hashes)))
+	require.NoError(err)
+	require.NoError(server.summaryDB.Put(database.PackUInt64(height), summary.Bytes()))
+	return server, summary
+}
+
+func testRecords(num int) []Record {
+	records := make([]Record, num)
+	for i := range records {
+		records[i] = Record{
+			Key:   []byte(fmt.Sprintf("key%08d", i)),
+			Value: bytes.Repeat([]byte{byte(i)}, 1024),
+		}
+	}
+	return records
+}
+
+func TestSync(t *testing.T) {
+	// Records span several chunks
+	records := testRecords(1024)
+	server, summary := newTestServer(t, 1024, records)
+	require.Greater(t, summary.NumChunks, uint32(1))
+
+	nodeID := ids.GenerateTestNodeID()
+	peerID := ids.GenerateTestNodeID()
+	tamperedPeerID := ids.GenerateTestNodeID()
+	unknownPeerID := ids.GenerateTestNodeID()
+	emptyServer, err := NewServer(logging.NoLog{}, memdb.New(), memdb.New(), 0, nil)
+	require.NoError(t, err)
+	emptyPeerID := ids.GenerateTestNodeID()
+
+	tests := map[string]struct {
+		peers       []ids.NodeID
+		expectedErr error
+	}{
+		"valid peer": {
+			peers: []ids.NodeID{peerID, tamperedPeerID},
+		},
+		"tampered peer": {
+			peers:       []ids.NodeID{tamperedPeerID},
+			expectedErr: errWrongChunkHash,
+		},
+		"peer without summary": {
+			peers:       []ids.NodeID{emptyPeerID},
+			expectedErr: errEmptyResponse,
+		},
+		"disconnected peer": {
+			peers:       []ids.NodeID{unknownPeerID},
+			expectedErr: errRequestFailed,
+		},
+		"no peers": {
+			peers:       []ids.NodeID{nodeID},
+			expectedErr: errNoPeers,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			vmDB := memdb.New()
+			syncDB := prefixdb.NewNested(syncPrefix, vmDB)
+			network := &testNetwork{
+				servers: map[ids.NodeID]*Server{
+					peerID:         server,
+					tamperedPeerID: server,
+					emptyPeerID:    emptyServer,
+				},
+				tampered: set.Set[ids.NodeID]{tamperedPeerID: struct{}{}},
+			}
+			syncer := NewSyncer(logging.NoLog{}, nodeID, syncDB, network, 0)
+			network.syncer = syncer
+			for _, peer := range tt.peers {
+				syncer.Connected(peer)
+			}
+
+			err := syncer.Sync(context.Background(), summary)
+			require.ErrorIs(err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+
+			// Chain state records are replaced, blocks and records of the
+			// state sync database are kept
+			blockKey := append(hashing.ComputeHash256([]byte("block")), 1)
+			require.NoError(vmDB.Put(blockKey, []byte("block")))
+			require.NoError(vmDB.Put([]byte("stale"), []byte("stale")))
+			excludedPrefix := hashing.ComputeHash256(syncPrefix)
+			require.NoError(syncer.Apply(vmDB, excludedPrefix))
+
+			expected := map[string][]byte{string(blockKey): []byte("block")}
+			for _, record := range records {
+				expected[string(record.Key)] = record.Value
+			}
+			it := vmDB.NewIterator()
+			defer it.Release()
+			actual := map[string][]byte{}
+			for it.Next() {
+				if bytes.HasPrefix(it.Key(), excludedPrefix) {
+					continue
+				}
+				actual[string(it.Key())] = it.Value()
+			}
+			require.NoError(it.Error())
+			require.Equal(expected, actual)
+
+			// Download is dropped after it was applied
+			applying, err := syncDB.Has(applyingKey)
+			require.NoError(err)
+			require.False(applying)
+			downloadIt := syncer.downloadDB.NewIterator()
+			defer downloadIt.Release()
+			require.False(downloadIt.Next())
+		})
+	}
+}
+
+func TestServerPrune(t *testing.T) {
+	require := require.New(t)
+
+	records := testRecords(16)
+	server, _ := newTestServer(t, 1, records)
+	heights := []uint64{1, 2, 3}
+	for _, height := range heights[1:] {
+		summary, err := NewSummary(height, ids.GenerateTestID(), ids.Empty, 0)
+		require.NoError(err)
+		require.NoError(server.summaryDB.Put(database.PackUInt64(height), summary.Bytes()))
+	}
+	// Chunks of the summary, that wasn't completed
+	require.NoError(server.chunkDB.Put(chunkKey(4, 0), []byte{1}))
+
+	require.NoError(server.prune())
+
+	summaryHeights, err := server.summaryHeights()
+	require.NoError(err)
+	require.Equal(heights[1:], summaryHeights)
+	for _, db := range []database.Database{server.chunkDB, server.chunkHashDB} {
+		it := db.NewIterator()
+		require.False(it.Next())
+		it.Release()
+	}
+
+	summary, err := server.GetLastSummary()
+	require.NoError(err)
+	require.Equal(uint64(3), summary.Height())
+	_, err = server.GetSummary(1)
+	require.ErrorIs(err, database.ErrNotFound)
+}
@@ -0,0 +1,141 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package teleporter
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+// Aggregator collects signatures over unsigned messages from the validators
+// of the source chain and aggregates them into BitSetSignature.
+type Aggregator struct {
+	log         logging.Logger
+	pChainState validators.State
+	client      SignatureGetter
+}
+
+func NewAggregator(log logging.Logger, pChainState validators.State, client SignatureGetter) *Aggregator {
+	return &Aggregator{
+		log:         log,
+		pChainState: pChainState,
+		client:      client,
+	}
+}
+
+type signatureResult struct {
+	index     int
+	signature *bls.Signature
+}
+
+// AggregateSignatures requests signatures over [msg] from the validators of
+// the subnet of [msg.SourceChainID] at [pChainHeight], until at least
+// [quorumNum]/[quorumDen] of the total validators weight signed it. Each
+// signature is verified against the public key of its validator, before its
+// weight is counted.
+//
+// Invariant: [msg] is correctly initialized.
+func (a *Aggregator) AggregateSignatures(
+	ctx context.Context,
+	msg *UnsignedMessage,
+	pChainHeight uint64,
+	quorumNum uint64,
+	quorumDen uint64,
+) (*Message, error) {
+	subnetID, err := a.pChainState.GetSubnetID(ctx, msg.SourceChainID)
+	if err != nil {
+		return nil, err
+	}
+	vdrs, totalWeight, err := GetCanonicalValidatorSet(ctx, a.pChainState, pChainHeight, subnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan *signatureResult, len(vdrs))
+	for i, vdr := range vdrs {
+		go func(index int, vdr *Validator) {
+			results <- &signatureResult{
+				index:     index,
+				signature: a.getSignature(ctx, vdr, msg),
+			}
+		}(i, vdr)
+	}
+
+	var (
+		signers    = set.NewBits()
+		signatures = make([]*bls.Signature, 0, len(vdrs))
+		sigWeight  uint64
+		weightErr  = VerifyWeight(sigWeight, totalWeight, quorumNum, quorumDen)
+	)
+	for range vdrs {
+		if weightErr == nil {
+			break
+		}
+		result := <-results
+		if result.signature == nil {
+			continue
+		}
+		signers.Add(result.index)
+		signatures = append(signatures, result.signature)
+		sigWeight += vdrs[result.index].Weight // Impossible to overflow here
+		weightErr = VerifyWeight(sigWeight, totalWeight, quorumNum, quorumDen)
+	}
+	if weightErr != nil {
+		return nil, weightErr
+	}
+	if len(signatures) == 0 {
+		return nil, fmt.Errorf("%w: no signatures", ErrInsufficientWeight)
+	}
+
+	aggSig, err := bls.AggregateSignatures(signatures)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't aggregate signatures: %w", err)
+	}
+	signature := &BitSetSignature{Signers: signers.Bytes()}
+	copy(signature.Signature[:], bls.SignatureToBytes(aggSig))
+	return NewMessage(msg, signature)
+}
+
+// getSignature returns the signature of [vdr] over [msg], requesting it from
+// the nodes of [vdr] one by one. Returns nil, if no node provided a valid
+// signature.
+func (a *Aggregator) getSignature(ctx context.Context, vdr *Validator, msg *UnsignedMessage) *bls.Signature {
+	msgBytes := msg.Bytes()
+	for _, nodeID := range vdr.NodeIDs {
+		sigBytes, err := a.client.GetSignature(ctx, nodeID, msg)
+		if err != nil {
+			a.log.Debug("failed to get signature",
+				zap.Stringer("nodeID", nodeID),
+				zap.Error(err),
+			)
+			continue
+		}
+		sig, err := bls.SignatureFromBytes(sigBytes)
+		if err != nil {
+			a.log.Debug("failed to parse signature",
+				zap.Stringer("nodeID", nodeID),
+				zap.Error(err),
+			)
+			continue
+		}
+		if !bls.Verify(vdr.PublicKey, sig, msgBytes) {
+			a.log.Debug("dropping invalid signature",
+				zap.Stringer("nodeID", nodeID),
+			)
+			continue
+		}
+		return sig
+	}
+	return nil
+}
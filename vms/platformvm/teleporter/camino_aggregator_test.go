@@ -0,0 +1,137 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package teleporter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+type testSignatureGetter struct {
+	signatures map[ids.NodeID][]byte
+}
+
+func (g *testSignatureGetter) GetSignature(_ context.Context, nodeID ids.NodeID, _ *UnsignedMessage) ([]byte, error) {
+	sig, ok := g.signatures[nodeID]
+	if !ok {
+		return nil, errMock
+	}
+	return sig, nil
+}
+
+func TestAggregateSignatures(t *testing.T) {
+	unsignedMsg, err := NewUnsignedMessage(sourceChainID, ids.GenerateTestID(), []byte("payload"))
+	require.NoError(t, err)
+
+	sk, err := bls.NewSecretKey()
+	require.NoError(t, err)
+	sig := bls.Sign(sk, unsignedMsg.Bytes())
+	sigBytes := bls.SignatureToBytes(sig)
+	invalidSigBytes := bls.SignatureToBytes(bls.Sign(sk, []byte("other message")))
+
+	// Validators with the same public key are merged into the same canonical
+	// validator. Validators without public keys can't sign.
+	pk := bls.PublicFromSecretKey(sk)
+	nodeID0 := ids.GenerateTestNodeID()
+	nodeID1 := ids.GenerateTestNodeID()
+	nodeID2 := ids.GenerateTestNodeID()
+	vdrSet := map[ids.NodeID]*validators.GetValidatorOutput{
+		nodeID0: {NodeID: nodeID0, PublicKey: pk, Weight: 2},
+		nodeID1: {NodeID: nodeID1, PublicKey: pk, Weight: 2},
+		nodeID2: {NodeID: nodeID2, Weight: 1},
+	}
+
+	tests := map[string]struct {
+		signatures        map[ids.NodeID][]byte
+		quorumNum         uint64
+		quorumDen         uint64
+		expectedErr       error
+		expectedSignature *BitSetSignature
+	}{
+		"quorum reached": {
+			signatures: map[ids.NodeID][]byte{nodeID1: sigBytes},
+			quorumNum:  2,
+			quorumDen:  3,
+			expectedSignature: func() *BitSetSignature {
+				signature := &BitSetSignature{Signers: set.NewBits(0).Bytes()}
+				copy(signature.Signature[:], sigBytes)
+				return signature
+			}(),
+		},
+		"quorum not reached": {
+			signatures:  map[ids.NodeID][]byte{nodeID1: sigBytes},
+			quorumNum:   5,
+			quorumDen:   5,
+			expectedErr: ErrInsufficientWeight,
+		},
+		"no signatures": {
+			signatures:  map[ids.NodeID][]byte{},
+			quorumNum:   1,
+			quorumDen:   2,
+			expectedErr: ErrInsufficientWeight,
+		},
+		"invalid signature": {
+			signatures:  map[ids.NodeID][]byte{nodeID0: invalidSigBytes, nodeID1: invalidSigBytes},
+			quorumNum:   1,
+			quorumDen:   2,
+			expectedErr: ErrInsufficientWeight,
+		},
+		"invalid signature of one node": {
+			signatures: map[ids.NodeID][]byte{nodeID0: invalidSigBytes, nodeID1: sigBytes},
+			quorumNum:  2,
+			quorumDen:  3,
+			expectedSignature: func() *BitSetSignature {
+				signature := &BitSetSignature{Signers: set.NewBits(0).Bytes()}
+				copy(signature.Signature[:], sigBytes)
+				return signature
+			}(),
+		},
+		"malformed signature": {
+			signatures:  map[ids.NodeID][]byte{nodeID0: {1}, nodeID1: {1}},
+			quorumNum:   1,
+			quorumDen:   2,
+			expectedErr: ErrInsufficientWeight,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			state := validators.NewMockState(ctrl)
+			state.EXPECT().GetSubnetID(gomock.Any(), sourceChainID).Return(subnetID, nil)
+			state.EXPECT().GetValidatorSet(gomock.Any(), pChainHeight, subnetID).Return(vdrSet, nil)
+
+			aggregator := NewAggregator(
+				logging.NoLog{},
+				state,
+				&testSignatureGetter{signatures: test.signatures},
+			)
+			msg, err := aggregator.AggregateSignatures(
+				context.Background(),
+				unsignedMsg,
+				pChainHeight,
+				test.quorumNum,
+				test.quorumDen,
+			)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+			require.Equal(unsignedMsg.Bytes(), msg.UnsignedMessage.Bytes())
+			require.Equal(test.expectedSignature, msg.Signature)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package teleporter
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+// SignatureRequest is sent via AppRequest to request the signature of the
+// validator over [UnsignedMessage].
+type SignatureRequest struct {
+	UnsignedMessage []byte `serialize:"true"`
+}
+
+// SignatureResponse is the response to the SignatureRequest. [Signature] is
+// empty, if the validator refused to sign the message.
+type SignatureResponse struct {
+	Signature []byte `serialize:"true"`
+}
+
+// AppResponseSender sends responses to AppRequests. It is implemented by
+// common.AppSender, which can't be referenced here, as snow depends on this
+// package.
+type AppResponseSender interface {
+	SendAppResponse(ctx context.Context, nodeID ids.NodeID, requestID uint32, appResponseBytes []byte) error
+}
+
+// ParseSignatureRequest returns the unsigned message, which signature is
+// requested by [request].
+func ParseSignatureRequest(request []byte) (*UnsignedMessage, error) {
+	req := SignatureRequest{}
+	if _, err := c.Unmarshal(request, &req); err != nil {
+		return nil, err
+	}
+	return ParseUnsignedMessage(req.UnsignedMessage)
+}
+
+// MessageID returns the ID of the unsigned message.
+func MessageID(msg *UnsignedMessage) ids.ID {
+	return hashing.ComputeHash256Array(msg.Bytes())
+}
+
+// Handler serves signatures of this node over the messages, that were marked
+// as signable by the VM.
+type Handler struct {
+	log       logging.Logger
+	signer    Signer
+	appSender AppResponseSender
+
+	lock     sync.RWMutex
+	signable set.Set[ids.ID]
+}
+
+func NewHandler(log logging.Logger, signer Signer, appSender AppResponseSender) *Handler {
+	return &Handler{
+		log:       log,
+		signer:    signer,
+		appSender: appSender,
+		signable:  set.Set[ids.ID]{},
+	}
+}
+
+// MarkSignable allows this node to sign [msg], when it is requested by other
+// nodes.
+func (h *Handler) MarkSignable(msg *UnsignedMessage) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.signable.Add(MessageID(msg))
+}
+
+// UnmarkSignable forbids this node to sign [msg] again.
+func (h *Handler) UnmarkSignable(msg *UnsignedMessage) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.signable.Remove(MessageID(msg))
+}
+
+func (h *Handler) isSignable(msg *UnsignedMessage) bool {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	return h.signable.Contains(MessageID(msg))
+}
+
+// AppRequest handles the SignatureRequest from [nodeID]. Malformed requests
+// are dropped, requests for messages, that aren't signable, are answered with
+// an empty signature.
+func (h *Handler) AppRequest(ctx context.Context, nodeID ids.NodeID, requestID uint32, _ time.Time, request []byte) error {
+	msg, err := ParseSignatureRequest(request)
+	if err != nil {
+		h.log.Debug("dropping malformed signature request",
+			zap.Stringer("nodeID", nodeID),
+			zap.Uint32("requestID", requestID),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	resp := SignatureResponse{}
+	if h.isSignable(msg) {
+		resp.Signature, err = h.signer.Sign(msg)
+		if err != nil {
+			h.log.Debug("couldn't sign requested message",
+				zap.Stringer("nodeID", nodeID),
+				zap.Uint32("requestID", requestID),
+				zap.Error(err),
+			)
+			resp.Signature = nil
+		}
+	}
+
+	respBytes, err := c.Marshal(codecVersion, &resp)
+	if err != nil {
+		return err
+	}
+	return h.appSender.SendAppResponse(ctx, nodeID, requestID, respBytes)
+}
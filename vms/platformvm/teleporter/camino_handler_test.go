@@ -0,0 +1,100 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package teleporter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+type testSigner struct {
+	signature []byte
+}
+
+func (s *testSigner) Sign(*UnsignedMessage) ([]byte, error) {
+	return s.signature, nil
+}
+
+// testAppSender delivers requests straight to the handler and responses
+// straight to the signature getter.
+type testAppSender struct {
+	handler *Handler
+	getter  *NetworkSignatureGetter
+	nodeID  ids.NodeID
+}
+
+func (s *testAppSender) SendAppRequest(ctx context.Context, nodeIDs set.Set[ids.NodeID], requestID uint32, request []byte) error {
+	for nodeID := range nodeIDs {
+		if nodeID != s.nodeID {
+			s.getter.AppRequestFailed(requestID)
+			continue
+		}
+		go func(nodeID ids.NodeID) {
+			_ = s.handler.AppRequest(ctx, nodeID, requestID, time.Time{}, request)
+		}(nodeID)
+	}
+	return nil
+}
+
+func (s *testAppSender) SendAppResponse(_ context.Context, _ ids.NodeID, requestID uint32, response []byte) error {
+	s.getter.AppResponse(requestID, response)
+	return nil
+}
+
+func TestHandlerServesSignableMessages(t *testing.T) {
+	require := require.New(t)
+
+	signature := []byte("signature")
+	nodeID := ids.GenerateTestNodeID()
+	appSender := &testAppSender{nodeID: nodeID}
+	handler := NewHandler(logging.NoLog{}, &testSigner{signature: signature}, appSender)
+	getter := NewNetworkSignatureGetter(appSender, 0)
+	appSender.handler = handler
+	appSender.getter = getter
+
+	msg, err := NewUnsignedMessage(ids.GenerateTestID(), ids.GenerateTestID(), []byte("payload"))
+	require.NoError(err)
+
+	// message isn't signable yet
+	_, err = getter.GetSignature(context.Background(), nodeID, msg)
+	require.ErrorIs(err, errNoSignature)
+
+	handler.MarkSignable(msg)
+	sig, err := getter.GetSignature(context.Background(), nodeID, msg)
+	require.NoError(err)
+	require.Equal(signature, sig)
+
+	handler.UnmarkSignable(msg)
+	_, err = getter.GetSignature(context.Background(), nodeID, msg)
+	require.ErrorIs(err, errNoSignature)
+
+	// request to unknown node fails
+	_, err = getter.GetSignature(context.Background(), ids.GenerateTestNodeID(), msg)
+	require.ErrorIs(err, errRequestFailed)
+
+	require.Empty(getter.pending)
+}
+
+func TestHandlerDropsMalformedRequests(t *testing.T) {
+	require := require.New(t)
+
+	appSender := &testAppSender{}
+	handler := NewHandler(logging.NoLog{}, &testSigner{}, appSender)
+	require.NoError(handler.AppRequest(context.Background(), ids.GenerateTestNodeID(), 0, time.Time{}, []byte{1, 2, 3}))
+}
+
+func TestNetworkSignatureGetterUnknownRequest(t *testing.T) {
+	require := require.New(t)
+
+	getter := NewNetworkSignatureGetter(&testAppSender{}, 0)
+	require.False(getter.AppResponse(1, []byte{}))
+	require.False(getter.AppRequestFailed(1))
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package teleporter
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+var (
+	_ SignatureGetter = (*NetworkSignatureGetter)(nil)
+
+	errRequestFailed = errors.New("signature request failed")
+	errNoSignature   = errors.New("validator refused to sign message")
+)
+
+// AppRequestSender sends AppRequests. It is implemented by common.AppSender.
+type AppRequestSender interface {
+	SendAppRequest(ctx context.Context, nodeIDs set.Set[ids.NodeID], requestID uint32, appRequestBytes []byte) error
+}
+
+// SignatureGetter requests signatures over unsigned messages from other
+// nodes.
+type SignatureGetter interface {
+	// GetSignature returns the signature of [nodeID] over [msg].
+	GetSignature(ctx context.Context, nodeID ids.NodeID, msg *UnsignedMessage) ([]byte, error)
+}
+
+// NetworkSignatureGetter requests signatures from other nodes via AppRequest.
+// The VM must forward AppResponse and AppRequestFailed messages, that
+// correspond to the requests sent by the getter, to it.
+type NetworkSignatureGetter struct {
+	appSender AppRequestSender
+
+	lock          sync.Mutex
+	nextRequestID uint32
+	pending       map[uint32]chan []byte
+}
+
+// NewNetworkSignatureGetter returns a new signature getter, that sends
+// requests with ids, that start from [firstRequestID].
+func NewNetworkSignatureGetter(appSender AppRequestSender, firstRequestID uint32) *NetworkSignatureGetter {
+	return &NetworkSignatureGetter{
+		appSender:     appSender,
+		nextRequestID: firstRequestID,
+		pending:       make(map[uint32]chan []byte),
+	}
+}
+
+func (g *NetworkSignatureGetter) GetSignature(ctx context.Context, nodeID ids.NodeID, msg *UnsignedMessage) ([]byte, error) {
+	reqBytes, err := c.Marshal(codecVersion, &SignatureRequest{UnsignedMessage: msg.Bytes()})
+	if err != nil {
+		return nil, err
+	}
+
+	respChan := make(chan []byte, 1)
+	g.lock.Lock()
+	requestID := g.nextRequestID
+	g.nextRequestID++
+	g.pending[requestID] = respChan
+	g.lock.Unlock()
+	defer g.removePending(requestID)
+
+	if err := g.appSender.SendAppRequest(ctx, set.Set[ids.NodeID]{nodeID: struct{}{}}, requestID, reqBytes); err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case respBytes := <-respChan:
+		if respBytes == nil {
+			return nil, errRequestFailed
+		}
+		resp := SignatureResponse{}
+		if _, err := c.Unmarshal(respBytes, &resp); err != nil {
+			return nil, err
+		}
+		if len(resp.Signature) == 0 {
+			return nil, errNoSignature
+		}
+		return resp.Signature, nil
+	}
+}
+
+// AppResponse delivers the response to the pending request. Returns false, if
+// [requestID] doesn't belong to the pending request of this getter.
+func (g *NetworkSignatureGetter) AppResponse(requestID uint32, response []byte) bool {
+	if response == nil {
+		response = []byte{}
+	}
+	return g.deliver(requestID, response)
+}
+
+// AppRequestFailed fails the pending request. Returns false, if [requestID]
+// doesn't belong to the pending request of this getter.
+func (g *NetworkSignatureGetter) AppRequestFailed(requestID uint32) bool {
+	return g.deliver(requestID, nil)
+}
+
+func (g *NetworkSignatureGetter) deliver(requestID uint32, response []byte) bool {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	respChan, ok := g.pending[requestID]
+	if !ok {
+		return false
+	}
+	delete(g.pending, requestID)
+	respChan <- response
+	return true
+}
+
+func (g *NetworkSignatureGetter) removePending(requestID uint32) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	delete(g.pending, requestID)
+}
@@ -5,6 +5,7 @@ package teleporter
 
 import (
 	"errors"
+
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 )
@@ -42,7 +43,6 @@ func (s *signer) Sign(msg *UnsignedMessage) ([]byte, error) {
 	}
 
 	msgBytes := msg.Bytes()
-	//sig := bls.Sign(s.sk, msgBytes)
-	//return bls.SignatureToBytes(sig), nil
-	return msgBytes, nil
+	sig := bls.Sign(s.sk, msgBytes)
+	return bls.SignatureToBytes(sig), nil
 }
@@ -138,6 +138,5 @@ func AggregatePublicKeys(vdrs []*Validator) (*bls.PublicKey, error) {
 	for i, vdr := range vdrs {
 		pks[i] = vdr.PublicKey
 	}
-	//return bls.AggregatePublicKeys(pks)
-	return nil, nil
+	return bls.AggregatePublicKeys(pks)
 }
@@ -84,8 +84,9 @@ func (tx *AddPermissionlessValidatorTx) PublicKey() (*bls.PublicKey, bool, error
 	if err := tx.Signer.Verify(); err != nil {
 		return nil, false, err
 	}
-	key := tx.Signer.Key()
-	return key, key != nil, nil
+	// Camino proofs of possession carry the node signature instead of a BLS
+	// key, so validators don't register BLS keys with them
+	return nil, false, nil
 }
 
 func (tx *AddPermissionlessValidatorTx) StartTime() time.Time {
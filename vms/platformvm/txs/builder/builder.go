@@ -247,21 +247,26 @@ func (b *builder) NewImportTx(
 
 	importedAVAX := importedAmounts[b.ctx.AVAXAssetID]
 
+	txFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
 	ins := []*avax.TransferableInput{}
 	outs := []*avax.TransferableOutput{}
 	switch {
-	case importedAVAX < b.cfg.TxFee: // imported amount goes toward paying tx fee
+	case importedAVAX < txFee: // imported amount goes toward paying tx fee
 		var baseSigners [][]*crypto.PrivateKeySECP256K1R
-		ins, outs, _, baseSigners, err = b.Spend(keys, 0, b.cfg.TxFee-importedAVAX, changeAddr)
+		ins, outs, _, baseSigners, err = b.Spend(keys, 0, txFee-importedAVAX, changeAddr)
 		if err != nil {
 			return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 		}
 		signers = append(baseSigners, signers...)
 		delete(importedAmounts, b.ctx.AVAXAssetID)
-	case importedAVAX == b.cfg.TxFee:
+	case importedAVAX == txFee:
 		delete(importedAmounts, b.ctx.AVAXAssetID)
 	default:
-		importedAmounts[b.ctx.AVAXAssetID] -= b.cfg.TxFee
+		importedAmounts[b.ctx.AVAXAssetID] -= txFee
 	}
 
 	for assetID, amount := range importedAmounts {
@@ -306,9 +311,14 @@ func (b *builder) NewExportTx(
 	keys []*crypto.PrivateKeySECP256K1R,
 	changeAddr ids.ShortID,
 ) (*txs.Tx, error) {
-	toBurn, err := math.Add64(amount, b.cfg.TxFee)
+	txFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	toBurn, err := math.Add64(amount, txFee)
 	if err != nil {
-		return nil, fmt.Errorf("amount (%d) + tx fee(%d) overflows", amount, b.cfg.TxFee)
+		return nil, fmt.Errorf("amount (%d) + tx fee(%d) overflows", amount, txFee)
 	}
 	ins, outs, _, signers, err := b.Spend(keys, 0, toBurn, changeAddr)
 	if err != nil {
@@ -353,7 +363,10 @@ func (b *builder) NewCreateChainTx(
 	changeAddr ids.ShortID,
 ) (*txs.Tx, error) {
 	timestamp := b.state.GetTimestamp()
-	createBlockchainTxFee := b.cfg.GetCreateBlockchainTxFee(timestamp)
+	createBlockchainTxFee, err := b.scaledFee(b.cfg.GetCreateBlockchainTxFee(timestamp))
+	if err != nil {
+		return nil, err
+	}
 	ins, outs, _, signers, err := b.Spend(keys, 0, createBlockchainTxFee, changeAddr)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
@@ -397,7 +410,10 @@ func (b *builder) NewCreateSubnetTx(
 	changeAddr ids.ShortID,
 ) (*txs.Tx, error) {
 	timestamp := b.state.GetTimestamp()
-	createSubnetTxFee := b.cfg.GetCreateSubnetTxFee(timestamp)
+	createSubnetTxFee, err := b.scaledFee(b.cfg.GetCreateSubnetTxFee(timestamp))
+	if err != nil {
+		return nil, err
+	}
 	ins, outs, _, signers, err := b.Spend(keys, 0, createSubnetTxFee, changeAddr)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
@@ -436,7 +452,12 @@ func (b *builder) NewAddValidatorTx(
 	keys []*crypto.PrivateKeySECP256K1R,
 	changeAddr ids.ShortID,
 ) (*txs.Tx, error) {
-	ins, unstakedOuts, stakedOuts, signers, err := b.Spend(keys, stakeAmount, b.cfg.AddPrimaryNetworkValidatorFee, changeAddr)
+	txFee, err := b.scaledFee(b.cfg.AddPrimaryNetworkValidatorFee)
+	if err != nil {
+		return nil, err
+	}
+
+	ins, unstakedOuts, stakedOuts, signers, err := b.Spend(keys, stakeAmount, txFee, changeAddr)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
@@ -478,7 +499,12 @@ func (b *builder) NewAddDelegatorTx(
 	keys []*crypto.PrivateKeySECP256K1R,
 	changeAddr ids.ShortID,
 ) (*txs.Tx, error) {
-	ins, unlockedOuts, lockedOuts, signers, err := b.Spend(keys, stakeAmount, b.cfg.AddPrimaryNetworkDelegatorFee, changeAddr)
+	txFee, err := b.scaledFee(b.cfg.AddPrimaryNetworkDelegatorFee)
+	if err != nil {
+		return nil, err
+	}
+
+	ins, unlockedOuts, lockedOuts, signers, err := b.Spend(keys, stakeAmount, txFee, changeAddr)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
@@ -519,7 +545,12 @@ func (b *builder) NewAddSubnetValidatorTx(
 	keys []*crypto.PrivateKeySECP256K1R,
 	changeAddr ids.ShortID,
 ) (*txs.Tx, error) {
-	ins, outs, _, signers, err := b.Spend(keys, 0, b.cfg.TxFee, changeAddr)
+	txFee, err := b.scaledFee(b.cfg.AddSubnetValidatorFee)
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, _, signers, err := b.Spend(keys, 0, txFee, changeAddr)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
@@ -562,7 +593,12 @@ func (b *builder) NewRemoveSubnetValidatorTx(
 	keys []*crypto.PrivateKeySECP256K1R,
 	changeAddr ids.ShortID,
 ) (*txs.Tx, error) {
-	ins, outs, _, signers, err := b.Spend(keys, 0, b.cfg.TxFee, changeAddr)
+	txFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, _, signers, err := b.Spend(keys, 0, txFee, changeAddr)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
@@ -7,12 +7,16 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/crypto"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/msig"
@@ -26,8 +30,14 @@ import (
 var (
 	_ CaminoBuilder = (*caminoBuilder)(nil)
 
-	errKeyMissing       = errors.New("couldn't find key matching address")
-	errWrongNodeKeyType = errors.New("node key type isn't *crypto.PrivateKeySECP256K1R")
+	errKeyMissing              = errors.New("couldn't find key matching address")
+	errWrongNodeKeyType        = errors.New("node key type isn't *crypto.PrivateKeySECP256K1R")
+	errWrongClaimAmountsLen    = errors.New("claim amounts length doesn't match deposit tx ids length")
+	errNotDepositTx            = errors.New("tx isn't deposit tx")
+	errWrongRewardsOwnerType   = errors.New("deposit rewards owner isn't *secp256k1fx.OutputOwners")
+	errRewardsOwnerSigMissing  = errors.New("can't sign for deposit rewards owner")
+	errVoterSigMissing         = errors.New("can't sign for voter")
+	errMultisigAliasSigMissing = errors.New("can't sign for multisig alias owners")
 )
 
 type CaminoBuilder interface {
@@ -67,6 +77,46 @@ type CaminoTxBuilder interface {
 		keys []*crypto.PrivateKeySECP256K1R,
 		changeAddr ids.ShortID,
 	) (*txs.Tx, error)
+
+	NewClaimTx(
+		depositTxIDs []ids.ID,
+		claimAmounts []uint64,
+		keys []*crypto.PrivateKeySECP256K1R,
+		changeAddr ids.ShortID,
+	) (*txs.Tx, error)
+
+	NewAddProposalTx(
+		proposal dao.Proposal,
+		keys []*crypto.PrivateKeySECP256K1R,
+		changeAddr ids.ShortID,
+	) (*txs.Tx, error)
+
+	NewAddVoteTx(
+		proposalID ids.ID,
+		optionIndex uint32,
+		voterAddress ids.ShortID,
+		keys []*crypto.PrivateKeySECP256K1R,
+		changeAddr ids.ShortID,
+	) (*txs.Tx, error)
+
+	NewFinishProposalsTx(
+		proposalIDs []ids.ID,
+	) (*txs.Tx, error)
+
+	NewMultisigAliasTx(
+		alias ids.ShortID,
+		threshold uint32,
+		addresses []ids.ShortID,
+		keys []*crypto.PrivateKeySECP256K1R,
+		changeAddr ids.ShortID,
+	) (*txs.Tx, error)
+
+	NewSetKycExpirationTx(
+		address ids.ShortID,
+		expiration uint64,
+		keys []*crypto.PrivateKeySECP256K1R,
+		changeAddr ids.ShortID,
+	) (*txs.Tx, error)
 }
 
 func NewCamino(
@@ -123,7 +173,12 @@ func (b *caminoBuilder) NewAddValidatorTx(
 		)
 	}
 
-	ins, outs, signers, err := b.Lock(keys, stakeAmount, b.cfg.AddPrimaryNetworkValidatorFee, locked.StateBonded, changeAddr)
+	txFee, err := b.scaledFee(b.cfg.AddPrimaryNetworkValidatorFee)
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, signers, err := b.Lock(keys, stakeAmount, txFee, locked.StateBonded, changeAddr)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
@@ -229,7 +284,12 @@ func (b *caminoBuilder) NewAddAddressStateTx(
 	keys []*crypto.PrivateKeySECP256K1R,
 	changeAddr ids.ShortID,
 ) (*txs.Tx, error) {
-	ins, outs, signers, err := b.Lock(keys, 0, b.cfg.TxFee, locked.StateUnlocked, changeAddr)
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, signers, err := b.Lock(keys, 0, baseFee, locked.StateUnlocked, changeAddr)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
@@ -262,7 +322,12 @@ func (b *caminoBuilder) NewDepositTx(
 	keys []*crypto.PrivateKeySECP256K1R,
 	changeAddr ids.ShortID,
 ) (*txs.Tx, error) {
-	ins, outs, signers, err := b.Lock(keys, amount, b.cfg.TxFee, locked.StateDeposited, changeAddr)
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, signers, err := b.Lock(keys, amount, baseFee, locked.StateDeposited, changeAddr)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
@@ -306,7 +371,12 @@ func (b *caminoBuilder) NewUnlockDepositTx(
 	}
 
 	// burning fee
-	feeIns, feeOuts, feeSigners, err := b.Lock(keys, 0, b.cfg.TxFee, locked.StateUnlocked, changeAddr)
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	feeIns, feeOuts, feeSigners, err := b.Lock(keys, 0, baseFee, locked.StateUnlocked, changeAddr)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
@@ -343,7 +413,12 @@ func (b *caminoBuilder) NewRegisterNodeTx(
 	keys []*crypto.PrivateKeySECP256K1R,
 	changeAddr ids.ShortID,
 ) (*txs.Tx, error) {
-	ins, outs, signers, err := b.Lock(keys, 0, b.cfg.TxFee, locked.StateUnlocked, changeAddr)
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, signers, err := b.Lock(keys, 0, baseFee, locked.StateUnlocked, changeAddr)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
@@ -394,6 +469,295 @@ func (b *caminoBuilder) NewRegisterNodeTx(
 	return tx, tx.SyntacticVerify(b.ctx)
 }
 
+func (b *caminoBuilder) NewClaimTx(
+	depositTxIDs []ids.ID,
+	claimAmounts []uint64,
+	keys []*crypto.PrivateKeySECP256K1R,
+	changeAddr ids.ShortID,
+) (*txs.Tx, error) {
+	if len(depositTxIDs) != len(claimAmounts) {
+		return nil, errWrongClaimAmountsLen
+	}
+
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, signers, err := b.Lock(keys, 0, baseFee, locked.StateUnlocked, changeAddr)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	// deposit tx ids must be sorted, claim amounts and auths must match them
+	claims := make(map[ids.ID]uint64, len(depositTxIDs))
+	for i, depositTxID := range depositTxIDs {
+		claims[depositTxID] = claimAmounts[i]
+	}
+	sortedDepositTxIDs := make([]ids.ID, len(depositTxIDs))
+	copy(sortedDepositTxIDs, depositTxIDs)
+	utils.Sort(sortedDepositTxIDs)
+
+	sortedClaimAmounts := make([]uint64, len(sortedDepositTxIDs))
+	rewardsAuths := make([]verify.Verifiable, len(sortedDepositTxIDs))
+	for i, depositTxID := range sortedDepositTxIDs {
+		sortedClaimAmounts[i] = claims[depositTxID]
+
+		signedDepositTx, _, err := b.state.GetTx(depositTxID)
+		if err != nil {
+			return nil, err
+		}
+		depositTx, ok := signedDepositTx.Unsigned.(*txs.DepositTx)
+		if !ok {
+			return nil, errNotDepositTx
+		}
+		rewardsOwner, ok := depositTx.RewardsOwner.(*secp256k1fx.OutputOwners)
+		if !ok {
+			return nil, errWrongRewardsOwnerType
+		}
+
+		kc := secp256k1fx.NewKeychain(keys...)
+		sigIndices, rewardsSigners, able := kc.Match(rewardsOwner, b.clk.Unix())
+		if !able {
+			return nil, errRewardsOwnerSigMissing
+		}
+
+		rewardsAuths[i] = &secp256k1fx.Input{SigIndices: sigIndices}
+		signers = append(signers, rewardsSigners)
+	}
+
+	utx := &txs.ClaimTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		DepositTxIDs: sortedDepositTxIDs,
+		ClaimAmounts: sortedClaimAmounts,
+		RewardsAuth:  rewardsAuths,
+	}
+
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+func (b *caminoBuilder) NewAddProposalTx(
+	proposal dao.Proposal,
+	keys []*crypto.PrivateKeySECP256K1R,
+	changeAddr ids.ShortID,
+) (*txs.Tx, error) {
+	caminoConfig, err := b.state.CaminoConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, signers, err := b.Lock(keys, caminoConfig.DaoProposalBondAmount, baseFee, locked.StateBonded, changeAddr)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	utx := &txs.AddProposalTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		Proposal: proposal,
+	}
+
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+func (b *caminoBuilder) NewAddVoteTx(
+	proposalID ids.ID,
+	optionIndex uint32,
+	voterAddress ids.ShortID,
+	keys []*crypto.PrivateKeySECP256K1R,
+	changeAddr ids.ShortID,
+) (*txs.Tx, error) {
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, signers, err := b.Lock(keys, 0, baseFee, locked.StateUnlocked, changeAddr)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	voterOwner, err := msig.GetOwner(b.state, voterAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	kc := secp256k1fx.NewKeychain(keys...)
+	sigIndices, voterSigners, able := kc.Match(voterOwner, b.clk.Unix())
+	if !able {
+		return nil, errVoterSigMissing
+	}
+	signers = append(signers, voterSigners)
+
+	utx := &txs.AddVoteTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		ProposalID:   proposalID,
+		OptionIndex:  optionIndex,
+		VoterAddress: voterAddress,
+		VoterAuth:    &secp256k1fx.Input{SigIndices: sigIndices},
+	}
+
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+func (b *caminoBuilder) NewFinishProposalsTx(proposalIDs []ids.ID) (*txs.Tx, error) {
+	sortedProposalIDs := make([]ids.ID, len(proposalIDs))
+	copy(sortedProposalIDs, proposalIDs)
+	utils.Sort(sortedProposalIDs)
+
+	ins, outs, err := b.Unlock(b.state, sortedProposalIDs, locked.StateBonded)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	utx := &txs.FinishProposalsTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		ProposalIDs: sortedProposalIDs,
+	}
+
+	tx, err := txs.NewSigned(utx, txs.Codec, nil)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+func (b *caminoBuilder) NewMultisigAliasTx(
+	alias ids.ShortID,
+	threshold uint32,
+	addresses []ids.ShortID,
+	keys []*crypto.PrivateKeySECP256K1R,
+	changeAddr ids.ShortID,
+) (*txs.Tx, error) {
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, signers, err := b.Lock(keys, 0, baseFee, locked.StateUnlocked, changeAddr)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	aliasAuth := &secp256k1fx.Input{}
+	if alias != ids.ShortEmpty {
+		aliasOwner, err := b.state.GetMultisigOwner(alias)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get multisig alias %s: %w", alias, err)
+		}
+
+		owners := aliasOwner.Owners
+		policy, err := b.state.GetMultisigPolicy(alias)
+		switch {
+		case err == nil && policy.LargeThreshold > owners.Threshold:
+			owners.Threshold = policy.LargeThreshold
+		case err != nil && err != database.ErrNotFound:
+			return nil, err
+		}
+
+		kc := secp256k1fx.NewKeychain(keys...)
+		sigIndices, aliasSigners, able := kc.Match(&owners, b.clk.Unix())
+		if !able {
+			return nil, errMultisigAliasSigMissing
+		}
+		aliasAuth.SigIndices = sigIndices
+		signers = append(signers, aliasSigners)
+	}
+
+	sortedAddresses := make([]ids.ShortID, len(addresses))
+	copy(sortedAddresses, addresses)
+	utils.Sort(sortedAddresses)
+
+	utx := &txs.MultisigAliasTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		Alias:     alias,
+		Threshold: threshold,
+		Addresses: sortedAddresses,
+		AliasAuth: aliasAuth,
+	}
+
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+func (b *caminoBuilder) NewSetKycExpirationTx(
+	address ids.ShortID,
+	expiration uint64,
+	keys []*crypto.PrivateKeySECP256K1R,
+	changeAddr ids.ShortID,
+) (*txs.Tx, error) {
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, signers, err := b.Lock(keys, 0, baseFee, locked.StateUnlocked, changeAddr)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	utx := &txs.SetKycExpirationTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		Address:    address,
+		Expiration: expiration,
+	}
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
 func getSigner(
 	keys []*crypto.PrivateKeySECP256K1R,
 	address ids.ShortID,
@@ -421,3 +785,13 @@ func getSigners(
 	}
 	return signers, nil
 }
+
+// scaledFee returns [staticFee] of some tx type scaled according to the
+// current base fee
+func (b *builder) scaledFee(staticFee uint64) (uint64, error) {
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return 0, err
+	}
+	return b.cfg.ScaleFee(staticFee, baseFee)
+}
@@ -139,7 +139,7 @@ func newCaminoEnvironment(postBanff bool, caminoGenesisConf api.Camino) *caminoE
 
 	atomicUTXOs := avax.NewAtomicUTXOManager(ctx.SharedMemory, txs.Codec)
 	uptimes := uptime.NewManager(baseState)
-	utxoHandler := utxo.NewHandler(ctx, &clk, baseState, fx)
+	utxoHandler := utxo.NewHandler(ctx, &clk, baseState, fx, &config)
 
 	txBuilder := NewCamino(
 		ctx,
@@ -349,6 +349,7 @@ func defaultCaminoConfig(postBanff bool) config.Config {
 		TxFee:                  defaultTxFee,
 		CreateSubnetTxFee:      100 * defaultTxFee,
 		CreateBlockchainTxFee:  100 * defaultTxFee,
+		AddSubnetValidatorFee:  defaultTxFee,
 		MinValidatorStake:      defaultCaminoValidatorWeight,
 		MaxValidatorStake:      defaultCaminoValidatorWeight,
 		MinDelegatorStake:      1 * units.MilliAvax,
@@ -363,9 +364,6 @@ func defaultCaminoConfig(postBanff bool) config.Config {
 		ApricotPhase3Time: defaultValidateEndTime,
 		ApricotPhase5Time: defaultValidateEndTime,
 		BanffTime:         banffTime,
-		CaminoConfig: config.CaminoConfig{
-			DaoProposalBondAmount: 100 * units.Avax,
-		},
 	}
 }
 
@@ -0,0 +1,63 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+)
+
+var (
+	_ UnsignedTx = (*AddDepositOfferTx)(nil)
+
+	errNilDepositOffer = errors.New("deposit offer is nil")
+)
+
+// AddDepositOfferTx is an unsigned addDepositOfferTx
+type AddDepositOfferTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// ID of existing deposit offer that will be updated.
+	// If empty, new deposit offer will be added.
+	DepositOfferID ids.ID `serialize:"true" json:"depositOfferID"`
+	// Deposit offer that will be added or that will replace existing one
+	DepositOffer *deposit.Offer `serialize:"true" json:"depositOffer"`
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *AddDepositOfferTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case tx.DepositOffer == nil:
+		return errNilDepositOffer
+	}
+
+	if err := tx.DepositOffer.Verify(); err != nil {
+		return fmt.Errorf("failed to verify deposit offer: %w", err)
+	}
+
+	if err := locked.VerifyNoLocks(tx.Ins, tx.Outs); err != nil {
+		return err
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *AddDepositOfferTx) Visit(visitor Visitor) error {
+	return visitor.AddDepositOfferTx(tx)
+}
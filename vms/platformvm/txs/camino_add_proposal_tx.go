@@ -0,0 +1,70 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+)
+
+var (
+	_ UnsignedTx = (*AddProposalTx)(nil)
+
+	errNilProposal = errors.New("proposal is nil")
+)
+
+// AddProposalTx is an unsigned addProposalTx
+type AddProposalTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// Proposal that will be voted on. Its bond will be unlocked when voting ends.
+	Proposal dao.Proposal `serialize:"true" json:"proposal"`
+}
+
+func (tx *AddProposalTx) BondAmount() (uint64, error) {
+	bondAmount := uint64(0)
+	for _, out := range tx.Outs {
+		if lockedOut, ok := out.Out.(*locked.Out); ok && lockedOut.IsNewlyLockedWith(locked.StateBonded) {
+			newBondAmount, err := math.Add64(bondAmount, lockedOut.Amount())
+			if err != nil {
+				return 0, err
+			}
+			bondAmount = newBondAmount
+		}
+	}
+	return bondAmount, nil
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *AddProposalTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case tx.Proposal == nil:
+		return errNilProposal
+	}
+
+	if err := tx.Proposal.Verify(); err != nil {
+		return fmt.Errorf("failed to verify proposal: %w", err)
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *AddProposalTx) Visit(visitor Visitor) error {
+	return visitor.AddProposalTx(tx)
+}
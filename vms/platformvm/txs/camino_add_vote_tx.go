@@ -0,0 +1,70 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+)
+
+var (
+	_ UnsignedTx = (*AddVoteTx)(nil)
+
+	errEmptyProposalID = errors.New("proposal id is empty")
+	errEmptyVoter      = errors.New("voter address is empty")
+)
+
+// AddVoteTx is an unsigned addVoteTx
+type AddVoteTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// ID of proposal that is voted on
+	ProposalID ids.ID `serialize:"true" json:"proposalID"`
+	// Index of proposal option that is voted for
+	OptionIndex uint32 `serialize:"true" json:"optionIndex"`
+	// Address of consortium member that votes
+	VoterAddress ids.ShortID `serialize:"true" json:"voterAddress"`
+	// Auth that will be used to verify credential for voter address.
+	// Credential for voter must be placed after credentials for inputs.
+	VoterAuth verify.Verifiable `serialize:"true" json:"voterAuth"`
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *AddVoteTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case tx.ProposalID == ids.Empty:
+		return errEmptyProposalID
+	case tx.VoterAddress == ids.ShortEmpty:
+		return errEmptyVoter
+	}
+
+	if err := verify.All(tx.VoterAuth); err != nil {
+		return fmt.Errorf("failed to verify voter auth: %w", err)
+	}
+
+	if err := locked.VerifyNoLocks(tx.Ins, tx.Outs); err != nil {
+		return err
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *AddVoteTx) Visit(visitor Visitor) error {
+	return visitor.AddVoteTx(tx)
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+)
+
+var (
+	_ UnsignedTx = (*ClaimTx)(nil)
+
+	errNoDepositsToClaim     = errors.New("no deposit txs with rewards to claim")
+	errNotSortedDepositTxIDs = errors.New("deposit tx ids are not sorted and unique")
+	errWrongClaimAmountsLen  = errors.New("claim amounts length doesn't match deposit tx ids length")
+	errWrongRewardsAuthLen   = errors.New("rewards auth length doesn't match deposit tx ids length")
+	errZeroClaimAmount       = errors.New("claim amount is zero")
+)
+
+// ClaimTx is an unsigned claimTx
+type ClaimTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// IDs of deposit txs which rewards will be claimed
+	DepositTxIDs []ids.ID `serialize:"true" json:"depositTxIDs"`
+	// Amounts of rewards that will be claimed for corresponding deposits
+	ClaimAmounts []uint64 `serialize:"true" json:"claimAmounts"`
+	// Auths that will be used to verify credentials for corresponding deposits rewards owners.
+	// Credentials for rewards owners must be placed after credentials for inputs.
+	RewardsAuth []verify.Verifiable `serialize:"true" json:"rewardsAuth"`
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *ClaimTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case len(tx.DepositTxIDs) == 0:
+		return errNoDepositsToClaim
+	case len(tx.ClaimAmounts) != len(tx.DepositTxIDs):
+		return errWrongClaimAmountsLen
+	case len(tx.RewardsAuth) != len(tx.DepositTxIDs):
+		return errWrongRewardsAuthLen
+	case !utils.IsSortedAndUniqueSortable(tx.DepositTxIDs):
+		return errNotSortedDepositTxIDs
+	}
+
+	for _, claimAmount := range tx.ClaimAmounts {
+		if claimAmount == 0 {
+			return errZeroClaimAmount
+		}
+	}
+
+	if err := verify.All(tx.RewardsAuth...); err != nil {
+		return fmt.Errorf("failed to verify rewards auth: %w", err)
+	}
+
+	if err := locked.VerifyNoLocks(tx.Ins, tx.Outs); err != nil {
+		return err
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *ClaimTx) Visit(visitor Visitor) error {
+	return visitor.ClaimTx(tx)
+}
@@ -8,10 +8,13 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
 var _ UnsignedTx = (*DepositTx)(nil)
@@ -54,6 +57,27 @@ func (tx *DepositTx) DepositAmount() (uint64, error) {
 	return depositAmount, nil
 }
 
+// Owners returns sorted addresses of deposit rewards owner and
+// owners of deposited outputs
+func (tx *DepositTx) Owners() []ids.ShortID {
+	owners := set.Set[ids.ShortID]{}
+	if rewardsOwner, ok := tx.RewardsOwner.(*secp256k1fx.OutputOwners); ok {
+		owners.Add(rewardsOwner.Addrs...)
+	}
+	for _, out := range tx.Outs {
+		lockedOut, ok := out.Out.(*locked.Out)
+		if !ok || !lockedOut.IsNewlyLockedWith(locked.StateDeposited) {
+			continue
+		}
+		if transferOut, ok := lockedOut.TransferableOut.(*secp256k1fx.TransferOutput); ok {
+			owners.Add(transferOut.Addrs...)
+		}
+	}
+	ownersList := owners.List()
+	utils.Sort(ownersList)
+	return ownersList
+}
+
 // SyntacticVerify returns nil if [tx] is valid
 func (tx *DepositTx) SyntacticVerify(ctx *snow.Context) error {
 	switch {
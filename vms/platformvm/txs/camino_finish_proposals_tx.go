@@ -0,0 +1,58 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils"
+)
+
+var (
+	_ UnsignedTx = (*FinishProposalsTx)(nil)
+
+	errNoProposalsToFinish    = errors.New("no proposals to finish")
+	errNotSortedProposalIDs   = errors.New("proposal ids are not sorted and unique")
+	errFinishProposalsHasMemo = errors.New("finish proposals tx must not have memo")
+)
+
+// FinishProposalsTx is a system tx that finishes proposals which voting ended,
+// executes successful ones and unlocks their bonds.
+type FinishProposalsTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// IDs of proposals that will be finished
+	ProposalIDs []ids.ID `serialize:"true" json:"proposalIDs"`
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *FinishProposalsTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case len(tx.ProposalIDs) == 0:
+		return errNoProposalsToFinish
+	case !utils.IsSortedAndUniqueSortable(tx.ProposalIDs):
+		return errNotSortedProposalIDs
+	case len(tx.Memo) != 0:
+		return errFinishProposalsHasMemo
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *FinishProposalsTx) Visit(visitor Visitor) error {
+	return visitor.FinishProposalsTx(tx)
+}
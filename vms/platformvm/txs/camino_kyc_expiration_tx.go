@@ -0,0 +1,51 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+)
+
+var _ UnsignedTx = (*SetKycExpirationTx)(nil)
+
+// SetKycExpirationTx is an unsigned setKycExpirationTx
+type SetKycExpirationTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// Address, which kyc verified state expiration will be set
+	Address ids.ShortID `serialize:"true" json:"address"`
+	// Unix timestamp at which kyc verified state of address will expire.
+	// Zero means that it never expires.
+	Expiration uint64 `serialize:"true" json:"expiration"`
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *SetKycExpirationTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case tx.Address == ids.ShortEmpty:
+		return ErrEmptyAddress
+	}
+
+	if err := locked.VerifyNoLocks(tx.Ins, tx.Outs); err != nil {
+		return err
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return err
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *SetKycExpirationTx) Visit(visitor Visitor) error {
+	return visitor.SetKycExpirationTx(tx)
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+)
+
+var (
+	_ UnsignedTx = (*MultisigAliasPolicyTx)(nil)
+
+	errEmptyMultisigAlias         = errors.New("multisig alias is empty")
+	errLargeSpendingWithoutAmount = errors.New("large spending threshold or delay is set without large amount")
+)
+
+// MultisigAliasPolicyTx is an unsigned multisigAliasPolicyTx
+type MultisigAliasPolicyTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// Alias, which spending policy will be set
+	Alias ids.ShortID `serialize:"true" json:"alias"`
+	// Max AVAX amount, that can be spent from alias during one day.
+	// Zero means no limit.
+	DailyLimit uint64 `serialize:"true" json:"dailyLimit"`
+	// Spendings above this AVAX amount are large. Zero means
+	// there are no large spendings.
+	LargeAmount uint64 `serialize:"true" json:"largeAmount"`
+	// Number of alias owners signatures required for large spendings
+	LargeThreshold uint32 `serialize:"true" json:"largeThreshold"`
+	// Duration in seconds, for which outputs of large spendings must be
+	// timelocked, if they aren't owned by alias
+	LargeDelay uint64 `serialize:"true" json:"largeDelay"`
+	// Auth that will be used to verify credential for current alias owners.
+	// Credential for alias must be placed after credentials for inputs.
+	// Number of signatures must be equal to the greatest of alias threshold
+	// and current policy large spending threshold.
+	AliasAuth verify.Verifiable `serialize:"true" json:"aliasAuth"`
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *MultisigAliasPolicyTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case tx.Alias == ids.ShortEmpty:
+		return errEmptyMultisigAlias
+	case tx.LargeAmount == 0 && (tx.LargeThreshold != 0 || tx.LargeDelay != 0):
+		return errLargeSpendingWithoutAmount
+	}
+
+	if err := tx.AliasAuth.Verify(); err != nil {
+		return fmt.Errorf("failed to verify alias auth: %w", err)
+	}
+
+	if err := locked.VerifyNoLocks(tx.Ins, tx.Outs); err != nil {
+		return err
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *MultisigAliasPolicyTx) Visit(visitor Visitor) error {
+	return visitor.MultisigAliasPolicyTx(tx)
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var (
+	_ UnsignedTx = (*MultisigAliasTx)(nil)
+
+	errNoMultisigAliasOwners = errors.New("multisig alias has no owners")
+	errZeroMultisigThreshold = errors.New("multisig alias threshold is zero")
+)
+
+// MultisigAliasTx is an unsigned multisigAliasTx
+type MultisigAliasTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// Alias that will be updated. If empty, new alias will be created
+	// with id derived from this tx id.
+	Alias ids.ShortID `serialize:"true" json:"alias"`
+	// Number of owners signatures required to authorize alias
+	Threshold uint32 `serialize:"true" json:"threshold"`
+	// Sorted and unique addresses of alias owners
+	Addresses []ids.ShortID `serialize:"true" json:"addresses"`
+	// Auth that will be used to verify credential for current alias owners,
+	// if alias is updated. Credential for alias must be placed after
+	// credentials for inputs.
+	AliasAuth verify.Verifiable `serialize:"true" json:"aliasAuth"`
+}
+
+// Owners returns new owners of alias
+func (tx *MultisigAliasTx) Owners() *secp256k1fx.OutputOwners {
+	return &secp256k1fx.OutputOwners{
+		Threshold: tx.Threshold,
+		Addrs:     tx.Addresses,
+	}
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *MultisigAliasTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case len(tx.Addresses) == 0:
+		return errNoMultisigAliasOwners
+	case tx.Threshold == 0:
+		return errZeroMultisigThreshold
+	}
+
+	if err := tx.Owners().Verify(); err != nil {
+		return fmt.Errorf("failed to verify alias owners: %w", err)
+	}
+
+	if err := tx.AliasAuth.Verify(); err != nil {
+		return fmt.Errorf("failed to verify alias auth: %w", err)
+	}
+
+	if err := locked.VerifyNoLocks(tx.Ins, tx.Outs); err != nil {
+		return err
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *MultisigAliasTx) Visit(visitor Visitor) error {
+	return visitor.MultisigAliasTx(tx)
+}
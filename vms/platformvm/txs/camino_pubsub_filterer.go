@@ -0,0 +1,85 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/pubsub"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var _ pubsub.Filterer = (*filterer)(nil)
+
+type filterer struct {
+	tx         *Tx
+	stateUTXOs []*avax.UTXO
+}
+
+// NewPubSubFilterer returns filterer, that matches addresses of [tx] outputs,
+// including inner outputs of locked outputs, addresses of [stateUTXOs] and
+// addresses referenced by camino txs: deposit rewards owners, address state
+// targets, consortium members and multisig aliases with their owners.
+//
+// [stateUTXOs] are utxos produced by [tx] execution, which aren't [tx]
+// outputs, e.g. claimed or validator rewards.
+func NewPubSubFilterer(tx *Tx, stateUTXOs []*avax.UTXO) pubsub.Filterer {
+	return &filterer{tx: tx, stateUTXOs: stateUTXOs}
+}
+
+// Apply the filter on the addresses.
+func (f *filterer) Filter(filters []pubsub.Filter) ([]bool, interface{}) {
+	resp := make([]bool, len(filters))
+	for _, address := range f.addresses() {
+		for i, c := range filters {
+			if resp[i] {
+				continue
+			}
+			resp[i] = c.Check(address)
+		}
+	}
+	return resp, api.JSONTxID{
+		TxID: f.tx.ID(),
+	}
+}
+
+func (f *filterer) addresses() [][]byte {
+	addresses := [][]byte{}
+	for _, utxo := range append(f.tx.UTXOs(), f.stateUTXOs...) {
+		addressable, ok := utxo.Out.(avax.Addressable)
+		if !ok {
+			continue
+		}
+		addresses = append(addresses, addressable.Addresses()...)
+	}
+
+	var referencedAddresses []ids.ShortID
+	switch utx := f.tx.Unsigned.(type) {
+	case *DepositTx:
+		if owner, ok := utx.RewardsOwner.(*secp256k1fx.OutputOwners); ok {
+			referencedAddresses = owner.Addrs
+		}
+	case *AddAddressStateTx:
+		referencedAddresses = []ids.ShortID{utx.Address}
+	case *SetKycExpirationTx:
+		referencedAddresses = []ids.ShortID{utx.Address}
+	case *RegisterNodeTx:
+		referencedAddresses = []ids.ShortID{utx.ConsortiumMemberAddress}
+	case *AddVoteTx:
+		referencedAddresses = []ids.ShortID{utx.VoterAddress}
+	case *MultisigAliasTx:
+		referencedAddresses = utx.Addresses
+		if utx.Alias != ids.ShortEmpty {
+			referencedAddresses = append([]ids.ShortID{utx.Alias}, referencedAddresses...)
+		}
+	case *MultisigAliasPolicyTx:
+		referencedAddresses = []ids.ShortID{utx.Alias}
+	}
+	for _, address := range referencedAddresses {
+		addr := address
+		addresses = append(addresses, addr[:])
+	}
+	return addresses
+}
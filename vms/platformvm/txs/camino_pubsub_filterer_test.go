@@ -0,0 +1,131 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/pubsub"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+type mockFilter struct {
+	addr []byte
+}
+
+func (f *mockFilter) Check(addr []byte) bool {
+	return bytes.Equal(addr, f.addr)
+}
+
+func TestPubSubFilterer(t *testing.T) {
+	outAddr := ids.ShortID{1}
+	lockedOutAddr := ids.ShortID{2}
+	referencedAddr := ids.ShortID{3}
+	otherAddr := ids.ShortID{4}
+
+	baseTx := BaseTx{BaseTx: avax.BaseTx{
+		Outs: []*avax.TransferableOutput{
+			{
+				Out: &secp256k1fx.TransferOutput{
+					OutputOwners: secp256k1fx.OutputOwners{
+						Addrs: []ids.ShortID{outAddr},
+					},
+				},
+			},
+			{
+				Out: &locked.Out{
+					IDs: locked.IDs{DepositTxID: locked.ThisTxID},
+					TransferableOut: &secp256k1fx.TransferOutput{
+						OutputOwners: secp256k1fx.OutputOwners{
+							Addrs: []ids.ShortID{lockedOutAddr},
+						},
+					},
+				},
+			},
+		},
+	}}
+
+	tests := map[string]UnsignedTx{
+		"DepositTx": &DepositTx{
+			BaseTx: baseTx,
+			RewardsOwner: &secp256k1fx.OutputOwners{
+				Addrs: []ids.ShortID{referencedAddr},
+			},
+		},
+		"AddAddressStateTx": &AddAddressStateTx{
+			BaseTx:  baseTx,
+			Address: referencedAddr,
+		},
+		"RegisterNodeTx": &RegisterNodeTx{
+			BaseTx:                  baseTx,
+			ConsortiumMemberAddress: referencedAddr,
+		},
+		"AddVoteTx": &AddVoteTx{
+			BaseTx:       baseTx,
+			VoterAddress: referencedAddr,
+		},
+		"MultisigAliasTx: new alias": &MultisigAliasTx{
+			BaseTx:    baseTx,
+			Addresses: []ids.ShortID{referencedAddr},
+		},
+		"MultisigAliasTx: updated alias": &MultisigAliasTx{
+			BaseTx:    baseTx,
+			Alias:     referencedAddr,
+			Addresses: []ids.ShortID{ids.GenerateTestShortID()},
+		},
+		"MultisigAliasPolicyTx": &MultisigAliasPolicyTx{
+			BaseTx: baseTx,
+			Alias:  referencedAddr,
+		},
+	}
+	for name, utx := range tests {
+		t.Run(name, func(t *testing.T) {
+			filterer := NewPubSubFilterer(&Tx{Unsigned: utx}, nil)
+			fr, _ := filterer.Filter([]pubsub.Filter{
+				&mockFilter{addr: outAddr[:]},
+				&mockFilter{addr: lockedOutAddr[:]},
+				&mockFilter{addr: referencedAddr[:]},
+				&mockFilter{addr: otherAddr[:]},
+			})
+			require.Equal(t, []bool{true, true, true, false}, fr)
+		})
+	}
+}
+
+func TestPubSubFiltererStateUTXOs(t *testing.T) {
+	outAddr := ids.ShortID{1}
+	rewardsOwnerAddr := ids.ShortID{2}
+	otherAddr := ids.ShortID{3}
+
+	claimTx := &ClaimTx{BaseTx: BaseTx{BaseTx: avax.BaseTx{
+		Outs: []*avax.TransferableOutput{{
+			Out: &secp256k1fx.TransferOutput{
+				OutputOwners: secp256k1fx.OutputOwners{
+					Addrs: []ids.ShortID{outAddr},
+				},
+			},
+		}},
+	}}}
+	claimedRewardUTXO := &avax.UTXO{
+		Out: &secp256k1fx.TransferOutput{
+			OutputOwners: secp256k1fx.OutputOwners{
+				Addrs: []ids.ShortID{rewardsOwnerAddr},
+			},
+		},
+	}
+
+	filterer := NewPubSubFilterer(&Tx{Unsigned: claimTx}, []*avax.UTXO{claimedRewardUTXO})
+	fr, _ := filterer.Filter([]pubsub.Filter{
+		&mockFilter{addr: outAddr[:]},
+		&mockFilter{addr: rewardsOwnerAddr[:]},
+		&mockFilter{addr: otherAddr[:]},
+	})
+	require.Equal(t, []bool{true, true, false}, fr)
+}
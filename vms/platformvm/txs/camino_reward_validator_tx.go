@@ -3,7 +3,11 @@
 
 package txs
 
-import "github.com/ava-labs/avalanchego/vms/components/avax"
+import (
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+)
 
 var _ UnsignedTx = (*CaminoRewardValidatorTx)(nil)
 
@@ -23,3 +27,15 @@ type CaminoRewardValidatorTx struct {
 
 	RewardValidatorTx `serialize:"true"`
 }
+
+func (tx *CaminoRewardValidatorTx) InputIDs() set.Set[ids.ID] {
+	inputIDs := set.NewSet[ids.ID](len(tx.Ins))
+	for _, in := range tx.Ins {
+		inputIDs.Add(in.InputID())
+	}
+	return inputIDs
+}
+
+func (tx *CaminoRewardValidatorTx) Outputs() []*avax.TransferableOutput {
+	return tx.Outs
+}
@@ -8,4 +8,12 @@ type CaminoVisitor interface {
 	DepositTx(*DepositTx) error
 	UnlockDepositTx(*UnlockDepositTx) error
 	RegisterNodeTx(*RegisterNodeTx) error
+	AddDepositOfferTx(*AddDepositOfferTx) error
+	ClaimTx(*ClaimTx) error
+	AddProposalTx(*AddProposalTx) error
+	AddVoteTx(*AddVoteTx) error
+	FinishProposalsTx(*FinishProposalsTx) error
+	MultisigAliasTx(*MultisigAliasTx) error
+	MultisigAliasPolicyTx(*MultisigAliasPolicyTx) error
+	SetKycExpirationTx(*SetKycExpirationTx) error
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/codec/linearcodec"
 	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
 	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
@@ -113,6 +114,18 @@ func RegisterUnsignedTxsTypes(targetCodec codec.CaminoRegistry) error {
 		targetCodec.RegisterCustomType(&DepositTx{}),
 		targetCodec.RegisterCustomType(&UnlockDepositTx{}),
 		targetCodec.RegisterCustomType(&RegisterNodeTx{}),
+		targetCodec.RegisterCustomType(&AddDepositOfferTx{}),
+		targetCodec.RegisterCustomType(&ClaimTx{}),
+		targetCodec.RegisterCustomType(&AddProposalTx{}),
+		targetCodec.RegisterCustomType(&AddVoteTx{}),
+		targetCodec.RegisterCustomType(&FinishProposalsTx{}),
+
+		targetCodec.RegisterCustomType(&dao.BaseFeeProposal{}),
+		targetCodec.RegisterCustomType(&dao.AddDepositOfferProposal{}),
+
+		targetCodec.RegisterCustomType(&MultisigAliasTx{}),
+		targetCodec.RegisterCustomType(&MultisigAliasPolicyTx{}),
+		targetCodec.RegisterCustomType(&SetKycExpirationTx{}),
 	)
 	return errs.Err
 }
@@ -106,7 +106,7 @@ func newCaminoEnvironment(postBanff bool, caminoGenesisConf api.Camino) *caminoE
 
 	atomicUTXOs := avax.NewAtomicUTXOManager(ctx.SharedMemory, txs.Codec)
 	uptimes := uptime.NewManager(baseState)
-	utxoHandler := utxo.NewHandler(ctx, &clk, baseState, fx)
+	utxoHandler := utxo.NewHandler(ctx, &clk, baseState, fx, &config)
 
 	txBuilder := builder.NewCamino(
 		ctx,
@@ -244,6 +244,7 @@ func defaultCaminoConfig(postBanff bool) config.Config {
 		TxFee:                  defaultTxFee,
 		CreateSubnetTxFee:      100 * defaultTxFee,
 		CreateBlockchainTxFee:  100 * defaultTxFee,
+		AddSubnetValidatorFee:  defaultTxFee,
 		MinValidatorStake:      defaultCaminoValidatorWeight,
 		MaxValidatorStake:      defaultCaminoValidatorWeight,
 		MinDelegatorStake:      1 * units.MilliAvax,
@@ -258,9 +259,6 @@ func defaultCaminoConfig(postBanff bool) config.Config {
 		ApricotPhase3Time: defaultValidateEndTime,
 		ApricotPhase5Time: defaultValidateEndTime,
 		BanffTime:         banffTime,
-		CaminoConfig: config.CaminoConfig{
-			DaoProposalBondAmount: 100 * units.Avax,
-		},
 	}
 }
 
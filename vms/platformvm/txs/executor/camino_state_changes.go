@@ -0,0 +1,61 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+type caminoStateChanges struct {
+	// new address states of addresses which kyc verification expired
+	expiredKycAddressStates map[ids.ShortID]uint64
+}
+
+func (s *caminoStateChanges) Apply(stateDiff state.Diff) {
+	for address, states := range s.expiredKycAddressStates {
+		stateDiff.SetAddressStates(address, states)
+		stateDiff.RemoveKycExpiration(address)
+	}
+}
+
+func (s *caminoStateChanges) Len() int {
+	return len(s.expiredKycAddressStates)
+}
+
+// caminoAdvanceTimeTo does not modify [parentState].
+// Instead it adds to [changes] all camino-specific state changes caused by
+// advancing the chain time to the [newChainTime].
+func caminoAdvanceTimeTo(
+	parentState state.Chain,
+	newChainTime time.Time,
+	changes *stateChanges,
+) error {
+	kycExpirations, err := parentState.GetAllKycExpirations()
+	if err != nil {
+		return err
+	}
+
+	newChainTimestamp := uint64(newChainTime.Unix())
+	for address, expiration := range kycExpirations {
+		if expiration > newChainTimestamp {
+			continue
+		}
+
+		states, err := parentState.GetAddressStates(address)
+		if err != nil {
+			return err
+		}
+
+		if changes.expiredKycAddressStates == nil {
+			changes.expiredKycAddressStates = make(map[ids.ShortID]uint64)
+		}
+		changes.expiredKycAddressStates[address] = states&^txs.AddressStateKycVerifiedBit | txs.AddressStateKycExpiredBit
+	}
+
+	return nil
+}
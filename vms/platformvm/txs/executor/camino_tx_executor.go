@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
+	"time"
 
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
@@ -14,8 +16,12 @@ import (
 	"github.com/ava-labs/avalanchego/utils/crypto"
 	"github.com/ava-labs/avalanchego/utils/math"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
 	deposits "github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/genesis"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/msig"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
@@ -46,6 +52,25 @@ var (
 	errConsortiumMemberHasNode    = errors.New("consortium member already has registered node")
 	errConsortiumSignatureMissing = errors.New("wrong consortium's member signature")
 	errNotNodeOwner               = errors.New("node is registered for another consortium member address")
+	errDepositOfferAlreadyExists  = errors.New("deposit offer already exists")
+	errDepositOfferEndsBeforeNow  = errors.New("deposit offer end time is before current chain time")
+	errDepositOfferEndExtended    = errors.New("deposit offer end time can't be increased")
+	errDepositOfferFieldsChanged  = errors.New("only deposit offer flags and end time can be changed")
+	errClaimAmountTooBig          = errors.New("claim amount is greater than claimable reward")
+	errWrongRewardsOwnerType      = errors.New("deposit rewards owner isn't *secp256k1fx.OutputOwners")
+	errRewardsOwnerSigMissing     = errors.New("wrong deposit rewards owner signature")
+	errProposalStartInThePast     = errors.New("proposal start time is before current chain time")
+	errWrongProposalBondAmount    = errors.New("wrong proposal bond amount")
+	errDaoProposalsDisabled       = errors.New("dao proposal bond amount isn't set")
+	errNotAthensPhase             = errors.New("tx isn't allowed before the AthensPhase upgrade")
+	errVoterSigMissing            = errors.New("wrong voter signature")
+	errWrongProposalsToFinish     = errors.New("proposals to finish don't match expected ones")
+	errMultisigAliasSigMissing    = errors.New("wrong multisig alias owners signatures")
+	errNestedMultisigAlias        = errors.New("multisig alias owner can't be multisig alias")
+	errMultisigThresholdTooBig    = errors.New("multisig alias policy large threshold is greater than number of owners")
+	errKycExpirationNotInFuture   = errors.New("kyc expiration time isn't after current chain time")
+	errKycExpired                 = errors.New("address kyc verification expired")
+	errNotKycVerified             = errors.New("address isn't kyc verified")
 )
 
 type CaminoStandardTxExecutor struct {
@@ -120,6 +145,10 @@ func (e *CaminoStandardTxExecutor) AddValidatorTx(tx *txs.AddValidatorTx) error
 		return fmt.Errorf("%w: %s", errNotConsortiumMember, err)
 	}
 
+	if err := e.verifyKycNotExpired(consortiumMemberAddress); err != nil {
+		return err
+	}
+
 	// verifying consortium member signatures
 
 	signersAddresses, err := e.Fx.RecoverAddresses(tx, e.Tx.Creds)
@@ -178,6 +207,11 @@ func (e *CaminoStandardTxExecutor) AddValidatorTx(tx *txs.AddValidatorTx) error
 			)
 		}
 
+		txFee, err := scaledFee(e.Backend.Config, e.State, e.Backend.Config.AddPrimaryNetworkValidatorFee)
+		if err != nil {
+			return err
+		}
+
 		// Verify the flowcheck
 		if err := e.Backend.FlowChecker.VerifyLock(
 			tx,
@@ -185,7 +219,7 @@ func (e *CaminoStandardTxExecutor) AddValidatorTx(tx *txs.AddValidatorTx) error
 			tx.Ins,
 			tx.Outs,
 			e.Tx.Creds,
-			e.Backend.Config.AddPrimaryNetworkValidatorFee,
+			txFee,
 			e.Backend.Ctx.AVAXAssetID,
 			locked.StateBonded,
 		); err != nil {
@@ -206,6 +240,9 @@ func (e *CaminoStandardTxExecutor) AddValidatorTx(tx *txs.AddValidatorTx) error
 		return err
 	}
 	e.State.PutPendingValidator(newStaker)
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
 	utxo.Consume(e.State, tx.Ins)
 	if err := utxo.ProduceLocked(e.State, txID, tx.Outs, locked.StateBonded); err != nil {
 		return err
@@ -435,7 +472,8 @@ func (e *CaminoProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx)
 		return fmt.Errorf("failed to get next removed staker tx: %w", err)
 	}
 
-	if _, ok := stakerTx.Unsigned.(txs.ValidatorTx); !ok {
+	vdrTx, ok := stakerTx.Unsigned.(txs.ValidatorTx)
+	if !ok {
 		// Invariant: Permissioned stakers are removed by the advancement of
 		//            time and the current chain timestamp is == this staker's
 		//            EndTime. This means only permissionless stakers should be
@@ -448,11 +486,69 @@ func (e *CaminoProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx)
 
 	txID := e.Tx.ID()
 
+	if err := spendMultisigPolicies(e.Backend, e.OnCommitState, caminoTx.Ins, caminoTx.Outs); err != nil {
+		return err
+	}
 	utxo.Consume(e.OnCommitState, caminoTx.Ins)
+	if err := spendMultisigPolicies(e.Backend, e.OnAbortState, caminoTx.Ins, caminoTx.Outs); err != nil {
+		return err
+	}
 	utxo.Consume(e.OnAbortState, caminoTx.Ins)
 	utxo.Produce(e.OnCommitState, txID, caminoTx.Outs)
 	utxo.Produce(e.OnAbortState, txID, caminoTx.Outs)
 
+	// Potential rewards of validators, which started staking before the
+	// AthensPhase upgrade, weren't calculated with the camino calculator
+	// and aren't minted
+	if !e.Config.IsAthensPhaseActivated(stakerToRemove.StartTime) {
+		return nil
+	}
+
+	// Provide the reward here
+	if stakerToRemove.PotentialReward > 0 {
+		outIntf, err := e.Fx.CreateOutput(stakerToRemove.PotentialReward, vdrTx.ValidationRewardsOwner())
+		if err != nil {
+			return fmt.Errorf("failed to create output: %w", err)
+		}
+		out, ok := outIntf.(verify.State)
+		if !ok {
+			return errInvalidState
+		}
+
+		rewardUTXO := &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID:        txID,
+				OutputIndex: uint32(len(caminoTx.Outs)),
+			},
+			Asset: avax.Asset{ID: e.Ctx.AVAXAssetID},
+			Out:   out,
+		}
+
+		e.OnCommitState.AddUTXO(rewardUTXO)
+		e.OnCommitState.AddRewardUTXO(tx.TxID, rewardUTXO)
+	}
+
+	// If the reward is aborted, then the current supply should be decreased.
+	currentSupply, err := e.OnAbortState.GetCurrentSupply(constants.PrimaryNetworkID)
+	if err != nil {
+		return err
+	}
+	newSupply, err := math.Sub(currentSupply, stakerToRemove.PotentialReward)
+	if err != nil {
+		return err
+	}
+	e.OnAbortState.SetCurrentSupply(constants.PrimaryNetworkID, newSupply)
+
+	uptime, err := e.Uptimes.CalculateUptimePercentFrom(
+		stakerToRemove.NodeID,
+		constants.PrimaryNetworkID,
+		stakerToRemove.StartTime,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to calculate uptime: %w", err)
+	}
+
+	e.PrefersCommit = uptime >= e.Config.UptimePercentage
 	return nil
 }
 
@@ -501,13 +597,33 @@ func (e *CaminoStandardTxExecutor) DepositTx(tx *txs.DepositTx) error {
 		return errDepositToSmall
 	}
 
+	// verify that depositors kyc isn't expired
+
+	if e.Config.IsAthensPhaseActivated(e.State.GetTimestamp()) {
+		signers, err := e.Fx.RecoverAddresses(tx, e.Tx.Creds)
+		if err != nil {
+			return fmt.Errorf("%w: %s", errRecoverAdresses, err)
+		}
+
+		for signer := range signers {
+			if err := e.verifyKycNotExpired(signer); err != nil {
+				return err
+			}
+		}
+	}
+
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
 	if err := e.FlowChecker.VerifyLock(
 		tx,
 		e.State,
 		tx.Ins,
 		tx.Outs,
 		e.Tx.Creds,
-		e.Config.TxFee,
+		baseFee,
 		e.Ctx.AVAXAssetID,
 		locked.StateDeposited,
 	); err != nil {
@@ -536,8 +652,11 @@ func (e *CaminoStandardTxExecutor) DepositTx(tx *txs.DepositTx) error {
 	}
 
 	e.State.SetCurrentSupply(constants.PrimaryNetworkID, newSupply)
-	e.State.UpdateDeposit(txID, deposit)
+	e.State.AddDeposit(txID, deposit, tx.Owners())
 
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
 	utxo.Consume(e.State, tx.Ins)
 	if err := utxo.ProduceLocked(e.State, txID, tx.Outs, locked.StateDeposited); err != nil {
 		return err
@@ -564,13 +683,18 @@ func (e *CaminoStandardTxExecutor) UnlockDepositTx(tx *txs.UnlockDepositTx) erro
 		return err
 	}
 
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
 	newUnlockedAmounts, err := e.FlowChecker.VerifyUnlockDeposit(
 		e.State,
 		tx,
 		tx.Ins,
 		tx.Outs,
 		e.Tx.Creds,
-		e.Config.TxFee,
+		baseFee,
 		e.Ctx.AVAXAssetID,
 	)
 	if err != nil {
@@ -602,12 +726,16 @@ func (e *CaminoStandardTxExecutor) UnlockDepositTx(tx *txs.UnlockDepositTx) erro
 				ClaimedRewardAmount: deposit.ClaimedRewardAmount,
 				Amount:              deposit.Amount,
 				Start:               deposit.Start,
+				Duration:            deposit.Duration,
 			}
 		}
 
 		e.State.UpdateDeposit(depositTxID, updatedDeposit)
 	}
 
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
 	utxo.Consume(e.State, tx.Ins)
 	utxo.Produce(e.State, e.Tx.ID(), tx.Outs)
 
@@ -630,6 +758,11 @@ func (e *CaminoStandardTxExecutor) RegisterNodeTx(tx *txs.RegisterNodeTx) error
 		return errNotConsortiumMember
 	}
 
+	if consortiumMemberAddressState&txs.AddressStateKycExpiredBit != 0 &&
+		e.Config.IsAthensPhaseActivated(e.State.GetTimestamp()) {
+		return errKycExpired
+	}
+
 	newNodeIDNotEmpty := tx.NewNodeID != ids.EmptyNodeID
 	oldNodeIDNotEmpty := tx.OldNodeID != ids.EmptyNodeID
 
@@ -684,13 +817,18 @@ func (e *CaminoStandardTxExecutor) RegisterNodeTx(tx *txs.RegisterNodeTx) error
 
 	// verify the flowcheck
 
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
 	if err := e.FlowChecker.VerifyLock(
 		tx,
 		e.State,
 		tx.Ins,
 		tx.Outs,
 		e.Tx.Creds[:len(e.Tx.Creds)-2], // base tx creds
-		e.Config.TxFee,
+		baseFee,
 		e.Ctx.AVAXAssetID,
 		locked.StateBonded,
 	); err != nil {
@@ -702,6 +840,9 @@ func (e *CaminoStandardTxExecutor) RegisterNodeTx(tx *txs.RegisterNodeTx) error
 	txID := e.Tx.ID()
 
 	// Consume the UTXOS
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
 	utxo.Consume(e.State, tx.Ins)
 	// Produce the UTXOS
 	utxo.Produce(e.State, txID, tx.Outs)
@@ -783,6 +924,17 @@ func (e *CaminoStandardTxExecutor) AddAddressStateTx(tx *txs.AddAddressStateTx)
 		newStates |= statesBit
 	}
 
+	// Re-verified kyc isn't expired anymore
+	isAthensPhase := e.Config.IsAthensPhaseActivated(e.State.GetTimestamp())
+	if isAthensPhase && statesBit == txs.AddressStateKycVerifiedBit && !tx.Remove {
+		newStates &^= txs.AddressStateKycExpiredBit
+	}
+
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
 	// Verify the flowcheck
 	if err := e.FlowChecker.VerifySpend(
 		tx,
@@ -791,7 +943,7 @@ func (e *CaminoStandardTxExecutor) AddAddressStateTx(tx *txs.AddAddressStateTx)
 		tx.Outs,
 		e.Tx.Creds,
 		map[ids.ID]uint64{
-			e.Ctx.AVAXAssetID: e.Config.TxFee,
+			e.Ctx.AVAXAssetID: baseFee,
 		},
 	); err != nil {
 		return err
@@ -800,6 +952,9 @@ func (e *CaminoStandardTxExecutor) AddAddressStateTx(tx *txs.AddAddressStateTx)
 	txID := e.Tx.ID()
 
 	// Consume the UTXOS
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
 	utxo.Consume(e.State, tx.Ins)
 	// Produce the UTXOS
 	utxo.Produce(e.State, txID, tx.Outs)
@@ -807,6 +962,95 @@ func (e *CaminoStandardTxExecutor) AddAddressStateTx(tx *txs.AddAddressStateTx)
 	if states != newStates {
 		e.State.SetAddressStates(tx.Address, newStates)
 	}
+	// Previous kyc expiration doesn't apply to re-verified or removed kyc
+	if isAthensPhase && statesBit == txs.AddressStateKycVerifiedBit {
+		e.State.RemoveKycExpiration(tx.Address)
+	}
+
+	return nil
+}
+
+func (e *CaminoStandardTxExecutor) SetKycExpirationTx(tx *txs.SetKycExpirationTx) error {
+	chainTime := e.State.GetTimestamp()
+	if !e.Config.IsAthensPhaseActivated(chainTime) {
+		return errNotAthensPhase
+	}
+
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	addresses, err := e.Fx.RecoverAddresses(tx, e.Tx.Creds)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errRecoverAdresses, err)
+	}
+
+	if addresses.Len() == 0 {
+		return errWrongNumberOfCredentials
+	}
+
+	// Accumulate roles over all signers
+	roles := uint64(0)
+	for address := range addresses {
+		states, err := e.State.GetAddressStates(address)
+		if err != nil {
+			return err
+		}
+		roles |= states
+	}
+
+	// Verify that roles are allowed to modify kyc verified state
+	if err := verifyAccess(roles, txs.AddressStateKycVerifiedBit); err != nil {
+		return err
+	}
+
+	states, err := e.State.GetAddressStates(tx.Address)
+	if err != nil {
+		return err
+	}
+
+	if states&txs.AddressStateKycVerifiedBit == 0 {
+		return errNotKycVerified
+	}
+
+	if tx.Expiration != 0 && tx.Expiration <= uint64(chainTime.Unix()) {
+		return errKycExpirationNotInFuture
+	}
+
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
+	// Verify the flowcheck
+	if err := e.FlowChecker.VerifySpend(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		e.Tx.Creds,
+		map[ids.ID]uint64{
+			e.Ctx.AVAXAssetID: baseFee,
+		},
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+	}
+
+	txID := e.Tx.ID()
+
+	// Consume the UTXOS
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
+	utxo.Consume(e.State, tx.Ins)
+	// Produce the UTXOS
+	utxo.Produce(e.State, txID, tx.Outs)
+
+	if tx.Expiration == 0 {
+		e.State.RemoveKycExpiration(tx.Address)
+	} else {
+		e.State.SetKycExpiration(tx.Address, tx.Expiration)
+	}
 
 	return nil
 }
@@ -828,6 +1072,22 @@ func verifyAccess(roles, statesBit uint64) error {
 	return nil
 }
 
+// verifyKycNotExpired returns error if kyc verification of [address] expired.
+// Expired kyc isn't checked before the AthensPhase upgrade.
+func (e *CaminoStandardTxExecutor) verifyKycNotExpired(address ids.ShortID) error {
+	if !e.Config.IsAthensPhaseActivated(e.State.GetTimestamp()) {
+		return nil
+	}
+	states, err := e.State.GetAddressStates(address)
+	if err != nil {
+		return err
+	}
+	if states&txs.AddressStateKycExpiredBit != 0 {
+		return fmt.Errorf("%w: %s", errKycExpired, address)
+	}
+	return nil
+}
+
 func verifyAddrsOwner(addrs set.Set[ids.ShortID], owner *secp256k1fx.OutputOwners) error {
 	matchingSigsCount := uint32(0)
 	for _, addr := range owner.Addrs {
@@ -840,3 +1100,795 @@ func verifyAddrsOwner(addrs set.Set[ids.ShortID], owner *secp256k1fx.OutputOwner
 	}
 	return errors.New("missing signature")
 }
+
+func (e *CaminoStandardTxExecutor) AddDepositOfferTx(tx *txs.AddDepositOfferTx) error {
+	if !e.Config.IsAthensPhaseActivated(e.State.GetTimestamp()) {
+		return errNotAthensPhase
+	}
+
+	caminoConfig, err := e.State.CaminoConfig()
+	if err != nil {
+		return err
+	}
+
+	if !caminoConfig.LockModeBondDeposit {
+		return errWrongLockMode
+	}
+
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	// verify admin role
+
+	addresses, err := e.Fx.RecoverAddresses(tx, e.Tx.Creds)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errRecoverAdresses, err)
+	}
+
+	roles := uint64(0)
+	for address := range addresses {
+		states, err := e.State.GetAddressStates(address)
+		if err != nil {
+			return err
+		}
+		roles |= states
+	}
+
+	if roles&txs.AddressStateRoleAdminBit == 0 {
+		return errInvalidRoles
+	}
+
+	// verify offer
+
+	currentChainTime := uint64(e.State.GetTimestamp().Unix())
+	if tx.DepositOffer.End < currentChainTime {
+		return errDepositOfferEndsBeforeNow
+	}
+
+	offer := *tx.DepositOffer
+
+	if tx.DepositOfferID == ids.Empty {
+		if err := offer.SetID(); err != nil {
+			return err
+		}
+		if _, err := e.State.GetDepositOffer(offer.ID); err == nil {
+			return errDepositOfferAlreadyExists
+		} else if err != database.ErrNotFound {
+			return err
+		}
+	} else {
+		oldOffer, err := e.State.GetDepositOffer(tx.DepositOfferID)
+		if err != nil {
+			return err
+		}
+
+		// Existing deposits are still referencing this offer for reward calculation,
+		// so only flags and end time (which are not used in calculations) could be changed.
+		// End time could only be decreased to close offer early.
+		if offer.End > oldOffer.End {
+			return errDepositOfferEndExtended
+		}
+
+		offer.ID = oldOffer.ID
+		updatedOldOffer := *oldOffer
+		updatedOldOffer.End = offer.End
+		updatedOldOffer.Flags = offer.Flags
+		if offer != updatedOldOffer {
+			return errDepositOfferFieldsChanged
+		}
+	}
+
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
+	// Verify the flowcheck
+	if err := e.FlowChecker.VerifySpend(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		e.Tx.Creds,
+		map[ids.ID]uint64{
+			e.Ctx.AVAXAssetID: baseFee,
+		},
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+	}
+
+	txID := e.Tx.ID()
+
+	// Consume the UTXOS
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
+	utxo.Consume(e.State, tx.Ins)
+	// Produce the UTXOS
+	utxo.Produce(e.State, txID, tx.Outs)
+
+	e.State.AddDepositOffer(&offer)
+
+	return nil
+}
+
+func (e *CaminoStandardTxExecutor) ClaimTx(tx *txs.ClaimTx) error {
+	if !e.Config.IsAthensPhaseActivated(e.State.GetTimestamp()) {
+		return errNotAthensPhase
+	}
+
+	caminoConfig, err := e.State.CaminoConfig()
+	if err != nil {
+		return err
+	}
+
+	if !caminoConfig.LockModeBondDeposit {
+		return errWrongLockMode
+	}
+
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	if len(e.Tx.Creds) < len(tx.DepositTxIDs) {
+		return errWrongNumberOfCredentials
+	}
+
+	baseTxCredsLen := len(e.Tx.Creds) - len(tx.DepositTxIDs)
+	baseTxCreds := e.Tx.Creds[:baseTxCredsLen]
+	rewardsOwnersCreds := e.Tx.Creds[baseTxCredsLen:]
+
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
+	// Verify the flowcheck
+	if err := e.FlowChecker.VerifySpend(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		baseTxCreds,
+		map[ids.ID]uint64{
+			e.Ctx.AVAXAssetID: baseFee,
+		},
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+	}
+
+	txID := e.Tx.ID()
+	currentChainTime := uint64(e.State.GetTimestamp().Unix())
+
+	// Consume the UTXOS
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
+	utxo.Consume(e.State, tx.Ins)
+	// Produce the UTXOS
+	utxo.Produce(e.State, txID, tx.Outs)
+
+	for i, depositTxID := range tx.DepositTxIDs {
+		deposit, err := e.State.GetDeposit(depositTxID)
+		if err != nil {
+			return err
+		}
+
+		offer, err := e.State.GetDepositOffer(deposit.DepositOfferID)
+		if err != nil {
+			return err
+		}
+
+		claimAmount := tx.ClaimAmounts[i]
+		if claimAmount > deposit.ClaimableReward(offer, currentChainTime) {
+			return errClaimAmountTooBig
+		}
+
+		// verify rewards owner signatures
+
+		signedDepositTx, _, err := e.State.GetTx(depositTxID)
+		if err != nil {
+			return err
+		}
+
+		depositTx, ok := signedDepositTx.Unsigned.(*txs.DepositTx)
+		if !ok {
+			return errWrongTxType
+		}
+
+		rewardsOwner, ok := depositTx.RewardsOwner.(*secp256k1fx.OutputOwners)
+		if !ok {
+			return errWrongRewardsOwnerType
+		}
+
+		if err := e.Fx.VerifyPermission(
+			e.Tx.Unsigned,
+			tx.RewardsAuth[i],
+			rewardsOwnersCreds[i],
+			rewardsOwner,
+		); err != nil {
+			return fmt.Errorf("%w: %s", errRewardsOwnerSigMissing, err)
+		}
+
+		// update deposit
+
+		updatedDeposit := *deposit
+		updatedDeposit.ClaimedRewardAmount += claimAmount
+		if updatedDeposit.UnlockedAmount == updatedDeposit.Amount &&
+			updatedDeposit.ClaimedRewardAmount == updatedDeposit.TotalReward(offer) {
+			e.State.UpdateDeposit(depositTxID, nil)
+		} else {
+			e.State.UpdateDeposit(depositTxID, &updatedDeposit)
+		}
+
+		// produce claimed rewards
+
+		e.State.AddUTXO(&avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID:        txID,
+				OutputIndex: uint32(len(tx.Outs) + i),
+			},
+			Asset: avax.Asset{ID: e.Ctx.AVAXAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          claimAmount,
+				OutputOwners: *rewardsOwner,
+			},
+		})
+	}
+
+	return nil
+}
+
+func (e *CaminoStandardTxExecutor) AddProposalTx(tx *txs.AddProposalTx) error {
+	if !e.Config.IsAthensPhaseActivated(e.State.GetTimestamp()) {
+		return errNotAthensPhase
+	}
+
+	caminoConfig, err := e.State.CaminoConfig()
+	if err != nil {
+		return err
+	}
+
+	if !caminoConfig.LockModeBondDeposit {
+		return errWrongLockMode
+	}
+
+	if caminoConfig.DaoProposalBondAmount == 0 {
+		return errDaoProposalsDisabled
+	}
+
+	if err := locked.VerifyLockMode(tx.Ins, tx.Outs, caminoConfig.LockModeBondDeposit); err != nil {
+		return err
+	}
+
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	if tx.Proposal.StartTime().Before(e.State.GetTimestamp()) {
+		return errProposalStartInThePast
+	}
+
+	bondAmount, err := tx.BondAmount()
+	if err != nil {
+		return err
+	}
+
+	if bondAmount != caminoConfig.DaoProposalBondAmount {
+		return fmt.Errorf("%w: expected %d, got %d",
+			errWrongProposalBondAmount, caminoConfig.DaoProposalBondAmount, bondAmount)
+	}
+
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
+	if err := e.FlowChecker.VerifyLock(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		e.Tx.Creds,
+		baseFee,
+		e.Ctx.AVAXAssetID,
+		locked.StateBonded,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+	}
+
+	txID := e.Tx.ID()
+
+	e.State.SetProposal(dao.NewProposalState(txID, tx.Proposal))
+
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
+	utxo.Consume(e.State, tx.Ins)
+	return utxo.ProduceLocked(e.State, txID, tx.Outs, locked.StateBonded)
+}
+
+func (e *CaminoStandardTxExecutor) AddVoteTx(tx *txs.AddVoteTx) error {
+	if !e.Config.IsAthensPhaseActivated(e.State.GetTimestamp()) {
+		return errNotAthensPhase
+	}
+
+	caminoConfig, err := e.State.CaminoConfig()
+	if err != nil {
+		return err
+	}
+
+	if !caminoConfig.LockModeBondDeposit {
+		return errWrongLockMode
+	}
+
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	if len(e.Tx.Creds) == 0 {
+		return errWrongNumberOfCredentials
+	}
+
+	baseTxCreds := e.Tx.Creds[:len(e.Tx.Creds)-1]
+	voterCred := e.Tx.Creds[len(e.Tx.Creds)-1]
+
+	// verify voter
+
+	voterAddressState, err := e.State.GetAddressStates(tx.VoterAddress)
+	if err != nil {
+		return err
+	}
+
+	if voterAddressState&txs.AddressStateConsortiumBit == 0 {
+		return errNotConsortiumMember
+	}
+
+	voterOwner, err := msig.GetOwner(e.State, tx.VoterAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := e.Fx.VerifyPermission(
+		e.Tx.Unsigned,
+		tx.VoterAuth,
+		voterCred,
+		voterOwner,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errVoterSigMissing, err)
+	}
+
+	// verify vote
+
+	proposal, err := e.State.GetProposal(tx.ProposalID)
+	if err != nil {
+		return err
+	}
+
+	if !proposal.IsActiveAt(e.State.GetTimestamp()) {
+		return dao.ErrNotActiveProposal
+	}
+
+	updatedProposal, err := proposal.AddVote(tx.VoterAddress, tx.OptionIndex)
+	if err != nil {
+		return err
+	}
+
+	// Verify the flowcheck
+
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
+	if err := e.FlowChecker.VerifySpend(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		baseTxCreds,
+		map[ids.ID]uint64{
+			e.Ctx.AVAXAssetID: baseFee,
+		},
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+	}
+
+	e.State.SetProposal(updatedProposal)
+
+	txID := e.Tx.ID()
+
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
+	utxo.Consume(e.State, tx.Ins)
+	utxo.Produce(e.State, txID, tx.Outs)
+
+	return nil
+}
+
+func (e *CaminoStandardTxExecutor) FinishProposalsTx(tx *txs.FinishProposalsTx) error {
+	if !e.Config.IsAthensPhaseActivated(e.State.GetTimestamp()) {
+		return errNotAthensPhase
+	}
+
+	caminoConfig, err := e.State.CaminoConfig()
+	if err != nil {
+		return err
+	}
+
+	if !caminoConfig.LockModeBondDeposit {
+		return errWrongLockMode
+	}
+
+	if len(e.Tx.Creds) != 0 {
+		return errWrongNumberOfCredentials
+	}
+
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	proposals, err := GetProposalsToFinish(e.State, e.State.GetTimestamp())
+	if err != nil {
+		return err
+	}
+
+	if len(proposals) != len(tx.ProposalIDs) {
+		return errWrongProposalsToFinish
+	}
+	for i, proposal := range proposals {
+		if proposal.ID != tx.ProposalIDs[i] {
+			return errWrongProposalsToFinish
+		}
+	}
+
+	ins, outs, err := e.FlowChecker.Unlock(e.State, tx.ProposalIDs, locked.StateBonded)
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(tx.Ins, ins) || !reflect.DeepEqual(tx.Outs, outs) {
+		return errInvalidSystemTxBody
+	}
+
+	for _, proposal := range proposals {
+		if err := e.executeProposal(proposal, caminoConfig.DaoProposalQuorum); err != nil {
+			return err
+		}
+		e.State.RemoveProposal(proposal.ID)
+	}
+
+	txID := e.Tx.ID()
+
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
+	utxo.Consume(e.State, tx.Ins)
+	utxo.Produce(e.State, txID, tx.Outs)
+
+	return nil
+}
+
+// executeProposal applies [proposal] outcome to the state, if it has one
+func (e *CaminoStandardTxExecutor) executeProposal(proposal *dao.ProposalState, quorum uint64) error {
+	optionIndex, ok := proposal.Outcome(quorum)
+	if !ok {
+		return nil
+	}
+
+	switch p := proposal.Proposal.(type) {
+	case *dao.BaseFeeProposal:
+		e.State.SetBaseFee(p.Options[optionIndex])
+	case *dao.AddDepositOfferProposal:
+		if optionIndex != dao.AddDepositOfferOptionAccept {
+			return nil
+		}
+		offer := *p.DepositOffer
+		if err := offer.SetID(); err != nil {
+			return err
+		}
+		if _, err := e.State.GetDepositOffer(offer.ID); err == nil {
+			// the same offer was already added, nothing to do
+			return nil
+		} else if err != database.ErrNotFound {
+			return err
+		}
+		e.State.AddDepositOffer(&offer)
+	default:
+		return fmt.Errorf("%w: %T", errWrongTxType, p)
+	}
+	return nil
+}
+
+// GetProposalsToFinish returns proposals which voting ended at or before
+// [chainTime], sorted by their ids.
+func GetProposalsToFinish(chainState state.Chain, chainTime time.Time) ([]*dao.ProposalState, error) {
+	allProposals, err := chainState.GetAllProposals()
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := []*dao.ProposalState{}
+	for _, proposal := range allProposals {
+		if !proposal.Proposal.EndTime().After(chainTime) {
+			proposals = append(proposals, proposal)
+		}
+	}
+
+	sort.Slice(proposals, func(i, j int) bool {
+		return proposals[i].ID.Less(proposals[j].ID)
+	})
+
+	return proposals, nil
+}
+
+// GetNextStakerChangeTime returns the next time a staker will be either added
+// or removed to/from the current validator set or the next time a proposal
+// voting will end, whichever is earlier.
+func GetNextStakerChangeTime(chainState state.Chain) (time.Time, error) {
+	nextStakerChangeTime, err := getNextStakerChangeTime(chainState)
+	if err != nil && err != database.ErrNotFound {
+		return time.Time{}, err
+	}
+	hasStakers := err == nil
+
+	nextProposalEndTime, hasProposals, err := getNextProposalEndTime(chainState)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	switch {
+	case hasStakers && hasProposals && nextProposalEndTime.Before(nextStakerChangeTime):
+		return nextProposalEndTime, nil
+	case hasStakers:
+		return nextStakerChangeTime, nil
+	case hasProposals:
+		return nextProposalEndTime, nil
+	default:
+		return time.Time{}, database.ErrNotFound
+	}
+}
+
+// getNextProposalEndTime returns the earliest end time of proposals in
+// [chainState] or false, if there are no proposals.
+func getNextProposalEndTime(chainState state.Chain) (time.Time, bool, error) {
+	proposals, err := chainState.GetAllProposals()
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	if len(proposals) == 0 {
+		return time.Time{}, false, nil
+	}
+
+	nextEndTime := proposals[0].Proposal.EndTime()
+	for _, proposal := range proposals[1:] {
+		if endTime := proposal.Proposal.EndTime(); endTime.Before(nextEndTime) {
+			nextEndTime = endTime
+		}
+	}
+	return nextEndTime, true, nil
+}
+
+func (e *CaminoStandardTxExecutor) MultisigAliasTx(tx *txs.MultisigAliasTx) error {
+	if !e.Config.IsAthensPhaseActivated(e.State.GetTimestamp()) {
+		return errNotAthensPhase
+	}
+
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	txID := e.Tx.ID()
+	baseTxCreds := e.Tx.Creds
+	aliasID := tx.Alias
+
+	if aliasID == ids.ShortEmpty {
+		// new alias
+		alias, err := genesis.NewMultisigAlias(txID, tx.Addresses, tx.Threshold)
+		if err != nil {
+			return err
+		}
+		aliasID = alias.Alias
+	} else {
+		// existing alias update, must be authorized by its current owners
+		if len(e.Tx.Creds) == 0 {
+			return errWrongNumberOfCredentials
+		}
+
+		baseTxCreds = e.Tx.Creds[:len(e.Tx.Creds)-1]
+
+		policy, err := e.verifyMultisigAliasAuth(tx, aliasID, tx.AliasAuth, e.Tx.Creds[len(e.Tx.Creds)-1])
+		if err != nil {
+			return err
+		}
+		if policy != nil && int(policy.LargeThreshold) > len(tx.Addresses) {
+			return fmt.Errorf("%w: %d > %d",
+				errMultisigThresholdTooBig, policy.LargeThreshold, len(tx.Addresses))
+		}
+	}
+
+	// nested aliases aren't supported
+	for _, owner := range tx.Addresses {
+		if _, err := e.State.GetMultisigOwner(owner); err == nil {
+			return errNestedMultisigAlias
+		} else if err != database.ErrNotFound {
+			return err
+		}
+	}
+
+	// verify the flowcheck
+
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
+	if err := e.FlowChecker.VerifySpend(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		baseTxCreds,
+		map[ids.ID]uint64{
+			e.Ctx.AVAXAssetID: baseFee,
+		},
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+	}
+
+	e.State.SetMultisigOwner(&state.MultisigOwner{
+		Alias:  aliasID,
+		Owners: *tx.Owners(),
+	})
+
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
+	utxo.Consume(e.State, tx.Ins)
+	utxo.Produce(e.State, txID, tx.Outs)
+
+	return nil
+}
+
+func (e *CaminoStandardTxExecutor) MultisigAliasPolicyTx(tx *txs.MultisigAliasPolicyTx) error {
+	if !e.Config.IsAthensPhaseActivated(e.State.GetTimestamp()) {
+		return errNotAthensPhase
+	}
+
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	// policy change must be authorized by alias current owners
+	if len(e.Tx.Creds) == 0 {
+		return errWrongNumberOfCredentials
+	}
+
+	baseTxCreds := e.Tx.Creds[:len(e.Tx.Creds)-1]
+
+	if _, err := e.verifyMultisigAliasAuth(tx, tx.Alias, tx.AliasAuth, e.Tx.Creds[len(e.Tx.Creds)-1]); err != nil {
+		return err
+	}
+
+	aliasOwner, err := e.State.GetMultisigOwner(tx.Alias)
+	if err != nil {
+		return fmt.Errorf("failed to get multisig alias %s: %w", tx.Alias, err)
+	}
+	if int(tx.LargeThreshold) > len(aliasOwner.Owners.Addrs) {
+		return fmt.Errorf("%w: %d > %d",
+			errMultisigThresholdTooBig, tx.LargeThreshold, len(aliasOwner.Owners.Addrs))
+	}
+
+	// verify the flowcheck
+
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
+	if err := e.FlowChecker.VerifySpend(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		baseTxCreds,
+		map[ids.ID]uint64{
+			e.Ctx.AVAXAssetID: baseFee,
+		},
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+	}
+
+	// tx spendings are recorded by the current policy, so that
+	// they can't bypass it by changing it in the same tx
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
+
+	// spendings of current period are preserved, so
+	// changing the policy doesn't reset the daily limit
+	newPolicy := &state.MultisigPolicy{Alias: tx.Alias}
+	policy, err := e.State.GetMultisigPolicy(tx.Alias)
+	switch {
+	case err == nil:
+		newPolicy.PeriodStart = policy.PeriodStart
+		newPolicy.Spent = policy.Spent
+	case err != database.ErrNotFound:
+		return err
+	}
+	newPolicy.DailyLimit = tx.DailyLimit
+	newPolicy.LargeAmount = tx.LargeAmount
+	newPolicy.LargeThreshold = tx.LargeThreshold
+	newPolicy.LargeDelay = tx.LargeDelay
+
+	e.State.SetMultisigPolicy(newPolicy)
+
+	txID := e.Tx.ID()
+	utxo.Consume(e.State, tx.Ins)
+	utxo.Produce(e.State, txID, tx.Outs)
+
+	return nil
+}
+
+// verifyMultisigAliasAuth verifies that [aliasAuth] and [aliasCred] are
+// signed by [alias] owners. Required number of signatures is the greatest
+// of alias threshold and its policy large threshold.
+// Returns alias policy or nil, if alias has no policy.
+func (e *CaminoStandardTxExecutor) verifyMultisigAliasAuth(
+	tx txs.UnsignedTx,
+	alias ids.ShortID,
+	aliasAuth verify.Verifiable,
+	aliasCred verify.Verifiable,
+) (*state.MultisigPolicy, error) {
+	aliasOwner, err := e.State.GetMultisigOwner(alias)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get multisig alias %s: %w", alias, err)
+	}
+
+	policy, err := e.State.GetMultisigPolicy(alias)
+	switch {
+	case err == database.ErrNotFound:
+		policy = nil
+	case err != nil:
+		return nil, err
+	}
+
+	owners := aliasOwner.Owners
+	if policy != nil && policy.LargeThreshold > owners.Threshold {
+		owners.Threshold = policy.LargeThreshold
+	}
+
+	if err := e.Fx.VerifyPermission(tx, aliasAuth, aliasCred, &owners); err != nil {
+		return nil, fmt.Errorf("%w: %s", errMultisigAliasSigMissing, err)
+	}
+
+	return policy, nil
+}
+
+// scaledFee returns [staticFee] of some tx type scaled according to the
+// current base fee
+func scaledFee(cfg *config.Config, chainState state.Chain, staticFee uint64) (uint64, error) {
+	baseFee, err := chainState.GetBaseFee()
+	if err != nil {
+		return 0, err
+	}
+	return cfg.ScaleFee(staticFee, baseFee)
+}
+
+// spendMultisigPolicies records amounts spent by [ins] and [outs] from
+// multisig aliases in their spending policies, see utxo.SpendMultisigPolicies.
+// Spending policies are only enforced after the AthensPhase upgrade.
+func spendMultisigPolicies(
+	backend *Backend,
+	chainState state.Chain,
+	ins []*avax.TransferableInput,
+	outs []*avax.TransferableOutput,
+) error {
+	if !backend.Config.IsAthensPhaseActivated(chainState.GetTimestamp()) {
+		return nil
+	}
+	return utxo.SpendMultisigPolicies(chainState, backend.Ctx.AVAXAssetID, ins, outs)
+}
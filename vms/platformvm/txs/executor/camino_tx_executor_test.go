@@ -17,10 +17,14 @@ import (
 
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto"
 	"github.com/ava-labs/avalanchego/utils/hashing"
 	"github.com/ava-labs/avalanchego/utils/nodeid"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
 	"github.com/ava-labs/avalanchego/vms/platformvm/genesis"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
@@ -1277,6 +1281,7 @@ func TestCaminoRewardValidatorTx(t *testing.T) {
 	caminoGenesisConf := api.Camino{
 		VerifyNodeSignature: true,
 		LockModeBondDeposit: true,
+		ValidatorRewardRate: .10 * reward.PercentDenominator,
 	}
 
 	env := newCaminoEnvironment( /*postBanff*/ true, caminoGenesisConf)
@@ -1460,7 +1465,7 @@ func TestCaminoRewardValidatorTx(t *testing.T) {
 		onCommitUTXOs, err := avax.GetAllUTXOs(env.state, stakeOwnersAddresses)
 		require.NoError(t, err)
 		utxosAfterReward := tt.generateUTXOsAfterReward(tx.ID())
-		require.Equal(t, onCommitUTXOs, utxosAfterReward)
+		require.ElementsMatch(t, utxosAfterReward, onCommitUTXOs)
 	}
 
 	// Asserting that staker is removed
@@ -1507,14 +1512,36 @@ func TestCaminoRewardValidatorTx(t *testing.T) {
 		expectedErr: nil,
 	}
 
+	require.Greater(t, stakerToRemove.PotentialReward, uint64(0))
+	supplyBeforeReward, err := env.state.GetCurrentSupply(constants.PrimaryNetworkID)
+	require.NoError(t, err)
+
+	happyPathOnCommitTest := happyPathTest
+	happyPathOnCommitTest.generateUTXOsAfterReward = func(txID ids.ID) []*avax.UTXO {
+		rewardUTXO := &avax.UTXO{
+			UTXOID: avax.UTXOID{TxID: txID, OutputIndex: uint32(len(outs))},
+			Asset:  avax.Asset{ID: env.ctx.AVAXAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          stakerToRemove.PotentialReward,
+				OutputOwners: stakeOwners,
+			},
+		}
+		rewardUTXO.InputID()
+		return append([]*avax.UTXO{rewardUTXO}, happyPathTest.generateUTXOsAfterReward(txID)...)
+	}
+
 	t.Run("Happy path on commit", func(t *testing.T) {
-		txExecutor, tx := execute(t, happyPathTest)
+		txExecutor, tx := execute(t, happyPathOnCommitTest)
+		require.True(t, txExecutor.PrefersCommit)
 		txExecutor.OnCommitState.Apply(env.state)
 		env.state.SetHeight(uint64(1))
 		err = env.state.Commit()
 		require.NoError(t, err)
-		assertBalance(t, happyPathTest, tx)
+		assertBalance(t, happyPathOnCommitTest, tx)
 		assertNextStaker(t)
+		supply, err := env.state.GetCurrentSupply(constants.PrimaryNetworkID)
+		require.NoError(t, err)
+		require.Equal(t, supplyBeforeReward, supply)
 	})
 
 	// We need to start again the environment because the staker is already removed from the previous test
@@ -1530,6 +1557,28 @@ func TestCaminoRewardValidatorTx(t *testing.T) {
 		require.NoError(t, err)
 		assertBalance(t, happyPathTest, tx)
 		assertNextStaker(t)
+		supply, err := env.state.GetCurrentSupply(constants.PrimaryNetworkID)
+		require.NoError(t, err)
+		require.Equal(t, supplyBeforeReward-stakerToRemove.PotentialReward, supply)
+	})
+
+	// Validators, which started staking before the AthensPhase upgrade, aren't rewarded
+	env = newCaminoEnvironment( /*postBanff*/ true, caminoGenesisConf)
+	env.ctx.Lock.Lock()
+	env.config.BanffTime = env.state.GetTimestamp()
+	env.config.AthensPhaseTime = stakerToRemove.StartTime.Add(time.Second)
+
+	t.Run("Happy path before AthensPhase on commit", func(t *testing.T) {
+		txExecutor, tx := execute(t, happyPathTest)
+		txExecutor.OnCommitState.Apply(env.state)
+		env.state.SetHeight(uint64(1))
+		err = env.state.Commit()
+		require.NoError(t, err)
+		assertBalance(t, happyPathTest, tx)
+		assertNextStaker(t)
+		supply, err := env.state.GetCurrentSupply(constants.PrimaryNetworkID)
+		require.NoError(t, err)
+		require.Equal(t, supplyBeforeReward, supply)
 	})
 
 	// Shut down the environment
@@ -1754,6 +1803,273 @@ func TestAddAdressStateTxExecutor(t *testing.T) {
 	}
 }
 
+func TestCaminoStandardTxExecutorSetKycExpirationTx(t *testing.T) {
+	kycAdminKey := caminoPreFundedKeys[0]
+	kycAdminAddress := kycAdminKey.PublicKey().Address()
+	targetAddress := ids.ShortID{1}
+	feeOwners := secp256k1fx.OutputOwners{
+		Locktime:  0,
+		Threshold: 1,
+		Addrs:     []ids.ShortID{kycAdminAddress},
+	}
+
+	tests := map[string]struct {
+		beforeAthensPhase     bool
+		signerStates          uint64
+		targetStates          uint64
+		targetExpiration      uint64
+		expiration            func(chainTime uint64) uint64
+		expectedHasExpiration bool
+		expectedErr           error
+	}{
+		"Not AthensPhase": {
+			beforeAthensPhase: true,
+			signerStates:      txs.AddressStateRoleKycBit,
+			targetStates:      txs.AddressStateKycVerifiedBit,
+			expiration:        func(chainTime uint64) uint64 { return chainTime + 1 },
+			expectedErr:       errNotAthensPhase,
+		},
+		"Signer isn't kyc admin": {
+			targetStates: txs.AddressStateKycVerifiedBit,
+			expiration:   func(chainTime uint64) uint64 { return chainTime + 1 },
+			expectedErr:  errInvalidRoles,
+		},
+		"Address isn't kyc verified": {
+			signerStates: txs.AddressStateRoleKycBit,
+			targetStates: txs.AddressStateKycExpiredBit,
+			expiration:   func(chainTime uint64) uint64 { return chainTime + 1 },
+			expectedErr:  errNotKycVerified,
+		},
+		"Expiration isn't in the future": {
+			signerStates: txs.AddressStateRoleKycBit,
+			targetStates: txs.AddressStateKycVerifiedBit,
+			expiration:   func(chainTime uint64) uint64 { return chainTime },
+			expectedErr:  errKycExpirationNotInFuture,
+		},
+		"OK: set expiration": {
+			signerStates:          txs.AddressStateRoleKycBit,
+			targetStates:          txs.AddressStateKycVerifiedBit,
+			expiration:            func(chainTime uint64) uint64 { return chainTime + 1 },
+			expectedHasExpiration: true,
+		},
+		"OK: clear expiration": {
+			signerStates:     txs.AddressStateRoleKycBit,
+			targetStates:     txs.AddressStateKycVerifiedBit,
+			targetExpiration: 100,
+			expiration:       func(uint64) uint64 { return 0 },
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newCaminoEnvironment( /*postBanff*/ true, api.Camino{})
+			env.ctx.Lock.Lock()
+			defer func() {
+				require.NoError(t, shutdownCaminoEnvironment(env))
+			}()
+
+			env.config.BanffTime = env.state.GetTimestamp()
+			if tt.beforeAthensPhase {
+				env.config.AthensPhaseTime = env.state.GetTimestamp().Add(time.Second)
+			}
+			env.state.SetAddressStates(kycAdminAddress, tt.signerStates)
+			env.state.SetAddressStates(targetAddress, tt.targetStates)
+			if tt.targetExpiration != 0 {
+				env.state.SetKycExpiration(targetAddress, tt.targetExpiration)
+			}
+			utxo := generateTestUTXO(ids.ID{1}, avaxAssetID, defaultCaminoBalance, feeOwners, ids.Empty, ids.Empty)
+			env.state.AddUTXO(utxo)
+			require.NoError(t, env.state.Commit())
+
+			utx := &txs.SetKycExpirationTx{
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    env.ctx.NetworkID,
+					BlockchainID: env.ctx.ChainID,
+					Ins:          []*avax.TransferableInput{generateTestInFromUTXO(utxo, []uint32{0})},
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(avaxAssetID, defaultCaminoBalance-defaultTxFee, feeOwners, ids.Empty, ids.Empty),
+					},
+				}},
+				Address:    targetAddress,
+				Expiration: tt.expiration(uint64(env.state.GetTimestamp().Unix())),
+			}
+
+			tx, err := txs.NewSigned(utx, txs.Codec, [][]*crypto.PrivateKeySECP256K1R{{kycAdminKey}})
+			require.NoError(t, err)
+
+			onAcceptState, err := state.NewCaminoDiff(lastAcceptedID, env)
+			require.NoError(t, err)
+
+			executor := CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   onAcceptState,
+					Tx:      tx,
+				},
+			}
+
+			err = tx.Unsigned.Visit(&executor)
+			require.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+
+			states, err := onAcceptState.GetAddressStates(targetAddress)
+			require.NoError(t, err)
+			require.Equal(t, tt.targetStates, states)
+
+			expiration, err := onAcceptState.GetKycExpiration(targetAddress)
+			if tt.expectedHasExpiration {
+				require.NoError(t, err)
+				require.Equal(t, utx.Expiration, expiration)
+			} else {
+				require.ErrorIs(t, err, database.ErrNotFound)
+			}
+		})
+	}
+}
+
+func TestAddAdressStateTxExecutorKycExpiration(t *testing.T) {
+	kycAdminKey := caminoPreFundedKeys[0]
+	kycAdminAddress := kycAdminKey.PublicKey().Address()
+	targetAddress := ids.ShortID{1}
+	feeOwners := secp256k1fx.OutputOwners{
+		Locktime:  0,
+		Threshold: 1,
+		Addrs:     []ids.ShortID{kycAdminAddress},
+	}
+
+	tests := map[string]struct {
+		beforeAthensPhase     bool
+		targetStates          uint64
+		remove                bool
+		expectedStates        uint64
+		expectedHasExpiration bool
+	}{
+		"Not AthensPhase: add verified to expired": {
+			beforeAthensPhase:     true,
+			targetStates:          txs.AddressStateKycExpiredBit,
+			expectedStates:        txs.AddressStateKycVerifiedBit | txs.AddressStateKycExpiredBit,
+			expectedHasExpiration: true,
+		},
+		"OK: add verified to expired": {
+			targetStates:   txs.AddressStateKycExpiredBit,
+			expectedStates: txs.AddressStateKycVerifiedBit,
+		},
+		"OK: add verified to verified with expiration": {
+			targetStates:   txs.AddressStateKycVerifiedBit,
+			expectedStates: txs.AddressStateKycVerifiedBit,
+		},
+		"OK: remove verified with expiration": {
+			targetStates:   txs.AddressStateKycVerifiedBit,
+			remove:         true,
+			expectedStates: 0,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newCaminoEnvironment( /*postBanff*/ true, api.Camino{})
+			env.ctx.Lock.Lock()
+			defer func() {
+				require.NoError(t, shutdownCaminoEnvironment(env))
+			}()
+
+			env.config.BanffTime = env.state.GetTimestamp()
+			if tt.beforeAthensPhase {
+				env.config.AthensPhaseTime = env.state.GetTimestamp().Add(time.Second)
+			}
+			env.state.SetAddressStates(kycAdminAddress, txs.AddressStateRoleKycBit)
+			env.state.SetAddressStates(targetAddress, tt.targetStates)
+			env.state.SetKycExpiration(targetAddress, 100)
+			utxo := generateTestUTXO(ids.ID{1}, avaxAssetID, defaultCaminoBalance, feeOwners, ids.Empty, ids.Empty)
+			env.state.AddUTXO(utxo)
+			require.NoError(t, env.state.Commit())
+
+			utx := &txs.AddAddressStateTx{
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    env.ctx.NetworkID,
+					BlockchainID: env.ctx.ChainID,
+					Ins:          []*avax.TransferableInput{generateTestInFromUTXO(utxo, []uint32{0})},
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(avaxAssetID, defaultCaminoBalance-defaultTxFee, feeOwners, ids.Empty, ids.Empty),
+					},
+				}},
+				Address: targetAddress,
+				State:   txs.AddressStateKycVerified,
+				Remove:  tt.remove,
+			}
+
+			tx, err := txs.NewSigned(utx, txs.Codec, [][]*crypto.PrivateKeySECP256K1R{{kycAdminKey}})
+			require.NoError(t, err)
+
+			onAcceptState, err := state.NewCaminoDiff(lastAcceptedID, env)
+			require.NoError(t, err)
+
+			executor := CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   onAcceptState,
+					Tx:      tx,
+				},
+			}
+
+			require.NoError(t, tx.Unsigned.Visit(&executor))
+
+			states, err := onAcceptState.GetAddressStates(targetAddress)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedStates, states)
+
+			_, err = onAcceptState.GetKycExpiration(targetAddress)
+			if tt.expectedHasExpiration {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, database.ErrNotFound)
+			}
+		})
+	}
+}
+
+func TestCaminoAdvanceTimeToKycExpiration(t *testing.T) {
+	require := require.New(t)
+
+	env := newCaminoEnvironment( /*postBanff*/ true, api.Camino{})
+	env.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(shutdownCaminoEnvironment(env))
+	}()
+
+	chainTime := env.state.GetTimestamp()
+	newChainTime := chainTime.Add(time.Second)
+	expiredAddress := ids.ShortID{1}
+	notExpiredAddress := ids.ShortID{2}
+
+	env.state.SetAddressStates(expiredAddress, txs.AddressStateKycVerifiedBit|txs.AddressStateConsortiumBit)
+	env.state.SetKycExpiration(expiredAddress, uint64(newChainTime.Unix()))
+	env.state.SetAddressStates(notExpiredAddress, txs.AddressStateKycVerifiedBit)
+	env.state.SetKycExpiration(notExpiredAddress, uint64(newChainTime.Unix())+1)
+	require.NoError(env.state.Commit())
+
+	changes, err := AdvanceTimeTo(&env.backend, env.state, newChainTime)
+	require.NoError(err)
+	require.Equal(1, changes.Len())
+
+	onAcceptState, err := state.NewCaminoDiff(lastAcceptedID, env)
+	require.NoError(err)
+	changes.Apply(onAcceptState)
+
+	states, err := onAcceptState.GetAddressStates(expiredAddress)
+	require.NoError(err)
+	require.Equal(txs.AddressStateKycExpiredBit|txs.AddressStateConsortiumBit, states)
+	_, err = onAcceptState.GetKycExpiration(expiredAddress)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	states, err = onAcceptState.GetAddressStates(notExpiredAddress)
+	require.NoError(err)
+	require.Equal(txs.AddressStateKycVerifiedBit, states)
+	expiration, err := onAcceptState.GetKycExpiration(notExpiredAddress)
+	require.NoError(err)
+	require.Equal(uint64(newChainTime.Unix())+1, expiration)
+}
+
 func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 	currentTime := time.Now()
 
@@ -2386,6 +2702,38 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		"Depositor kyc expired": {
+			caminoGenesisConf: api.Camino{
+				VerifyNodeSignature: true,
+				LockModeBondDeposit: true,
+				DepositOffers: []genesis.DepositOffer{
+					testDepositOffer,
+				},
+				AddressStates: []genesis.AddressState{{
+					Address: testKey.PublicKey().Address(),
+					State:   txs.AddressStateKycExpiredBit,
+				}},
+			},
+			utxos: []*avax.UTXO{
+				generateTestUTXO(ids.ID{1}, avaxAssetID, defaultCaminoBalance, outputOwners, ids.Empty, ids.Empty),
+			},
+			generateIns: func(utxos []*avax.UTXO) []*avax.TransferableInput {
+				return []*avax.TransferableInput{
+					generateTestInFromUTXO(utxos[0], sigIndices),
+				}
+			},
+			signers: [][]*crypto.PrivateKeySECP256K1R{inputSigners},
+			outs: []*avax.TransferableOutput{
+				generateTestOut(avaxAssetID, defaultCaminoBalance-defaultCaminoValidatorWeight-defaultTxFee, outputOwners, ids.Empty, ids.Empty),
+				generateTestOut(avaxAssetID, defaultCaminoValidatorWeight, outputOwners, locked.ThisTxID, ids.Empty),
+			},
+			depositOfferID: func(env caminoEnvironment) ids.ID {
+				genesisOffers, err := env.state.GetAllDepositOffers()
+				require.NoError(t, err)
+				return genesisOffers[0].ID
+			},
+			expectedErr: errKycExpired,
+		},
 		"Happy path, deposited amount transferred to another owner": {
 			caminoGenesisConf: api.Camino{
 				VerifyNodeSignature: true,
@@ -3072,3 +3420,1411 @@ func TestCaminoStandardTxExecutorUnlockDepositTx(t *testing.T) {
 		})
 	}
 }
+
+func TestCaminoStandardTxExecutorAddDepositOfferTx(t *testing.T) {
+	currentTime := time.Now()
+
+	genesisOffer := genesis.DepositOffer{
+		InterestRateNominator:   1000,
+		Start:                   uint64(currentTime.Add(-60 * time.Hour).Unix()),
+		End:                     uint64(currentTime.Add(+60 * time.Hour).Unix()),
+		MinAmount:               1,
+		MinDuration:             60,
+		MaxDuration:             60,
+		UnlockPeriodDuration:    60,
+		NoRewardsPeriodDuration: 0,
+	}
+	genesisOfferID, err := genesisOffer.ID()
+	require.NoError(t, err)
+
+	newOffer := func() *deposits.Offer {
+		return &deposits.Offer{
+			InterestRateNominator:   2000,
+			Start:                   uint64(currentTime.Unix()),
+			End:                     uint64(currentTime.Add(+120 * time.Hour).Unix()),
+			MinAmount:               10,
+			MinDuration:             100,
+			MaxDuration:             200,
+			UnlockPeriodDuration:    50,
+			NoRewardsPeriodDuration: 50,
+		}
+	}
+	updatedGenesisOffer := func() *deposits.Offer {
+		return &deposits.Offer{
+			InterestRateNominator:   genesisOffer.InterestRateNominator,
+			Start:                   genesisOffer.Start,
+			End:                     genesisOffer.End,
+			MinAmount:               genesisOffer.MinAmount,
+			MinDuration:             genesisOffer.MinDuration,
+			MaxDuration:             genesisOffer.MaxDuration,
+			UnlockPeriodDuration:    genesisOffer.UnlockPeriodDuration,
+			NoRewardsPeriodDuration: genesisOffer.NoRewardsPeriodDuration,
+			Flags:                   genesisOffer.Flags,
+		}
+	}
+
+	adminKey, err := testKeyfactory.NewPrivateKey()
+	require.NoError(t, err)
+	adminAddr := adminKey.PublicKey().Address()
+	outputOwners := secp256k1fx.OutputOwners{
+		Locktime:  0,
+		Threshold: 1,
+		Addrs:     []ids.ShortID{adminAddr},
+	}
+	signers := [][]*crypto.PrivateKeySECP256K1R{{adminKey.(*crypto.PrivateKeySECP256K1R)}}
+	sigIndices := []uint32{0}
+
+	tests := map[string]struct {
+		beforeAthensPhase   bool
+		lockModeBondDeposit bool
+		adminState          uint64
+		offerID             ids.ID
+		offer               func() *deposits.Offer
+		expectedOffer       func(*deposits.Offer) *deposits.Offer
+		expectedErr         error
+	}{
+		"Not AthensPhase": {
+			beforeAthensPhase:   true,
+			lockModeBondDeposit: true,
+			adminState:          txs.AddressStateRoleAdminBit,
+			offer:               newOffer,
+			expectedErr:         errNotAthensPhase,
+		},
+		"Wrong lockModeBondDeposit flag": {
+			lockModeBondDeposit: false,
+			adminState:          txs.AddressStateRoleAdminBit,
+			offer:               newOffer,
+			expectedErr:         errWrongLockMode,
+		},
+		"Not admin": {
+			lockModeBondDeposit: true,
+			adminState:          txs.AddressStateRoleKycBit,
+			offer:               newOffer,
+			expectedErr:         errInvalidRoles,
+		},
+		"Invalid offer bounds": {
+			lockModeBondDeposit: true,
+			adminState:          txs.AddressStateRoleAdminBit,
+			offer: func() *deposits.Offer {
+				offer := newOffer()
+				offer.MinDuration = offer.MaxDuration + 1
+				return offer
+			},
+			expectedErr: deposits.ErrOfferMinDurationGreaterThanMax,
+		},
+		"Offer ends before current chain time": {
+			lockModeBondDeposit: true,
+			adminState:          txs.AddressStateRoleAdminBit,
+			offer: func() *deposits.Offer {
+				offer := newOffer()
+				offer.Start = uint64(currentTime.Add(-2 * time.Hour).Unix())
+				offer.End = uint64(currentTime.Add(-1 * time.Hour).Unix())
+				return offer
+			},
+			expectedErr: errDepositOfferEndsBeforeNow,
+		},
+		"Offer already exists": {
+			lockModeBondDeposit: true,
+			adminState:          txs.AddressStateRoleAdminBit,
+			offer:               updatedGenesisOffer,
+			expectedErr:         errDepositOfferAlreadyExists,
+		},
+		"Update not existing offer": {
+			lockModeBondDeposit: true,
+			adminState:          txs.AddressStateRoleAdminBit,
+			offerID:             ids.GenerateTestID(),
+			offer:               newOffer,
+			expectedErr:         database.ErrNotFound,
+		},
+		"Update extends end time": {
+			lockModeBondDeposit: true,
+			adminState:          txs.AddressStateRoleAdminBit,
+			offerID:             genesisOfferID,
+			offer: func() *deposits.Offer {
+				offer := updatedGenesisOffer()
+				offer.End++
+				return offer
+			},
+			expectedErr: errDepositOfferEndExtended,
+		},
+		"Update changes interest rate": {
+			lockModeBondDeposit: true,
+			adminState:          txs.AddressStateRoleAdminBit,
+			offerID:             genesisOfferID,
+			offer: func() *deposits.Offer {
+				offer := updatedGenesisOffer()
+				offer.InterestRateNominator++
+				return offer
+			},
+			expectedErr: errDepositOfferFieldsChanged,
+		},
+		"OK: new offer": {
+			lockModeBondDeposit: true,
+			adminState:          txs.AddressStateRoleAdminBit,
+			offer:               newOffer,
+			expectedOffer: func(offer *deposits.Offer) *deposits.Offer {
+				require.NoError(t, offer.SetID())
+				return offer
+			},
+		},
+		"OK: close and lock existing offer": {
+			lockModeBondDeposit: true,
+			adminState:          txs.AddressStateRoleAdminBit,
+			offerID:             genesisOfferID,
+			offer: func() *deposits.Offer {
+				offer := updatedGenesisOffer()
+				offer.End = uint64(currentTime.Unix())
+				offer.Flags = deposits.OfferFlagLocked
+				return offer
+			},
+			expectedOffer: func(offer *deposits.Offer) *deposits.Offer {
+				offer.ID = genesisOfferID
+				return offer
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newCaminoEnvironment( /*postBanff*/ true, api.Camino{
+				LockModeBondDeposit: tt.lockModeBondDeposit,
+				DepositOffers:       []genesis.DepositOffer{genesisOffer},
+			})
+			env.ctx.Lock.Lock()
+			defer func() {
+				require.NoError(t, shutdownCaminoEnvironment(env))
+			}()
+
+			env.config.BanffTime = env.state.GetTimestamp()
+			env.state.SetTimestamp(currentTime)
+			if tt.beforeAthensPhase {
+				env.config.AthensPhaseTime = currentTime.Add(time.Second)
+			}
+			env.state.SetAddressStates(adminAddr, tt.adminState)
+			utxo := generateTestUTXO(ids.ID{1}, avaxAssetID, defaultCaminoBalance, outputOwners, ids.Empty, ids.Empty)
+			env.state.AddUTXO(utxo)
+			require.NoError(t, env.state.Commit())
+
+			offer := tt.offer()
+			utx := &txs.AddDepositOfferTx{
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    env.ctx.NetworkID,
+					BlockchainID: env.ctx.ChainID,
+					Ins:          []*avax.TransferableInput{generateTestInFromUTXO(utxo, sigIndices)},
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(avaxAssetID, defaultCaminoBalance-defaultTxFee, outputOwners, ids.Empty, ids.Empty),
+					},
+				}},
+				DepositOfferID: tt.offerID,
+				DepositOffer:   offer,
+			}
+
+			tx, err := txs.NewSigned(utx, txs.Codec, signers)
+			require.NoError(t, err)
+
+			onAcceptState, err := state.NewCaminoDiff(lastAcceptedID, env)
+			require.NoError(t, err)
+
+			executor := CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   onAcceptState,
+					Tx:      tx,
+				},
+			}
+
+			err = tx.Unsigned.Visit(&executor)
+			require.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedOffer != nil {
+				expectedOffer := tt.expectedOffer(tt.offer())
+				stateOffer, err := onAcceptState.GetDepositOffer(expectedOffer.ID)
+				require.NoError(t, err)
+				require.Equal(t, expectedOffer, stateOffer)
+			}
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorClaimTx(t *testing.T) {
+	currentTime := time.Now().Truncate(time.Second)
+
+	// 100% per year: reward is depositAmount * passedSeconds / secondsInYear
+	genesisOffer := genesis.DepositOffer{
+		InterestRateNominator:   1_000_000,
+		Start:                   uint64(currentTime.Add(-60 * time.Hour).Unix()),
+		End:                     uint64(currentTime.Add(+60 * time.Hour).Unix()),
+		MinAmount:               1,
+		MinDuration:             1000,
+		MaxDuration:             1000,
+		UnlockPeriodDuration:    100,
+		NoRewardsPeriodDuration: 0,
+	}
+	genesisOfferID, err := genesisOffer.ID()
+	require.NoError(t, err)
+
+	feeKey, err := testKeyfactory.NewPrivateKey()
+	require.NoError(t, err)
+	rewardsKey, err := testKeyfactory.NewPrivateKey()
+	require.NoError(t, err)
+	wrongKey, err := testKeyfactory.NewPrivateKey()
+	require.NoError(t, err)
+
+	feeOwner := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{feeKey.PublicKey().Address()},
+	}
+	rewardsOwner := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{rewardsKey.PublicKey().Address()},
+	}
+
+	depositAmount := uint64(365 * 24 * 60 * 60 * 10) // reward is 10 per second
+	depositTx := &txs.Tx{Unsigned: &txs.DepositTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			Outs: []*avax.TransferableOutput{
+				generateTestOut(avaxAssetID, depositAmount, rewardsOwner, locked.ThisTxID, ids.Empty),
+			},
+		}},
+		DepositOfferID:  genesisOfferID,
+		DepositDuration: 1000,
+		RewardsOwner:    &rewardsOwner,
+	}}
+	require.NoError(t, depositTx.Sign(txs.Codec, nil))
+	depositTxID := depositTx.ID()
+
+	testDeposit := func(unlockedAmount uint64) *deposits.Deposit {
+		return &deposits.Deposit{
+			DepositOfferID: genesisOfferID,
+			UnlockedAmount: unlockedAmount,
+			Start:          uint64(currentTime.Add(-100 * time.Second).Unix()),
+			Duration:       1000,
+			Amount:         depositAmount,
+		}
+	}
+
+	tests := map[string]struct {
+		beforeAthensPhase   bool
+		lockModeBondDeposit bool
+		deposit             *deposits.Deposit
+		depositTxID         ids.ID
+		claimAmount         uint64
+		rewardsSigner       crypto.PrivateKey
+		expectedDeposit     *deposits.Deposit
+		expectedErr         error
+	}{
+		"Not AthensPhase": {
+			beforeAthensPhase:   true,
+			lockModeBondDeposit: true,
+			deposit:             testDeposit(0),
+			depositTxID:         depositTxID,
+			claimAmount:         1000,
+			rewardsSigner:       rewardsKey,
+			expectedErr:         errNotAthensPhase,
+		},
+		"Wrong lockModeBondDeposit flag": {
+			lockModeBondDeposit: false,
+			deposit:             testDeposit(0),
+			depositTxID:         depositTxID,
+			claimAmount:         1000,
+			rewardsSigner:       rewardsKey,
+			expectedErr:         errWrongLockMode,
+		},
+		"Deposit not found": {
+			lockModeBondDeposit: true,
+			deposit:             testDeposit(0),
+			depositTxID:         ids.GenerateTestID(),
+			claimAmount:         1000,
+			rewardsSigner:       rewardsKey,
+			expectedErr:         database.ErrNotFound,
+		},
+		"Claim amount is greater than claimable reward": {
+			lockModeBondDeposit: true,
+			deposit:             testDeposit(0),
+			depositTxID:         depositTxID,
+			claimAmount:         1001,
+			rewardsSigner:       rewardsKey,
+			expectedErr:         errClaimAmountTooBig,
+		},
+		"Wrong rewards owner signature": {
+			lockModeBondDeposit: true,
+			deposit:             testDeposit(0),
+			depositTxID:         depositTxID,
+			claimAmount:         1000,
+			rewardsSigner:       wrongKey,
+			expectedErr:         errRewardsOwnerSigMissing,
+		},
+		"OK: partial claim": {
+			lockModeBondDeposit: true,
+			deposit:             testDeposit(0),
+			depositTxID:         depositTxID,
+			claimAmount:         400,
+			rewardsSigner:       rewardsKey,
+			expectedDeposit: func() *deposits.Deposit {
+				deposit := testDeposit(0)
+				deposit.ClaimedRewardAmount = 400
+				return deposit
+			}(),
+		},
+		"OK: last claim of unlocked deposit": {
+			lockModeBondDeposit: true,
+			deposit: func() *deposits.Deposit {
+				deposit := testDeposit(depositAmount)
+				deposit.Start = uint64(currentTime.Add(-2000 * time.Second).Unix())
+				deposit.ClaimedRewardAmount = 9000
+				return deposit
+			}(),
+			depositTxID:   depositTxID,
+			claimAmount:   1000,
+			rewardsSigner: rewardsKey,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newCaminoEnvironment( /*postBanff*/ true, api.Camino{
+				LockModeBondDeposit: tt.lockModeBondDeposit,
+				DepositOffers:       []genesis.DepositOffer{genesisOffer},
+			})
+			env.ctx.Lock.Lock()
+			defer func() {
+				require.NoError(t, shutdownCaminoEnvironment(env))
+			}()
+
+			env.config.BanffTime = env.state.GetTimestamp()
+			if tt.beforeAthensPhase {
+				env.config.AthensPhaseTime = currentTime.Add(time.Second)
+			}
+			env.state.SetTimestamp(currentTime)
+			env.state.AddTx(depositTx, status.Committed)
+			env.state.UpdateDeposit(depositTxID, tt.deposit)
+			feeUTXO := generateTestUTXO(ids.ID{1}, avaxAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
+			env.state.AddUTXO(feeUTXO)
+			require.NoError(t, env.state.Commit())
+
+			utx := &txs.ClaimTx{
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    env.ctx.NetworkID,
+					BlockchainID: env.ctx.ChainID,
+					Ins:          []*avax.TransferableInput{generateTestInFromUTXO(feeUTXO, []uint32{0})},
+				}},
+				DepositTxIDs: []ids.ID{tt.depositTxID},
+				ClaimAmounts: []uint64{tt.claimAmount},
+				RewardsAuth:  []verify.Verifiable{&secp256k1fx.Input{SigIndices: []uint32{0}}},
+			}
+
+			tx, err := txs.NewSigned(utx, txs.Codec, [][]*crypto.PrivateKeySECP256K1R{
+				{feeKey.(*crypto.PrivateKeySECP256K1R)},
+				{tt.rewardsSigner.(*crypto.PrivateKeySECP256K1R)},
+			})
+			require.NoError(t, err)
+
+			onAcceptState, err := state.NewCaminoDiff(lastAcceptedID, env)
+			require.NoError(t, err)
+
+			executor := CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   onAcceptState,
+					Tx:      tx,
+				},
+			}
+
+			err = tx.Unsigned.Visit(&executor)
+			require.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+
+			deposit, err := onAcceptState.GetDeposit(depositTxID)
+			if tt.expectedDeposit == nil {
+				require.Nil(t, deposit)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedDeposit, deposit)
+			}
+
+			rewardUTXO, err := onAcceptState.GetUTXO((&avax.UTXOID{TxID: tx.ID(), OutputIndex: 0}).InputID())
+			require.NoError(t, err)
+			require.Equal(t, &secp256k1fx.TransferOutput{
+				Amt:          tt.claimAmount,
+				OutputOwners: rewardsOwner,
+			}, rewardUTXO.Out)
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorAddProposalTx(t *testing.T) {
+	currentTime := time.Now().Truncate(time.Second)
+	bondAmount := 100 * units.Avax
+
+	proposerKey, err := testKeyfactory.NewPrivateKey()
+	require.NoError(t, err)
+	proposerAddr := proposerKey.PublicKey().Address()
+	outputOwners := secp256k1fx.OutputOwners{
+		Locktime:  0,
+		Threshold: 1,
+		Addrs:     []ids.ShortID{proposerAddr},
+	}
+	signers := [][]*crypto.PrivateKeySECP256K1R{{proposerKey.(*crypto.PrivateKeySECP256K1R)}}
+	sigIndices := []uint32{0}
+
+	newProposal := func() dao.Proposal {
+		return &dao.BaseFeeProposal{
+			Start:   uint64(currentTime.Add(time.Hour).Unix()),
+			End:     uint64(currentTime.Add(2 * time.Hour).Unix()),
+			Options: []uint64{defaultTxFee * 2},
+		}
+	}
+
+	tests := map[string]struct {
+		lockModeBondDeposit bool
+		genesisBondAmount   uint64
+		beforeAthensPhase   bool
+		proposal            func() dao.Proposal
+		bondAmount          uint64
+		expectedErr         error
+	}{
+		"Before AthensPhase": {
+			lockModeBondDeposit: true,
+			genesisBondAmount:   bondAmount,
+			beforeAthensPhase:   true,
+			proposal:            newProposal,
+			bondAmount:          bondAmount,
+			expectedErr:         errNotAthensPhase,
+		},
+		"Wrong lockModeBondDeposit flag": {
+			lockModeBondDeposit: false,
+			genesisBondAmount:   bondAmount,
+			proposal:            newProposal,
+			bondAmount:          bondAmount,
+			expectedErr:         errWrongLockMode,
+		},
+		"Bond amount isn't set in genesis": {
+			lockModeBondDeposit: true,
+			proposal:            newProposal,
+			bondAmount:          bondAmount,
+			expectedErr:         errDaoProposalsDisabled,
+		},
+		"Invalid proposal": {
+			lockModeBondDeposit: true,
+			genesisBondAmount:   bondAmount,
+			proposal: func() dao.Proposal {
+				proposal := newProposal().(*dao.BaseFeeProposal)
+				proposal.Options = nil
+				return proposal
+			},
+			bondAmount:  bondAmount,
+			expectedErr: dao.ErrWrongOptionsCount,
+		},
+		"Proposal starts in the past": {
+			lockModeBondDeposit: true,
+			genesisBondAmount:   bondAmount,
+			proposal: func() dao.Proposal {
+				proposal := newProposal().(*dao.BaseFeeProposal)
+				proposal.Start = uint64(currentTime.Add(-time.Hour).Unix())
+				return proposal
+			},
+			bondAmount:  bondAmount,
+			expectedErr: errProposalStartInThePast,
+		},
+		"Wrong bond amount": {
+			lockModeBondDeposit: true,
+			genesisBondAmount:   bondAmount,
+			proposal:            newProposal,
+			bondAmount:          bondAmount - 1,
+			expectedErr:         errWrongProposalBondAmount,
+		},
+		"OK": {
+			lockModeBondDeposit: true,
+			genesisBondAmount:   bondAmount,
+			proposal:            newProposal,
+			bondAmount:          bondAmount,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newCaminoEnvironment( /*postBanff*/ true, api.Camino{
+				LockModeBondDeposit:   tt.lockModeBondDeposit,
+				DaoProposalBondAmount: tt.genesisBondAmount,
+			})
+			env.ctx.Lock.Lock()
+			defer func() {
+				require.NoError(t, shutdownCaminoEnvironment(env))
+			}()
+
+			env.config.BanffTime = env.state.GetTimestamp()
+			if tt.beforeAthensPhase {
+				env.config.AthensPhaseTime = currentTime.Add(time.Second)
+			}
+			env.state.SetTimestamp(currentTime)
+			utxo := generateTestUTXO(ids.ID{1}, avaxAssetID, defaultCaminoBalance, outputOwners, ids.Empty, ids.Empty)
+			env.state.AddUTXO(utxo)
+			require.NoError(t, env.state.Commit())
+
+			proposal := tt.proposal()
+			utx := &txs.AddProposalTx{
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    env.ctx.NetworkID,
+					BlockchainID: env.ctx.ChainID,
+					Ins:          []*avax.TransferableInput{generateTestInFromUTXO(utxo, sigIndices)},
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(avaxAssetID, defaultCaminoBalance-defaultTxFee-tt.bondAmount, outputOwners, ids.Empty, ids.Empty),
+						generateTestOut(avaxAssetID, tt.bondAmount, outputOwners, ids.Empty, locked.ThisTxID),
+					},
+				}},
+				Proposal: proposal,
+			}
+
+			tx, err := txs.NewSigned(utx, txs.Codec, signers)
+			require.NoError(t, err)
+
+			onAcceptState, err := state.NewCaminoDiff(lastAcceptedID, env)
+			require.NoError(t, err)
+
+			executor := CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   onAcceptState,
+					Tx:      tx,
+				},
+			}
+
+			err = tx.Unsigned.Visit(&executor)
+			require.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr == nil {
+				proposalState, err := onAcceptState.GetProposal(tx.ID())
+				require.NoError(t, err)
+				require.Equal(t, dao.NewProposalState(tx.ID(), proposal), proposalState)
+			}
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorAddVoteTx(t *testing.T) {
+	currentTime := time.Now().Truncate(time.Second)
+
+	feeKey, err := testKeyfactory.NewPrivateKey()
+	require.NoError(t, err)
+	voterKey, err := testKeyfactory.NewPrivateKey()
+	require.NoError(t, err)
+	feeAddr := feeKey.PublicKey().Address()
+	voterAddr := voterKey.PublicKey().Address()
+	feeOwners := secp256k1fx.OutputOwners{
+		Locktime:  0,
+		Threshold: 1,
+		Addrs:     []ids.ShortID{feeAddr},
+	}
+	sigIndices := []uint32{0}
+
+	proposalID := ids.ID{1, 2, 3}
+	activeProposal := func() *dao.ProposalState {
+		return dao.NewProposalState(proposalID, &dao.BaseFeeProposal{
+			Start:   uint64(currentTime.Add(-time.Hour).Unix()),
+			End:     uint64(currentTime.Add(time.Hour).Unix()),
+			Options: []uint64{defaultTxFee * 2, defaultTxFee * 3},
+		})
+	}
+
+	tests := map[string]struct {
+		voterState       uint64
+		proposal         func() *dao.ProposalState
+		optionIndex      uint32
+		signers          [][]*crypto.PrivateKeySECP256K1R
+		expectedErr      error
+		expectedProposal func() *dao.ProposalState
+	}{
+		"Not consortium member": {
+			voterState:  txs.AddressStateRoleKycBit,
+			proposal:    activeProposal,
+			signers:     [][]*crypto.PrivateKeySECP256K1R{{feeKey.(*crypto.PrivateKeySECP256K1R)}, {voterKey.(*crypto.PrivateKeySECP256K1R)}},
+			expectedErr: errNotConsortiumMember,
+		},
+		"Missing voter signature": {
+			voterState:  txs.AddressStateConsortiumBit,
+			proposal:    activeProposal,
+			signers:     [][]*crypto.PrivateKeySECP256K1R{{feeKey.(*crypto.PrivateKeySECP256K1R)}, {feeKey.(*crypto.PrivateKeySECP256K1R)}},
+			expectedErr: errVoterSigMissing,
+		},
+		"Proposal doesn't exist": {
+			voterState:  txs.AddressStateConsortiumBit,
+			signers:     [][]*crypto.PrivateKeySECP256K1R{{feeKey.(*crypto.PrivateKeySECP256K1R)}, {voterKey.(*crypto.PrivateKeySECP256K1R)}},
+			expectedErr: database.ErrNotFound,
+		},
+		"Proposal isn't active": {
+			voterState: txs.AddressStateConsortiumBit,
+			proposal: func() *dao.ProposalState {
+				return dao.NewProposalState(proposalID, &dao.BaseFeeProposal{
+					Start:   uint64(currentTime.Add(time.Hour).Unix()),
+					End:     uint64(currentTime.Add(2 * time.Hour).Unix()),
+					Options: []uint64{defaultTxFee * 2},
+				})
+			},
+			signers:     [][]*crypto.PrivateKeySECP256K1R{{feeKey.(*crypto.PrivateKeySECP256K1R)}, {voterKey.(*crypto.PrivateKeySECP256K1R)}},
+			expectedErr: dao.ErrNotActiveProposal,
+		},
+		"Wrong option index": {
+			voterState:  txs.AddressStateConsortiumBit,
+			proposal:    activeProposal,
+			optionIndex: 2,
+			signers:     [][]*crypto.PrivateKeySECP256K1R{{feeKey.(*crypto.PrivateKeySECP256K1R)}, {voterKey.(*crypto.PrivateKeySECP256K1R)}},
+			expectedErr: dao.ErrWrongOptionIndex,
+		},
+		"Already voted": {
+			voterState: txs.AddressStateConsortiumBit,
+			proposal: func() *dao.ProposalState {
+				proposal, err := activeProposal().AddVote(voterAddr, 0)
+				require.NoError(t, err)
+				return proposal
+			},
+			optionIndex: 1,
+			signers:     [][]*crypto.PrivateKeySECP256K1R{{feeKey.(*crypto.PrivateKeySECP256K1R)}, {voterKey.(*crypto.PrivateKeySECP256K1R)}},
+			expectedErr: dao.ErrAlreadyVoted,
+		},
+		"OK": {
+			voterState:  txs.AddressStateConsortiumBit,
+			proposal:    activeProposal,
+			optionIndex: 1,
+			signers:     [][]*crypto.PrivateKeySECP256K1R{{feeKey.(*crypto.PrivateKeySECP256K1R)}, {voterKey.(*crypto.PrivateKeySECP256K1R)}},
+			expectedProposal: func() *dao.ProposalState {
+				return &dao.ProposalState{
+					ID:       proposalID,
+					Proposal: activeProposal().Proposal,
+					Votes:    []uint64{0, 1},
+					Voters:   []ids.ShortID{voterAddr},
+				}
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newCaminoEnvironment( /*postBanff*/ true, api.Camino{
+				LockModeBondDeposit: true,
+			})
+			env.ctx.Lock.Lock()
+			defer func() {
+				require.NoError(t, shutdownCaminoEnvironment(env))
+			}()
+
+			env.config.BanffTime = env.state.GetTimestamp()
+			env.state.SetTimestamp(currentTime)
+			env.state.SetAddressStates(voterAddr, tt.voterState)
+			if tt.proposal != nil {
+				env.state.SetProposal(tt.proposal())
+			}
+			utxo := generateTestUTXO(ids.ID{1}, avaxAssetID, defaultCaminoBalance, feeOwners, ids.Empty, ids.Empty)
+			env.state.AddUTXO(utxo)
+			require.NoError(t, env.state.Commit())
+
+			utx := &txs.AddVoteTx{
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    env.ctx.NetworkID,
+					BlockchainID: env.ctx.ChainID,
+					Ins:          []*avax.TransferableInput{generateTestInFromUTXO(utxo, sigIndices)},
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(avaxAssetID, defaultCaminoBalance-defaultTxFee, feeOwners, ids.Empty, ids.Empty),
+					},
+				}},
+				ProposalID:   proposalID,
+				OptionIndex:  tt.optionIndex,
+				VoterAddress: voterAddr,
+				VoterAuth:    &secp256k1fx.Input{SigIndices: sigIndices},
+			}
+
+			tx, err := txs.NewSigned(utx, txs.Codec, tt.signers)
+			require.NoError(t, err)
+
+			onAcceptState, err := state.NewCaminoDiff(lastAcceptedID, env)
+			require.NoError(t, err)
+
+			executor := CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   onAcceptState,
+					Tx:      tx,
+				},
+			}
+
+			err = tx.Unsigned.Visit(&executor)
+			require.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedProposal != nil {
+				proposalState, err := onAcceptState.GetProposal(proposalID)
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedProposal(), proposalState)
+			}
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorFinishProposalsTx(t *testing.T) {
+	currentTime := time.Now().Truncate(time.Second)
+	bondAmount := 100 * units.Avax
+
+	proposerKey, err := testKeyfactory.NewPrivateKey()
+	require.NoError(t, err)
+	outputOwners := secp256k1fx.OutputOwners{
+		Locktime:  0,
+		Threshold: 1,
+		Addrs:     []ids.ShortID{proposerKey.PublicKey().Address()},
+	}
+
+	newProposalTx := func(proposal dao.Proposal) *txs.Tx {
+		tx, err := txs.NewSigned(&txs.AddProposalTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    testNetworkID,
+				BlockchainID: constants.PlatformChainID,
+				Outs: []*avax.TransferableOutput{
+					generateTestOut(avaxAssetID, bondAmount, outputOwners, ids.Empty, locked.ThisTxID),
+				},
+			}},
+			Proposal: proposal,
+		}, txs.Codec, nil)
+		require.NoError(t, err)
+		return tx
+	}
+
+	voter1, voter2 := ids.ShortID{1}, ids.ShortID{2}
+	newBaseFee := defaultTxFee * 3
+
+	endedProposalTx := newProposalTx(&dao.BaseFeeProposal{
+		Start:   uint64(currentTime.Add(-2 * time.Hour).Unix()),
+		End:     uint64(currentTime.Unix()),
+		Options: []uint64{defaultTxFee * 2, newBaseFee},
+	})
+	endedProposalID := endedProposalTx.ID()
+	endedProposal := dao.NewProposalState(endedProposalID, endedProposalTx.Unsigned.(*txs.AddProposalTx).Proposal)
+	endedProposal, err = endedProposal.AddVote(voter1, 1)
+	require.NoError(t, err)
+	endedProposal, err = endedProposal.AddVote(voter2, 1)
+	require.NoError(t, err)
+
+	activeProposalTx := newProposalTx(&dao.BaseFeeProposal{
+		Start:   uint64(currentTime.Add(-2 * time.Hour).Unix()),
+		End:     uint64(currentTime.Add(time.Hour).Unix()),
+		Options: []uint64{defaultTxFee * 2},
+	})
+	activeProposalID := activeProposalTx.ID()
+	activeProposal := dao.NewProposalState(activeProposalID, activeProposalTx.Unsigned.(*txs.AddProposalTx).Proposal)
+
+	tests := map[string]struct {
+		quorum          uint64
+		proposalIDs     []ids.ID
+		expectedErr     error
+		expectedBaseFee uint64
+	}{
+		"Active proposal": {
+			proposalIDs: []ids.ID{endedProposalID, activeProposalID},
+			expectedErr: errWrongProposalsToFinish,
+		},
+		"OK: quorum isn't reached": {
+			quorum:          3,
+			proposalIDs:     []ids.ID{endedProposalID},
+			expectedBaseFee: defaultTxFee,
+		},
+		"OK": {
+			quorum:          2,
+			proposalIDs:     []ids.ID{endedProposalID},
+			expectedBaseFee: newBaseFee,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newCaminoEnvironment( /*postBanff*/ true, api.Camino{
+				LockModeBondDeposit:   true,
+				DaoProposalBondAmount: bondAmount,
+				DaoProposalQuorum:     tt.quorum,
+			})
+			env.ctx.Lock.Lock()
+			defer func() {
+				require.NoError(t, shutdownCaminoEnvironment(env))
+			}()
+
+			env.config.BanffTime = env.state.GetTimestamp()
+			env.state.SetTimestamp(currentTime)
+			for _, proposalTx := range []*txs.Tx{endedProposalTx, activeProposalTx} {
+				env.state.AddTx(proposalTx, status.Committed)
+				env.state.AddUTXO(generateTestUTXO(proposalTx.ID(), avaxAssetID, bondAmount, outputOwners, ids.Empty, proposalTx.ID()))
+			}
+			env.state.SetProposal(endedProposal)
+			env.state.SetProposal(activeProposal)
+			require.NoError(t, env.state.Commit())
+
+			tx, err := env.txBuilder.NewFinishProposalsTx(tt.proposalIDs)
+			require.NoError(t, err)
+
+			onAcceptState, err := state.NewCaminoDiff(lastAcceptedID, env)
+			require.NoError(t, err)
+
+			executor := CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   onAcceptState,
+					Tx:      tx,
+				},
+			}
+
+			err = tx.Unsigned.Visit(&executor)
+			require.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr == nil {
+				_, err := onAcceptState.GetProposal(endedProposalID)
+				require.ErrorIs(t, err, database.ErrNotFound)
+				_, err = onAcceptState.GetProposal(activeProposalID)
+				require.NoError(t, err)
+				baseFee, err := onAcceptState.GetBaseFee()
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedBaseFee, baseFee)
+			}
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorMultisigAliasTx(t *testing.T) {
+	feeKey, err := testKeyfactory.NewPrivateKey()
+	require.NoError(t, err)
+	ownerKey1, err := testKeyfactory.NewPrivateKey()
+	require.NoError(t, err)
+	ownerKey2, err := testKeyfactory.NewPrivateKey()
+	require.NoError(t, err)
+	feeOwners := secp256k1fx.OutputOwners{
+		Locktime:  0,
+		Threshold: 1,
+		Addrs:     []ids.ShortID{feeKey.PublicKey().Address()},
+	}
+	ownerAddr1 := ownerKey1.PublicKey().Address()
+	ownerAddr2 := ownerKey2.PublicKey().Address()
+	ownerAddrs := []ids.ShortID{ownerAddr1, ownerAddr2}
+	utils.Sort(ownerAddrs)
+
+	existingAlias := &state.MultisigOwner{
+		Alias: ids.ShortID{1, 1, 1},
+		Owners: secp256k1fx.OutputOwners{
+			Threshold: 2,
+			Addrs:     ownerAddrs,
+		},
+	}
+	newOwnerAddr := ids.ShortID{2, 2, 2}
+
+	feeSigner := []*crypto.PrivateKeySECP256K1R{feeKey.(*crypto.PrivateKeySECP256K1R)}
+
+	tests := map[string]struct {
+		beforeAthensPhase bool
+		alias             ids.ShortID
+		threshold         uint32
+		addresses         []ids.ShortID
+		aliasSigners      []*crypto.PrivateKeySECP256K1R
+		expectedErr       error
+	}{
+		"Not AthensPhase": {
+			beforeAthensPhase: true,
+			threshold:         1,
+			addresses:         ownerAddrs,
+			expectedErr:       errNotAthensPhase,
+		},
+		"Update not existing alias": {
+			alias:        ids.ShortID{3, 3, 3},
+			threshold:    1,
+			addresses:    []ids.ShortID{newOwnerAddr},
+			aliasSigners: []*crypto.PrivateKeySECP256K1R{ownerKey1.(*crypto.PrivateKeySECP256K1R)},
+			expectedErr:  database.ErrNotFound,
+		},
+		"Update without enough owners signatures": {
+			alias:        existingAlias.Alias,
+			threshold:    1,
+			addresses:    []ids.ShortID{newOwnerAddr},
+			aliasSigners: []*crypto.PrivateKeySECP256K1R{ownerKey1.(*crypto.PrivateKeySECP256K1R)},
+			expectedErr:  errMultisigAliasSigMissing,
+		},
+		"Nested alias": {
+			threshold:   1,
+			addresses:   []ids.ShortID{existingAlias.Alias},
+			expectedErr: errNestedMultisigAlias,
+		},
+		"OK: create alias": {
+			threshold: 1,
+			addresses: ownerAddrs,
+		},
+		"OK: update alias": {
+			alias:     existingAlias.Alias,
+			threshold: 1,
+			addresses: []ids.ShortID{newOwnerAddr},
+			aliasSigners: []*crypto.PrivateKeySECP256K1R{
+				ownerKey1.(*crypto.PrivateKeySECP256K1R),
+				ownerKey2.(*crypto.PrivateKeySECP256K1R),
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newCaminoEnvironment( /*postBanff*/ true, api.Camino{})
+			env.ctx.Lock.Lock()
+			defer func() {
+				require.NoError(t, shutdownCaminoEnvironment(env))
+			}()
+
+			env.config.BanffTime = env.state.GetTimestamp()
+			if tt.beforeAthensPhase {
+				env.config.AthensPhaseTime = env.state.GetTimestamp().Add(time.Second)
+			}
+			env.state.SetMultisigOwner(existingAlias)
+			utxo := generateTestUTXO(ids.ID{1}, avaxAssetID, defaultCaminoBalance, feeOwners, ids.Empty, ids.Empty)
+			env.state.AddUTXO(utxo)
+			require.NoError(t, env.state.Commit())
+
+			utx := &txs.MultisigAliasTx{
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    env.ctx.NetworkID,
+					BlockchainID: env.ctx.ChainID,
+					Ins:          []*avax.TransferableInput{generateTestInFromUTXO(utxo, []uint32{0})},
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(avaxAssetID, defaultCaminoBalance-defaultTxFee, feeOwners, ids.Empty, ids.Empty),
+					},
+				}},
+				Alias:     tt.alias,
+				Threshold: tt.threshold,
+				Addresses: tt.addresses,
+				AliasAuth: &secp256k1fx.Input{},
+			}
+			signers := [][]*crypto.PrivateKeySECP256K1R{feeSigner}
+			if tt.alias != ids.ShortEmpty {
+				aliasAuth, aliasSigners := generateMultisigAliasAuth(existingAlias.Owners.Addrs, tt.aliasSigners)
+				utx.AliasAuth = aliasAuth
+				signers = append(signers, aliasSigners)
+			}
+
+			tx, err := txs.NewSigned(utx, txs.Codec, signers)
+			require.NoError(t, err)
+
+			onAcceptState, err := state.NewCaminoDiff(lastAcceptedID, env)
+			require.NoError(t, err)
+
+			executor := CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   onAcceptState,
+					Tx:      tx,
+				},
+			}
+
+			err = tx.Unsigned.Visit(&executor)
+			require.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr == nil {
+				aliasID := tt.alias
+				if aliasID == ids.ShortEmpty {
+					alias, err := genesis.NewMultisigAlias(tx.ID(), tt.addresses, tt.threshold)
+					require.NoError(t, err)
+					aliasID = alias.Alias
+				}
+				aliasOwner, err := onAcceptState.GetMultisigOwner(aliasID)
+				require.NoError(t, err)
+				require.Equal(t, &state.MultisigOwner{
+					Alias: aliasID,
+					Owners: secp256k1fx.OutputOwners{
+						Threshold: tt.threshold,
+						Addrs:     tt.addresses,
+					},
+				}, aliasOwner)
+			}
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorMultisigAliasPolicyTx(t *testing.T) {
+	feeKey, err := testKeyfactory.NewPrivateKey()
+	require.NoError(t, err)
+	ownerKey1, err := testKeyfactory.NewPrivateKey()
+	require.NoError(t, err)
+	ownerKey2, err := testKeyfactory.NewPrivateKey()
+	require.NoError(t, err)
+	feeOwners := secp256k1fx.OutputOwners{
+		Locktime:  0,
+		Threshold: 1,
+		Addrs:     []ids.ShortID{feeKey.PublicKey().Address()},
+	}
+	ownerAddrs := []ids.ShortID{ownerKey1.PublicKey().Address(), ownerKey2.PublicKey().Address()}
+	utils.Sort(ownerAddrs)
+
+	existingAlias := &state.MultisigOwner{
+		Alias: ids.ShortID{1, 1, 1},
+		Owners: secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     ownerAddrs,
+		},
+	}
+
+	feeSigner := []*crypto.PrivateKeySECP256K1R{feeKey.(*crypto.PrivateKeySECP256K1R)}
+	oneOwnerSigner := []*crypto.PrivateKeySECP256K1R{ownerKey1.(*crypto.PrivateKeySECP256K1R)}
+	twoOwnersSigners := []*crypto.PrivateKeySECP256K1R{
+		ownerKey1.(*crypto.PrivateKeySECP256K1R),
+		ownerKey2.(*crypto.PrivateKeySECP256K1R),
+	}
+
+	tests := map[string]struct {
+		beforeAthensPhase bool
+		existingPolicy    *state.MultisigPolicy
+		utx               txs.MultisigAliasPolicyTx
+		aliasSigners      []*crypto.PrivateKeySECP256K1R
+		expectedPolicy    *state.MultisigPolicy
+		expectedErr       error
+	}{
+		"Not AthensPhase": {
+			beforeAthensPhase: true,
+			utx: txs.MultisigAliasPolicyTx{
+				Alias:      existingAlias.Alias,
+				DailyLimit: 100,
+			},
+			aliasSigners: oneOwnerSigner,
+			expectedErr:  errNotAthensPhase,
+		},
+		"Not existing alias": {
+			utx: txs.MultisigAliasPolicyTx{
+				Alias:      ids.ShortID{3, 3, 3},
+				DailyLimit: 100,
+			},
+			aliasSigners: oneOwnerSigner,
+			expectedErr:  database.ErrNotFound,
+		},
+		"Not enough signatures for policy large threshold": {
+			existingPolicy: &state.MultisigPolicy{
+				Alias:          existingAlias.Alias,
+				LargeAmount:    100,
+				LargeThreshold: 2,
+			},
+			utx: txs.MultisigAliasPolicyTx{
+				Alias:      existingAlias.Alias,
+				DailyLimit: 100,
+			},
+			aliasSigners: oneOwnerSigner,
+			expectedErr:  errMultisigAliasSigMissing,
+		},
+		"Large threshold is greater than number of owners": {
+			utx: txs.MultisigAliasPolicyTx{
+				Alias:          existingAlias.Alias,
+				LargeAmount:    100,
+				LargeThreshold: 3,
+			},
+			aliasSigners: oneOwnerSigner,
+			expectedErr:  errMultisigThresholdTooBig,
+		},
+		"OK: set policy": {
+			utx: txs.MultisigAliasPolicyTx{
+				Alias:          existingAlias.Alias,
+				DailyLimit:     1000,
+				LargeAmount:    100,
+				LargeThreshold: 2,
+				LargeDelay:     10,
+			},
+			aliasSigners: oneOwnerSigner,
+			expectedPolicy: &state.MultisigPolicy{
+				Alias:          existingAlias.Alias,
+				DailyLimit:     1000,
+				LargeAmount:    100,
+				LargeThreshold: 2,
+				LargeDelay:     10,
+			},
+		},
+		"OK: update policy, spendings are preserved": {
+			existingPolicy: &state.MultisigPolicy{
+				Alias:          existingAlias.Alias,
+				DailyLimit:     1000,
+				LargeAmount:    100,
+				LargeThreshold: 2,
+				PeriodStart:    5,
+				Spent:          50,
+			},
+			utx: txs.MultisigAliasPolicyTx{
+				Alias:      existingAlias.Alias,
+				DailyLimit: 500,
+			},
+			aliasSigners: twoOwnersSigners,
+			expectedPolicy: &state.MultisigPolicy{
+				Alias:       existingAlias.Alias,
+				DailyLimit:  500,
+				PeriodStart: 5,
+				Spent:       50,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newCaminoEnvironment( /*postBanff*/ true, api.Camino{})
+			env.ctx.Lock.Lock()
+			defer func() {
+				require.NoError(t, shutdownCaminoEnvironment(env))
+			}()
+
+			env.config.BanffTime = env.state.GetTimestamp()
+			if tt.beforeAthensPhase {
+				env.config.AthensPhaseTime = env.state.GetTimestamp().Add(time.Second)
+			}
+			env.state.SetMultisigOwner(existingAlias)
+			if tt.existingPolicy != nil {
+				env.state.SetMultisigPolicy(tt.existingPolicy)
+			}
+			utxo := generateTestUTXO(ids.ID{1}, avaxAssetID, defaultCaminoBalance, feeOwners, ids.Empty, ids.Empty)
+			env.state.AddUTXO(utxo)
+			require.NoError(t, env.state.Commit())
+
+			utx := tt.utx
+			utx.BaseTx = txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    env.ctx.NetworkID,
+				BlockchainID: env.ctx.ChainID,
+				Ins:          []*avax.TransferableInput{generateTestInFromUTXO(utxo, []uint32{0})},
+				Outs: []*avax.TransferableOutput{
+					generateTestOut(avaxAssetID, defaultCaminoBalance-defaultTxFee, feeOwners, ids.Empty, ids.Empty),
+				},
+			}}
+			aliasAuth, aliasSigners := generateMultisigAliasAuth(existingAlias.Owners.Addrs, tt.aliasSigners)
+			utx.AliasAuth = aliasAuth
+
+			tx, err := txs.NewSigned(&utx, txs.Codec, [][]*crypto.PrivateKeySECP256K1R{feeSigner, aliasSigners})
+			require.NoError(t, err)
+
+			onAcceptState, err := state.NewCaminoDiff(lastAcceptedID, env)
+			require.NoError(t, err)
+
+			executor := CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   onAcceptState,
+					Tx:      tx,
+				},
+			}
+
+			err = tx.Unsigned.Visit(&executor)
+			require.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr == nil {
+				policy, err := onAcceptState.GetMultisigPolicy(tt.utx.Alias)
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedPolicy, policy)
+			}
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorMultisigAliasSpendingPolicy(t *testing.T) {
+	ownerKey1, err := testKeyfactory.NewPrivateKey()
+	require.NoError(t, err)
+	ownerKey2, err := testKeyfactory.NewPrivateKey()
+	require.NoError(t, err)
+	ownerKeys := []*crypto.PrivateKeySECP256K1R{
+		ownerKey1.(*crypto.PrivateKeySECP256K1R),
+		ownerKey2.(*crypto.PrivateKeySECP256K1R),
+	}
+	if ownerKeys[1].PublicKey().Address().Less(ownerKeys[0].PublicKey().Address()) {
+		ownerKeys[0], ownerKeys[1] = ownerKeys[1], ownerKeys[0]
+	}
+	ownerAddrs := []ids.ShortID{ownerKeys[0].PublicKey().Address(), ownerKeys[1].PublicKey().Address()}
+
+	alias := &state.MultisigOwner{
+		Alias: ids.ShortID{1, 1, 1},
+		Owners: secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     ownerAddrs,
+		},
+	}
+	aliasOwners := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{alias.Alias},
+	}
+	recipientOwners := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{{2, 2, 2}},
+	}
+
+	const (
+		dailyLimit  = 1000
+		largeAmount = 500
+		largeDelay  = 1000
+	)
+
+	tests := map[string]struct {
+		spent              uint64
+		spentInPreviousDay bool
+		sent               uint64
+		locktime           uint64 // relative to chain time
+		sigIndices         []uint32
+		sharedWithAlias    bool
+		expectedSpent      uint64
+		expectedErr        error
+	}{
+		"OK: small spending": {
+			spent:         200,
+			sent:          100,
+			sigIndices:    []uint32{0},
+			expectedSpent: 400,
+		},
+		"Daily limit exceeded": {
+			spent:       800,
+			sent:        200,
+			sigIndices:  []uint32{0},
+			expectedErr: errFlowCheckFailed,
+		},
+		"OK: spendings of previous day are ignored": {
+			spent:              900,
+			spentInPreviousDay: true,
+			sent:               200,
+			sigIndices:         []uint32{0},
+			expectedSpent:      300,
+		},
+		"Large spending without large threshold signatures": {
+			spent:       200,
+			sent:        500,
+			locktime:    largeDelay,
+			sigIndices:  []uint32{0},
+			expectedErr: errFlowCheckFailed,
+		},
+		"Large spending isn't timelocked": {
+			spent:       200,
+			sent:        500,
+			locktime:    largeDelay - 1,
+			sigIndices:  []uint32{0, 1},
+			expectedErr: errFlowCheckFailed,
+		},
+		"OK: large spending": {
+			spent:         200,
+			sent:          500,
+			locktime:      largeDelay,
+			sigIndices:    []uint32{0, 1},
+			expectedSpent: 800,
+		},
+		"Spending, that is large together with spendings of current day, without large threshold signatures": {
+			spent:       400,
+			sent:        100,
+			locktime:    largeDelay,
+			sigIndices:  []uint32{0},
+			expectedErr: errFlowCheckFailed,
+		},
+		"Spending, that is large together with spendings of current day, isn't timelocked": {
+			spent:       400,
+			sent:        100,
+			sigIndices:  []uint32{0, 1},
+			expectedErr: errFlowCheckFailed,
+		},
+		"OK: spending, that is large together with spendings of current day": {
+			spent:         400,
+			sent:          100,
+			locktime:      largeDelay,
+			sigIndices:    []uint32{0, 1},
+			expectedSpent: 600,
+		},
+		"Output shared with alias": {
+			spent:           200,
+			sent:            100,
+			sigIndices:      []uint32{0},
+			sharedWithAlias: true,
+			expectedErr:     errFlowCheckFailed,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newCaminoEnvironment( /*postBanff*/ true, api.Camino{})
+			env.ctx.Lock.Lock()
+			defer func() {
+				require.NoError(t, shutdownCaminoEnvironment(env))
+			}()
+
+			env.config.BanffTime = env.state.GetTimestamp()
+			chainTime := uint64(env.state.GetTimestamp().Unix())
+			periodStart := chainTime - chainTime%state.MultisigPolicyPeriod
+			if tt.spentInPreviousDay {
+				periodStart -= state.MultisigPolicyPeriod
+			}
+
+			env.state.SetMultisigOwner(alias)
+			env.state.SetMultisigPolicy(&state.MultisigPolicy{
+				Alias:          alias.Alias,
+				DailyLimit:     dailyLimit,
+				LargeAmount:    largeAmount,
+				LargeThreshold: 2,
+				LargeDelay:     largeDelay,
+				PeriodStart:    periodStart,
+				Spent:          tt.spent,
+			})
+			utxo := generateTestUTXO(ids.ID{1}, avaxAssetID, defaultCaminoBalance, aliasOwners, ids.Empty, ids.Empty)
+			env.state.AddUTXO(utxo)
+			require.NoError(t, env.state.Commit())
+
+			recipientOwners := recipientOwners
+			if tt.locktime != 0 {
+				recipientOwners.Locktime = chainTime + tt.locktime
+			}
+			if tt.sharedWithAlias {
+				recipientOwners.Addrs = []ids.ShortID{alias.Alias, recipientOwners.Addrs[0]}
+				utils.Sort(recipientOwners.Addrs)
+			}
+
+			utx := &txs.MultisigAliasTx{
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    env.ctx.NetworkID,
+					BlockchainID: env.ctx.ChainID,
+					Ins:          []*avax.TransferableInput{generateTestInFromUTXO(utxo, tt.sigIndices)},
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(avaxAssetID, tt.sent, recipientOwners, ids.Empty, ids.Empty),
+						generateTestOut(avaxAssetID, defaultCaminoBalance-tt.sent-defaultTxFee, aliasOwners, ids.Empty, ids.Empty),
+					},
+				}},
+				Threshold: 1,
+				Addresses: []ids.ShortID{{3, 3, 3}},
+				AliasAuth: &secp256k1fx.Input{},
+			}
+			avax.SortTransferableOutputs(utx.Outs, txs.Codec)
+
+			signers := make([]*crypto.PrivateKeySECP256K1R, len(tt.sigIndices))
+			for i, sigIndex := range tt.sigIndices {
+				signers[i] = ownerKeys[sigIndex]
+			}
+			tx, err := txs.NewSigned(utx, txs.Codec, [][]*crypto.PrivateKeySECP256K1R{signers})
+			require.NoError(t, err)
+
+			onAcceptState, err := state.NewCaminoDiff(lastAcceptedID, env)
+			require.NoError(t, err)
+
+			executor := CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   onAcceptState,
+					Tx:      tx,
+				},
+			}
+
+			err = tx.Unsigned.Visit(&executor)
+			require.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr == nil {
+				policy, err := onAcceptState.GetMultisigPolicy(alias.Alias)
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedSpent, policy.SpentAt(chainTime))
+			}
+		})
+	}
+}
+
+// generateMultisigAliasAuth returns alias auth with indices of [signers]
+// addresses in [owners] and [signers] ordered according to those indices
+func generateMultisigAliasAuth(
+	owners []ids.ShortID,
+	signers []*crypto.PrivateKeySECP256K1R,
+) (*secp256k1fx.Input, []*crypto.PrivateKeySECP256K1R) {
+	auth := &secp256k1fx.Input{}
+	orderedSigners := []*crypto.PrivateKeySECP256K1R{}
+	for i, owner := range owners {
+		for _, signer := range signers {
+			if signer.PublicKey().Address() == owner {
+				auth.SigIndices = append(auth.SigIndices, uint32(i))
+				orderedSigners = append(orderedSigners, signer)
+			}
+		}
+	}
+	return auth, orderedSigners
+}
@@ -25,6 +25,38 @@ func (*StandardTxExecutor) RegisterNodeTx(*txs.RegisterNodeTx) error {
 	return errWrongTxType
 }
 
+func (*StandardTxExecutor) AddDepositOfferTx(*txs.AddDepositOfferTx) error {
+	return errWrongTxType
+}
+
+func (*StandardTxExecutor) ClaimTx(*txs.ClaimTx) error {
+	return errWrongTxType
+}
+
+func (*StandardTxExecutor) AddProposalTx(*txs.AddProposalTx) error {
+	return errWrongTxType
+}
+
+func (*StandardTxExecutor) AddVoteTx(*txs.AddVoteTx) error {
+	return errWrongTxType
+}
+
+func (*StandardTxExecutor) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	return errWrongTxType
+}
+
+func (*StandardTxExecutor) MultisigAliasTx(*txs.MultisigAliasTx) error {
+	return errWrongTxType
+}
+
+func (*StandardTxExecutor) MultisigAliasPolicyTx(*txs.MultisigAliasPolicyTx) error {
+	return errWrongTxType
+}
+
+func (*StandardTxExecutor) SetKycExpirationTx(*txs.SetKycExpirationTx) error {
+	return errWrongTxType
+}
+
 // Proposal
 
 func (*ProposalTxExecutor) AddAddressStateTx(*txs.AddAddressStateTx) error {
@@ -43,6 +75,38 @@ func (*ProposalTxExecutor) RegisterNodeTx(*txs.RegisterNodeTx) error {
 	return errWrongTxType
 }
 
+func (*ProposalTxExecutor) AddDepositOfferTx(*txs.AddDepositOfferTx) error {
+	return errWrongTxType
+}
+
+func (*ProposalTxExecutor) ClaimTx(*txs.ClaimTx) error {
+	return errWrongTxType
+}
+
+func (*ProposalTxExecutor) AddProposalTx(*txs.AddProposalTx) error {
+	return errWrongTxType
+}
+
+func (*ProposalTxExecutor) AddVoteTx(*txs.AddVoteTx) error {
+	return errWrongTxType
+}
+
+func (*ProposalTxExecutor) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	return errWrongTxType
+}
+
+func (*ProposalTxExecutor) MultisigAliasTx(*txs.MultisigAliasTx) error {
+	return errWrongTxType
+}
+
+func (*ProposalTxExecutor) MultisigAliasPolicyTx(*txs.MultisigAliasPolicyTx) error {
+	return errWrongTxType
+}
+
+func (*ProposalTxExecutor) SetKycExpirationTx(*txs.SetKycExpirationTx) error {
+	return errWrongTxType
+}
+
 // Atomic
 
 func (*AtomicTxExecutor) AddAddressStateTx(*txs.AddAddressStateTx) error {
@@ -61,6 +125,38 @@ func (*AtomicTxExecutor) RegisterNodeTx(*txs.RegisterNodeTx) error {
 	return errWrongTxType
 }
 
+func (*AtomicTxExecutor) AddDepositOfferTx(*txs.AddDepositOfferTx) error {
+	return errWrongTxType
+}
+
+func (*AtomicTxExecutor) ClaimTx(*txs.ClaimTx) error {
+	return errWrongTxType
+}
+
+func (*AtomicTxExecutor) AddProposalTx(*txs.AddProposalTx) error {
+	return errWrongTxType
+}
+
+func (*AtomicTxExecutor) AddVoteTx(*txs.AddVoteTx) error {
+	return errWrongTxType
+}
+
+func (*AtomicTxExecutor) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	return errWrongTxType
+}
+
+func (*AtomicTxExecutor) MultisigAliasTx(*txs.MultisigAliasTx) error {
+	return errWrongTxType
+}
+
+func (*AtomicTxExecutor) MultisigAliasPolicyTx(*txs.MultisigAliasPolicyTx) error {
+	return errWrongTxType
+}
+
+func (*AtomicTxExecutor) SetKycExpirationTx(*txs.SetKycExpirationTx) error {
+	return errWrongTxType
+}
+
 // MemPool
 
 func (v *MempoolTxVerifier) AddAddressStateTx(tx *txs.AddAddressStateTx) error {
@@ -78,3 +174,35 @@ func (v *MempoolTxVerifier) UnlockDepositTx(tx *txs.UnlockDepositTx) error {
 func (v *MempoolTxVerifier) RegisterNodeTx(tx *txs.RegisterNodeTx) error {
 	return v.standardTx(tx)
 }
+
+func (v *MempoolTxVerifier) AddDepositOfferTx(tx *txs.AddDepositOfferTx) error {
+	return v.standardTx(tx)
+}
+
+func (v *MempoolTxVerifier) ClaimTx(tx *txs.ClaimTx) error {
+	return v.standardTx(tx)
+}
+
+func (v *MempoolTxVerifier) AddProposalTx(tx *txs.AddProposalTx) error {
+	return v.standardTx(tx)
+}
+
+func (v *MempoolTxVerifier) AddVoteTx(tx *txs.AddVoteTx) error {
+	return v.standardTx(tx)
+}
+
+func (*MempoolTxVerifier) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	return errWrongTxType
+}
+
+func (v *MempoolTxVerifier) MultisigAliasTx(tx *txs.MultisigAliasTx) error {
+	return v.standardTx(tx)
+}
+
+func (v *MempoolTxVerifier) MultisigAliasPolicyTx(tx *txs.MultisigAliasPolicyTx) error {
+	return v.standardTx(tx)
+}
+
+func (v *MempoolTxVerifier) SetKycExpirationTx(tx *txs.SetKycExpirationTx) error {
+	return v.standardTx(tx)
+}
@@ -129,7 +129,7 @@ func newEnvironment(postBanff bool) *environment {
 
 	atomicUTXOs := avax.NewAtomicUTXOManager(ctx.SharedMemory, txs.Codec)
 	uptimes := uptime.NewManager(baseState)
-	utxoHandler := utxo.NewHandler(ctx, &clk, baseState, fx)
+	utxoHandler := utxo.NewHandler(ctx, &clk, baseState, fx, &config)
 
 	txBuilder := builder.New(
 		ctx,
@@ -122,6 +122,9 @@ func (e *ProposalTxExecutor) AddValidatorTx(tx *txs.AddValidatorTx) error {
 	txID := e.Tx.ID()
 
 	// Set up the state if this tx is committed
+	if err := spendMultisigPolicies(e.Backend, e.OnCommitState, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
 	// Consume the UTXOs
 	utxo.Consume(e.OnCommitState, tx.Ins)
 	// Produce the UTXOs
@@ -135,6 +138,9 @@ func (e *ProposalTxExecutor) AddValidatorTx(tx *txs.AddValidatorTx) error {
 	e.OnCommitState.PutPendingValidator(newStaker)
 
 	// Set up the state if this tx is aborted
+	if err := spendMultisigPolicies(e.Backend, e.OnAbortState, tx.Ins, onAbortOuts); err != nil {
+		return err
+	}
 	// Consume the UTXOs
 	utxo.Consume(e.OnAbortState, tx.Ins)
 	// Produce the UTXOs
@@ -170,6 +176,9 @@ func (e *ProposalTxExecutor) AddSubnetValidatorTx(tx *txs.AddSubnetValidatorTx)
 	txID := e.Tx.ID()
 
 	// Set up the state if this tx is committed
+	if err := spendMultisigPolicies(e.Backend, e.OnCommitState, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
 	// Consume the UTXOs
 	utxo.Consume(e.OnCommitState, tx.Ins)
 	// Produce the UTXOs
@@ -183,6 +192,9 @@ func (e *ProposalTxExecutor) AddSubnetValidatorTx(tx *txs.AddSubnetValidatorTx)
 	e.OnCommitState.PutPendingValidator(newStaker)
 
 	// Set up the state if this tx is aborted
+	if err := spendMultisigPolicies(e.Backend, e.OnAbortState, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
 	// Consume the UTXOs
 	utxo.Consume(e.OnAbortState, tx.Ins)
 	// Produce the UTXOs
@@ -219,6 +231,9 @@ func (e *ProposalTxExecutor) AddDelegatorTx(tx *txs.AddDelegatorTx) error {
 	txID := e.Tx.ID()
 
 	// Set up the state if this tx is committed
+	if err := spendMultisigPolicies(e.Backend, e.OnCommitState, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
 	// Consume the UTXOs
 	utxo.Consume(e.OnCommitState, tx.Ins)
 	// Produce the UTXOs
@@ -232,6 +247,9 @@ func (e *ProposalTxExecutor) AddDelegatorTx(tx *txs.AddDelegatorTx) error {
 	e.OnCommitState.PutPendingDelegator(newStaker)
 
 	// Set up the state if this tx is aborted
+	if err := spendMultisigPolicies(e.Backend, e.OnAbortState, tx.Ins, onAbortOuts); err != nil {
+		return err
+	}
 	// Consume the UTXOs
 	utxo.Consume(e.OnAbortState, tx.Ins)
 	// Produce the UTXOs
@@ -570,9 +588,9 @@ func (e *ProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error
 	return nil
 }
 
-// GetNextStakerChangeTime returns the next time a staker will be either added
+// getNextStakerChangeTime returns the next time a staker will be either added
 // or removed to/from the current validator set.
-func GetNextStakerChangeTime(state state.Chain) (time.Time, error) {
+func getNextStakerChangeTime(state state.Chain) (time.Time, error) {
 	currentStakerIterator, err := state.GetCurrentStakerIterator()
 	if err != nil {
 		return time.Time{}, err
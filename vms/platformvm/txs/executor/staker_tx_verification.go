@@ -115,6 +115,11 @@ func verifyAddValidatorTx(
 		)
 	}
 
+	txFee, err := scaledFee(backend.Config, chainState, backend.Config.AddPrimaryNetworkValidatorFee)
+	if err != nil {
+		return nil, err
+	}
+
 	// Verify the flowcheck
 	if err := backend.FlowChecker.VerifySpend(
 		tx,
@@ -123,7 +128,7 @@ func verifyAddValidatorTx(
 		outs,
 		sTx.Creds,
 		map[ids.ID]uint64{
-			backend.Ctx.AVAXAssetID: backend.Config.AddPrimaryNetworkValidatorFee,
+			backend.Ctx.AVAXAssetID: txFee,
 		},
 	); err != nil {
 		return nil, fmt.Errorf("%w: %s", errFlowCheckFailed, err)
@@ -214,6 +219,11 @@ func verifyAddSubnetValidatorTx(
 		return err
 	}
 
+	txFee, err := scaledFee(backend.Config, chainState, backend.Config.AddSubnetValidatorFee)
+	if err != nil {
+		return err
+	}
+
 	// Verify the flowcheck
 	if err := backend.FlowChecker.VerifySpend(
 		tx,
@@ -222,7 +232,7 @@ func verifyAddSubnetValidatorTx(
 		tx.Outs,
 		baseTxCreds,
 		map[ids.ID]uint64{
-			backend.Ctx.AVAXAssetID: backend.Config.AddSubnetValidatorFee,
+			backend.Ctx.AVAXAssetID: txFee,
 		},
 	); err != nil {
 		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
@@ -289,6 +299,11 @@ func removeSubnetValidatorValidation(
 		return nil, false, err
 	}
 
+	baseFee, err := chainState.GetBaseFee()
+	if err != nil {
+		return nil, false, err
+	}
+
 	// Verify the flowcheck
 	if err := backend.FlowChecker.VerifySpend(
 		tx,
@@ -297,7 +312,7 @@ func removeSubnetValidatorValidation(
 		tx.Outs,
 		baseTxCreds,
 		map[ids.ID]uint64{
-			backend.Ctx.AVAXAssetID: backend.Config.TxFee,
+			backend.Ctx.AVAXAssetID: baseFee,
 		},
 	); err != nil {
 		return nil, false, fmt.Errorf("%w: %s", errFlowCheckFailed, err)
@@ -390,6 +405,11 @@ func verifyAddDelegatorTx(
 		return nil, errOverDelegated
 	}
 
+	txFee, err := scaledFee(backend.Config, chainState, backend.Config.AddPrimaryNetworkDelegatorFee)
+	if err != nil {
+		return nil, err
+	}
+
 	// Verify the flowcheck
 	if err := backend.FlowChecker.VerifySpend(
 		tx,
@@ -398,7 +418,7 @@ func verifyAddDelegatorTx(
 		outs,
 		sTx.Creds,
 		map[ids.ID]uint64{
-			backend.Ctx.AVAXAssetID: backend.Config.AddPrimaryNetworkDelegatorFee,
+			backend.Ctx.AVAXAssetID: txFee,
 		},
 	); err != nil {
 		return nil, fmt.Errorf("%w: %s", errFlowCheckFailed, err)
@@ -521,6 +541,11 @@ func verifyAddPermissionlessValidatorTx(
 		txFee = backend.Config.AddPrimaryNetworkValidatorFee
 	}
 
+	txFee, err = scaledFee(backend.Config, chainState, txFee)
+	if err != nil {
+		return err
+	}
+
 	outs := make([]*avax.TransferableOutput, len(tx.Outs)+len(tx.StakeOuts))
 	copy(outs, tx.Outs)
 	copy(outs[len(tx.Outs):], tx.StakeOuts)
@@ -706,6 +731,11 @@ func verifyAddPermissionlessDelegatorTx(
 		txFee = backend.Config.AddPrimaryNetworkDelegatorFee
 	}
 
+	txFee, err = scaledFee(backend.Config, chainState, txFee)
+	if err != nil {
+		return err
+	}
+
 	// Verify the flowcheck
 	if err := backend.FlowChecker.VerifySpend(
 		tx,
@@ -421,6 +421,7 @@ func TestVerifyAddPermissionlessValidatorTx(t *testing.T) {
 					EndTime:   verifiedTx.EndTime(),
 				}
 				mockState.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, verifiedTx.NodeID()).Return(primaryNetworkVdr, nil)
+				mockState.EXPECT().GetBaseFee().Return(uint64(0), nil)
 				return mockState
 			},
 			sTxF: func() *txs.Tx {
@@ -467,6 +468,7 @@ func TestVerifyAddPermissionlessValidatorTx(t *testing.T) {
 					EndTime:   mockable.MaxTime,
 				}
 				mockState.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, verifiedTx.NodeID()).Return(primaryNetworkVdr, nil)
+				mockState.EXPECT().GetBaseFee().Return(uint64(0), nil)
 				return mockState
 			},
 			sTxF: func() *txs.Tx {
@@ -517,6 +519,7 @@ func TestVerifyAddPermissionlessValidatorTx(t *testing.T) {
 					EndTime:   mockable.MaxTime,
 				}
 				mockState.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, verifiedTx.NodeID()).Return(primaryNetworkVdr, nil)
+				mockState.EXPECT().GetBaseFee().Return(uint64(0), nil)
 				return mockState
 			},
 			sTxF: func() *txs.Tx {
@@ -58,7 +58,10 @@ func (e *StandardTxExecutor) CreateChainTx(tx *txs.CreateChainTx) error {
 
 	// Verify the flowcheck
 	timestamp := e.State.GetTimestamp()
-	createBlockchainTxFee := e.Config.GetCreateBlockchainTxFee(timestamp)
+	createBlockchainTxFee, err := scaledFee(e.Config, e.State, e.Config.GetCreateBlockchainTxFee(timestamp))
+	if err != nil {
+		return err
+	}
 	if err := e.FlowChecker.VerifySpend(
 		tx,
 		e.State,
@@ -74,6 +77,9 @@ func (e *StandardTxExecutor) CreateChainTx(tx *txs.CreateChainTx) error {
 
 	txID := e.Tx.ID()
 
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
 	// Consume the UTXOS
 	utxo.Consume(e.State, tx.Ins)
 	// Produce the UTXOS
@@ -97,7 +103,10 @@ func (e *StandardTxExecutor) CreateSubnetTx(tx *txs.CreateSubnetTx) error {
 
 	// Verify the flowcheck
 	timestamp := e.State.GetTimestamp()
-	createSubnetTxFee := e.Config.GetCreateSubnetTxFee(timestamp)
+	createSubnetTxFee, err := scaledFee(e.Config, e.State, e.Config.GetCreateSubnetTxFee(timestamp))
+	if err != nil {
+		return err
+	}
 	if err := e.FlowChecker.VerifySpend(
 		tx,
 		e.State,
@@ -113,6 +122,9 @@ func (e *StandardTxExecutor) CreateSubnetTx(tx *txs.CreateSubnetTx) error {
 
 	txID := e.Tx.ID()
 
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
 	// Consume the UTXOS
 	utxo.Consume(e.State, tx.Ins)
 	// Produce the UTXOS
@@ -166,14 +178,20 @@ func (e *StandardTxExecutor) ImportTx(tx *txs.ImportTx) error {
 		copy(ins, tx.Ins)
 		copy(ins[len(tx.Ins):], tx.ImportedInputs)
 
+		baseFee, err := e.State.GetBaseFee()
+		if err != nil {
+			return err
+		}
+
 		if err := e.FlowChecker.VerifySpendUTXOs(
+			e.State,
 			tx,
 			utxos,
 			ins,
 			tx.Outs,
 			e.Tx.Creds,
 			map[ids.ID]uint64{
-				e.Ctx.AVAXAssetID: e.Config.TxFee,
+				e.Ctx.AVAXAssetID: baseFee,
 			},
 		); err != nil {
 			return err
@@ -182,6 +200,9 @@ func (e *StandardTxExecutor) ImportTx(tx *txs.ImportTx) error {
 
 	txID := e.Tx.ID()
 
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
 	// Consume the UTXOS
 	utxo.Consume(e.State, tx.Ins)
 	// Produce the UTXOS
@@ -210,6 +231,11 @@ func (e *StandardTxExecutor) ExportTx(tx *txs.ExportTx) error {
 		}
 	}
 
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
 	// Verify the flowcheck
 	if err := e.FlowChecker.VerifySpend(
 		tx,
@@ -218,7 +244,7 @@ func (e *StandardTxExecutor) ExportTx(tx *txs.ExportTx) error {
 		outs,
 		e.Tx.Creds,
 		map[ids.ID]uint64{
-			e.Ctx.AVAXAssetID: e.Config.TxFee,
+			e.Ctx.AVAXAssetID: baseFee,
 		},
 	); err != nil {
 		return fmt.Errorf("failed verifySpend: %w", err)
@@ -226,6 +252,9 @@ func (e *StandardTxExecutor) ExportTx(tx *txs.ExportTx) error {
 
 	txID := e.Tx.ID()
 
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
 	// Consume the UTXOS
 	utxo.Consume(e.State, tx.Ins)
 	// Produce the UTXOS
@@ -286,6 +315,9 @@ func (e *StandardTxExecutor) AddValidatorTx(tx *txs.AddValidatorTx) error {
 	}
 
 	e.State.PutPendingValidator(newStaker)
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
 	utxo.Consume(e.State, tx.Ins)
 	utxo.Produce(e.State, txID, tx.Outs)
 
@@ -309,6 +341,9 @@ func (e *StandardTxExecutor) AddSubnetValidatorTx(tx *txs.AddSubnetValidatorTx)
 	}
 
 	e.State.PutPendingValidator(newStaker)
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
 	utxo.Consume(e.State, tx.Ins)
 	utxo.Produce(e.State, txID, tx.Outs)
 
@@ -332,6 +367,9 @@ func (e *StandardTxExecutor) AddDelegatorTx(tx *txs.AddDelegatorTx) error {
 	}
 
 	e.State.PutPendingDelegator(newStaker)
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
 	utxo.Consume(e.State, tx.Ins)
 	utxo.Produce(e.State, txID, tx.Outs)
 
@@ -363,6 +401,9 @@ func (e *StandardTxExecutor) RemoveSubnetValidatorTx(tx *txs.RemoveSubnetValidat
 	// Invariant: There are no permissioned subnet delegators to remove.
 
 	txID := e.Tx.ID()
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
 	utxo.Consume(e.State, tx.Ins)
 	utxo.Produce(e.State, txID, tx.Outs)
 
@@ -385,6 +426,11 @@ func (e *StandardTxExecutor) TransformSubnetTx(tx *txs.TransformSubnetTx) error
 		return err
 	}
 
+	transformSubnetTxFee, err := scaledFee(e.Config, e.State, e.Config.TransformSubnetTxFee)
+	if err != nil {
+		return err
+	}
+
 	totalRewardAmount := tx.MaximumSupply - tx.InitialSupply
 	if err := e.Backend.FlowChecker.VerifySpend(
 		tx,
@@ -396,7 +442,7 @@ func (e *StandardTxExecutor) TransformSubnetTx(tx *txs.TransformSubnetTx) error
 		//            entry in this map literal from being overwritten by the
 		//            second entry.
 		map[ids.ID]uint64{
-			e.Ctx.AVAXAssetID: e.Config.TransformSubnetTxFee,
+			e.Ctx.AVAXAssetID: transformSubnetTxFee,
 			tx.AssetID:        totalRewardAmount,
 		},
 	); err != nil {
@@ -405,6 +451,9 @@ func (e *StandardTxExecutor) TransformSubnetTx(tx *txs.TransformSubnetTx) error
 
 	txID := e.Tx.ID()
 
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
 	// Consume the UTXOS
 	utxo.Consume(e.State, tx.Ins)
 	// Produce the UTXOS
@@ -432,6 +481,9 @@ func (e *StandardTxExecutor) AddPermissionlessValidatorTx(tx *txs.AddPermissionl
 	}
 
 	e.State.PutPendingValidator(newStaker)
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
 	utxo.Consume(e.State, tx.Ins)
 	utxo.Produce(e.State, txID, tx.Outs)
 
@@ -455,6 +507,9 @@ func (e *StandardTxExecutor) AddPermissionlessDelegatorTx(tx *txs.AddPermissionl
 	}
 
 	e.State.PutPendingDelegator(newStaker)
+	if err := spendMultisigPolicies(e.Backend, e.State, tx.Ins, tx.Outs); err != nil {
+		return err
+	}
 	utxo.Consume(e.State, tx.Ins)
 	utxo.Produce(e.State, txID, tx.Outs)
 
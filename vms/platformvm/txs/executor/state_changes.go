@@ -69,6 +69,7 @@ type stateChanges struct {
 	pendingValidatorsToRemove []*state.Staker
 	pendingDelegatorsToRemove []*state.Staker
 	currentValidatorsToRemove []*state.Staker
+	caminoStateChanges
 }
 
 func (s *stateChanges) Apply(stateDiff state.Diff) {
@@ -91,12 +92,14 @@ func (s *stateChanges) Apply(stateDiff state.Diff) {
 	for _, currentValidatorToRemove := range s.currentValidatorsToRemove {
 		stateDiff.DeleteCurrentValidator(currentValidatorToRemove)
 	}
+
+	s.caminoStateChanges.Apply(stateDiff)
 }
 
 func (s *stateChanges) Len() int {
 	return len(s.currentValidatorsToAdd) + len(s.currentDelegatorsToAdd) +
 		len(s.pendingValidatorsToRemove) + len(s.pendingDelegatorsToRemove) +
-		len(s.currentValidatorsToRemove)
+		len(s.currentValidatorsToRemove) + s.caminoStateChanges.Len()
 }
 
 // AdvanceTimeTo does not modify [parentState].
@@ -149,7 +152,7 @@ func AdvanceTimeTo(
 			}
 		}
 
-		rewards, err := GetRewardsCalculator(backend, parentState, stakerToRemove.SubnetID)
+		rewards, err := GetRewardsCalculator(backend, parentState, stakerToRemove.SubnetID, stakerToRemove.StartTime)
 		if err != nil {
 			return nil, err
 		}
@@ -201,15 +204,32 @@ func AdvanceTimeTo(
 
 		changes.currentValidatorsToRemove = append(changes.currentValidatorsToRemove, stakerToRemove)
 	}
+
+	if err := caminoAdvanceTimeTo(parentState, newChainTime, changes); err != nil {
+		return nil, err
+	}
 	return changes, nil
 }
 
+// GetRewardsCalculator returns the calculator of potential rewards for stakers
+// of [subnetID], which start staking at [startTime].
 func GetRewardsCalculator(
 	backend *Backend,
 	parentState state.Chain,
 	subnetID ids.ID,
+	startTime time.Time,
 ) (reward.Calculator, error) {
 	if subnetID == constants.PrimaryNetworkID {
+		caminoConfig, err := parentState.CaminoConfig()
+		if err != nil {
+			return nil, err
+		}
+		if caminoConfig.LockModeBondDeposit && backend.Config.IsAthensPhaseActivated(startTime) {
+			return reward.NewCaminoCalculator(
+				caminoConfig.ValidatorRewardRate,
+				backend.Config.RewardConfig,
+			), nil
+		}
 		return backend.Rewards, nil
 	}
 
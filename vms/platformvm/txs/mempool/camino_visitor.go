@@ -4,9 +4,13 @@
 package mempool
 
 import (
+	"errors"
+
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 )
 
+var errCantIssueFinishProposalsTx = errors.New("can not issue a finish proposals tx")
+
 // Issuer
 
 func (i *issuer) AddAddressStateTx(*txs.AddAddressStateTx) error {
@@ -29,6 +33,45 @@ func (i *issuer) RegisterNodeTx(*txs.RegisterNodeTx) error {
 	return nil
 }
 
+func (i *issuer) AddDepositOfferTx(*txs.AddDepositOfferTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
+
+func (i *issuer) ClaimTx(*txs.ClaimTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
+
+func (i *issuer) AddProposalTx(*txs.AddProposalTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
+
+func (i *issuer) AddVoteTx(*txs.AddVoteTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
+
+func (*issuer) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	return errCantIssueFinishProposalsTx
+}
+
+func (i *issuer) MultisigAliasTx(*txs.MultisigAliasTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
+
+func (i *issuer) MultisigAliasPolicyTx(*txs.MultisigAliasPolicyTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
+
+func (i *issuer) SetKycExpirationTx(*txs.SetKycExpirationTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
+
 // Remover
 
 func (r *remover) AddAddressStateTx(*txs.AddAddressStateTx) error {
@@ -50,3 +93,43 @@ func (r *remover) RegisterNodeTx(*txs.RegisterNodeTx) error {
 	r.m.removeDecisionTxs([]*txs.Tx{r.tx})
 	return nil
 }
+
+func (r *remover) AddDepositOfferTx(*txs.AddDepositOfferTx) error {
+	r.m.removeDecisionTxs([]*txs.Tx{r.tx})
+	return nil
+}
+
+func (r *remover) ClaimTx(*txs.ClaimTx) error {
+	r.m.removeDecisionTxs([]*txs.Tx{r.tx})
+	return nil
+}
+
+func (r *remover) AddProposalTx(*txs.AddProposalTx) error {
+	r.m.removeDecisionTxs([]*txs.Tx{r.tx})
+	return nil
+}
+
+func (r *remover) AddVoteTx(*txs.AddVoteTx) error {
+	r.m.removeDecisionTxs([]*txs.Tx{r.tx})
+	return nil
+}
+
+func (*remover) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	// this tx is never in mempool
+	return nil
+}
+
+func (r *remover) MultisigAliasTx(*txs.MultisigAliasTx) error {
+	r.m.removeDecisionTxs([]*txs.Tx{r.tx})
+	return nil
+}
+
+func (r *remover) MultisigAliasPolicyTx(*txs.MultisigAliasPolicyTx) error {
+	r.m.removeDecisionTxs([]*txs.Tx{r.tx})
+	return nil
+}
+
+func (r *remover) SetKycExpirationTx(*txs.SetKycExpirationTx) error {
+	r.m.removeDecisionTxs([]*txs.Tx{r.tx})
+	return nil
+}
@@ -0,0 +1,26 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package uptimeproof
+
+import (
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/codec/linearcodec"
+	"github.com/ava-labs/avalanchego/utils/units"
+)
+
+const (
+	codecVersion = 0
+	maxProofSize = 512 * units.KiB
+)
+
+// Codec does serialization and deserialization of uptime proofs
+var c codec.Manager
+
+func init() {
+	c = codec.NewManager(maxProofSize)
+	lc := linearcodec.NewCustomMaxLength(maxProofSize)
+	if err := c.RegisterCodec(codecVersion, lc); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package uptimeproof
+
+import (
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/uptime"
+	"github.com/ava-labs/avalanchego/utils/constants"
+)
+
+var _ uptime.Manager = (*manager)(nil)
+
+type manager struct {
+	uptime.Manager
+	tracker        *Tracker
+	minWeightRatio float64
+}
+
+// NewManager returns [uptimeManager], which calculates the uptime percents of
+// the primary network validators from the estimates of [tracker]. Estimates
+// are only used, if their observers hold at least [minWeightRatio] of the
+// weight of the other validators, otherwise the locally observed uptime is
+// returned.
+func NewManager(uptimeManager uptime.Manager, tracker *Tracker, minWeightRatio float64) uptime.Manager {
+	return &manager{
+		Manager:        uptimeManager,
+		tracker:        tracker,
+		minWeightRatio: minWeightRatio,
+	}
+}
+
+func (m *manager) CalculateUptimePercentFrom(nodeID ids.NodeID, subnetID ids.ID, startTime time.Time) (float64, error) {
+	if subnetID == constants.PrimaryNetworkID {
+		estimate, ok := m.tracker.Estimate(nodeID)
+		if ok && float64(estimate.Weight) >= m.minWeightRatio*float64(estimate.TotalWeight) {
+			return estimate.Uptime, nil
+		}
+	}
+	return m.Manager.CalculateUptimePercentFrom(nodeID, subnetID, startTime)
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package uptimeproof
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/uptime"
+	"github.com/ava-labs/avalanchego/utils/constants"
+)
+
+func TestManagerCalculateUptimePercentFrom(t *testing.T) {
+	require := require.New(t)
+
+	tracker, vdrs := newTestTracker(t, 1, 3, 2)
+	now := tracker.clock.Time()
+	subject := vdrs[2].nodeID
+	subnetID := ids.GenerateTestID()
+
+	uptimeState := uptime.NewTestState()
+	uptimeState.AddNode(subject, constants.PrimaryNetworkID, now)
+	uptimeState.AddNode(subject, subnetID, now)
+	localManager := uptime.NewManager(uptimeState).(uptime.TestManager)
+	localManager.SetTime(now.Add(time.Hour))
+
+	localUptime, err := localManager.CalculateUptimePercentFrom(subject, constants.PrimaryNetworkID, now)
+	require.NoError(err)
+
+	m := NewManager(localManager, tracker, 0.5)
+
+	// no estimate
+	uptimePercent, err := m.CalculateUptimePercentFrom(subject, constants.PrimaryNetworkID, now)
+	require.NoError(err)
+	require.Equal(localUptime, uptimePercent)
+
+	// estimate of less than [minWeightRatio] of the weight
+	added, err := tracker.Add(vdrs[0].nodeID, newTestProof(t, vdrs[0], now, map[ids.NodeID]float64{subject: 0.9}))
+	require.NoError(err)
+	require.True(added)
+	uptimePercent, err = m.CalculateUptimePercentFrom(subject, constants.PrimaryNetworkID, now)
+	require.NoError(err)
+	require.Equal(localUptime, uptimePercent)
+
+	added, err = tracker.Add(vdrs[1].nodeID, newTestProof(t, vdrs[1], now, map[ids.NodeID]float64{subject: 0.7}))
+	require.NoError(err)
+	require.True(added)
+	uptimePercent, err = m.CalculateUptimePercentFrom(subject, constants.PrimaryNetworkID, now)
+	require.NoError(err)
+	require.InDelta(0.75, uptimePercent, 0.0001)
+
+	// subnet uptimes aren't estimated
+	uptimePercent, err = m.CalculateUptimePercentFrom(subject, subnetID, now)
+	require.NoError(err)
+	require.Equal(localUptime, uptimePercent)
+}
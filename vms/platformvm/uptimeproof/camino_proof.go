@@ -0,0 +1,144 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package uptimeproof
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+	"github.com/ava-labs/avalanchego/vms/platformvm/teleporter"
+)
+
+var (
+	errUnsortedObservations = errors.New("observations aren't sorted and unique")
+	errInvalidUptime        = errors.New("uptime exceeds percent denominator")
+	errInvalidSignature     = errors.New("invalid signature")
+)
+
+// Observation is the uptime of [NodeID] observed by the signer of the proof.
+// [Uptime] is in units of reward.PercentDenominator.
+type Observation struct {
+	NodeID ids.NodeID `serialize:"true"`
+	Uptime uint32     `serialize:"true"`
+}
+
+func (o Observation) Less(other Observation) bool {
+	return o.NodeID.Less(other.NodeID)
+}
+
+// UnsignedProof contains the uptimes of the primary network validators, that
+// were observed by [Signer] at [Timestamp].
+type UnsignedProof struct {
+	Signer       ids.NodeID    `serialize:"true"`
+	Timestamp    uint64        `serialize:"true"`
+	Observations []Observation `serialize:"true"`
+}
+
+// Verify returns nil, if [p] is well-formed
+func (p *UnsignedProof) Verify() error {
+	if !utils.IsSortedAndUniqueSortable(p.Observations) {
+		return errUnsortedObservations
+	}
+	for _, observation := range p.Observations {
+		if observation.Uptime > reward.PercentDenominator {
+			return fmt.Errorf("%w: %s", errInvalidUptime, observation.NodeID)
+		}
+	}
+	return nil
+}
+
+// uptime returns the observed uptime of [nodeID] in [0, 1]
+func (p *UnsignedProof) uptime(nodeID ids.NodeID) (float64, bool) {
+	i := sort.Search(len(p.Observations), func(i int) bool {
+		return !p.Observations[i].NodeID.Less(nodeID)
+	})
+	if i == len(p.Observations) || p.Observations[i].NodeID != nodeID {
+		return 0, false
+	}
+	return float64(p.Observations[i].Uptime) / reward.PercentDenominator, true
+}
+
+// Proof is the UnsignedProof signed by the BLS key of its signer. The
+// signature is over the teleporter message of [chainID] with the unsigned proof
+// as payload, so the proofs are signed by the same signer as other messages of
+// the chain.
+type Proof struct {
+	UnsignedProof []byte `serialize:"true"`
+	Signature     []byte `serialize:"true"`
+
+	unsigned *UnsignedProof
+	bytes    []byte
+}
+
+// NewProof signs [unsigned] with [signer] of the chain [chainID]
+func NewProof(chainID ids.ID, unsigned *UnsignedProof, signer teleporter.Signer) (*Proof, error) {
+	unsignedBytes, err := c.Marshal(codecVersion, unsigned)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't marshal unsigned proof: %w", err)
+	}
+	msg, err := teleporter.NewUnsignedMessage(chainID, chainID, unsignedBytes)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := signer.Sign(msg)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't sign proof: %w", err)
+	}
+
+	proof := &Proof{
+		UnsignedProof: unsignedBytes,
+		Signature:     signature,
+		unsigned:      unsigned,
+	}
+	proof.bytes, err = c.Marshal(codecVersion, proof)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't marshal proof: %w", err)
+	}
+	return proof, nil
+}
+
+// ParseProof parses [bytes] into the proof. The signature isn't verified.
+func ParseProof(bytes []byte) (*Proof, error) {
+	proof := &Proof{bytes: bytes}
+	if _, err := c.Unmarshal(bytes, proof); err != nil {
+		return nil, err
+	}
+	proof.unsigned = &UnsignedProof{}
+	if _, err := c.Unmarshal(proof.UnsignedProof, proof.unsigned); err != nil {
+		return nil, fmt.Errorf("couldn't unmarshal unsigned proof: %w", err)
+	}
+	return proof, nil
+}
+
+// Bytes returns the binary representation of [p]
+func (p *Proof) Bytes() []byte {
+	return p.bytes
+}
+
+// Unsigned returns the unsigned proof, that was signed
+func (p *Proof) Unsigned() *UnsignedProof {
+	return p.unsigned
+}
+
+// VerifySignature returns nil, if the proof was signed by [pk] for the chain
+// [chainID].
+func (p *Proof) VerifySignature(chainID ids.ID, pk *bls.PublicKey) error {
+	sig, err := bls.SignatureFromBytes(p.Signature)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errInvalidSignature, err)
+	}
+	msg, err := teleporter.NewUnsignedMessage(chainID, chainID, p.UnsignedProof)
+	if err != nil {
+		return err
+	}
+	if !bls.Verify(pk, sig, msg.Bytes()) {
+		return errInvalidSignature
+	}
+	return nil
+}
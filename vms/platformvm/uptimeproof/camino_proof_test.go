@@ -0,0 +1,100 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package uptimeproof
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+	"github.com/ava-labs/avalanchego/vms/platformvm/teleporter"
+)
+
+var _ teleporter.Signer = (*testSigner)(nil)
+
+type testSigner struct {
+	sk *bls.SecretKey
+}
+
+func (s *testSigner) Sign(msg *teleporter.UnsignedMessage) ([]byte, error) {
+	return bls.SignatureToBytes(bls.Sign(s.sk, msg.Bytes())), nil
+}
+
+func newTestSigner(t *testing.T) *testSigner {
+	sk, err := bls.NewSecretKey()
+	require.NoError(t, err)
+	return &testSigner{sk: sk}
+}
+
+func TestProofSignature(t *testing.T) {
+	require := require.New(t)
+
+	chainID := ids.GenerateTestID()
+	signer := newTestSigner(t)
+	unsigned := &UnsignedProof{
+		Signer:    ids.GenerateTestNodeID(),
+		Timestamp: 1,
+		Observations: []Observation{{
+			NodeID: ids.GenerateTestNodeID(),
+			Uptime: reward.PercentDenominator / 2,
+		}},
+	}
+
+	proof, err := NewProof(chainID, unsigned, signer)
+	require.NoError(err)
+
+	parsedProof, err := ParseProof(proof.Bytes())
+	require.NoError(err)
+	require.Equal(unsigned, parsedProof.Unsigned())
+	pk := bls.PublicFromSecretKey(signer.sk)
+	require.NoError(parsedProof.VerifySignature(chainID, pk))
+
+	// signature of another chain
+	err = parsedProof.VerifySignature(ids.GenerateTestID(), pk)
+	require.ErrorIs(err, errInvalidSignature)
+
+	// signature of another key
+	err = parsedProof.VerifySignature(chainID, bls.PublicFromSecretKey(newTestSigner(t).sk))
+	require.ErrorIs(err, errInvalidSignature)
+
+	// malformed signature
+	parsedProof.Signature = make([]byte, bls.SignatureLen)
+	err = parsedProof.VerifySignature(chainID, pk)
+	require.ErrorIs(err, errInvalidSignature)
+}
+
+func TestUnsignedProofVerify(t *testing.T) {
+	nodeID0 := ids.NodeID{0}
+	nodeID1 := ids.NodeID{1}
+
+	tests := map[string]struct {
+		observations []Observation
+		expectedErr  error
+	}{
+		"valid": {
+			observations: []Observation{{NodeID: nodeID0}, {NodeID: nodeID1, Uptime: reward.PercentDenominator}},
+		},
+		"unsorted": {
+			observations: []Observation{{NodeID: nodeID1}, {NodeID: nodeID0}},
+			expectedErr:  errUnsortedObservations,
+		},
+		"duplicate": {
+			observations: []Observation{{NodeID: nodeID0}, {NodeID: nodeID0}},
+			expectedErr:  errUnsortedObservations,
+		},
+		"uptime over 100%": {
+			observations: []Observation{{NodeID: nodeID0, Uptime: reward.PercentDenominator + 1}},
+			expectedErr:  errInvalidUptime,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			proof := &UnsignedProof{Observations: tt.observations}
+			require.ErrorIs(t, proof.Verify(), tt.expectedErr)
+		})
+	}
+}
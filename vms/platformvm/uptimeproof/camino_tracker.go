@@ -0,0 +1,214 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package uptimeproof
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+)
+
+const (
+	// Proofs, which timestamp is ahead of the local time by more than
+	// [maxClockDifference], are rejected
+	maxClockDifference = time.Minute
+
+	// Each sender can make the tracker verify up to [senderVerificationBurst]
+	// signatures at once and [senderVerificationsPerSecond] on average. This
+	// exceeds by far the rate of the proofs, that are signed by the
+	// validators, so only senders of invalid or redundant proofs are limited.
+	senderVerificationsPerSecond = 10
+	senderVerificationBurst      = 100
+	// Limiter of a sender, which wasn't used for [senderLimiterRefillTime], is
+	// full again, so it can be dropped
+	senderLimiterRefillTime = senderVerificationBurst / senderVerificationsPerSecond * time.Second
+)
+
+var (
+	errMissingValidatorSet = errors.New("missing primary network validator set")
+	errNotValidator        = errors.New("signer isn't a primary network validator")
+	errMissingPublicKey    = errors.New("signer has no BLS public key")
+	errStaleProof          = errors.New("proof is too old")
+	errFutureProof         = errors.New("proof is from the future")
+	errRateLimited         = errors.New("sender exceeded the proof verification rate")
+)
+
+// Estimate is the stake-weighted average of the uptimes of a validator, that
+// were observed by other validators.
+type Estimate struct {
+	// Uptime in [0, 1]
+	Uptime float64
+	// Weight of the validators, whose observations are included
+	Weight uint64
+	// Weight of all other validators
+	TotalWeight uint64
+}
+
+// Tracker verifies the uptime proofs of the primary network validators and
+// aggregates them into uptime estimates.
+type Tracker struct {
+	chainID    ids.ID
+	validators validators.Manager
+	maxAge     time.Duration
+	// Useful for faking time in tests
+	clock mockable.Clock
+
+	lock sync.RWMutex
+	// signer -> latest verified proof of the signer
+	proofs map[ids.NodeID]*UnsignedProof
+	// sender -> limiter of the signature verifications of the sender's proofs
+	limiters map[ids.NodeID]*senderLimiter
+}
+
+type senderLimiter struct {
+	limiter  *rate.Limiter
+	lastUsed time.Time
+}
+
+// NewTracker returns a tracker of the proofs of the chain [chainID], which are
+// signed by the current primary network validators from [vdrs]. Proofs older
+// than [maxAge] are ignored.
+func NewTracker(chainID ids.ID, vdrs validators.Manager, maxAge time.Duration) *Tracker {
+	return &Tracker{
+		chainID:    chainID,
+		validators: vdrs,
+		maxAge:     maxAge,
+		proofs:     make(map[ids.NodeID]*UnsignedProof),
+		limiters:   make(map[ids.NodeID]*senderLimiter),
+	}
+}
+
+// Add verifies [proof], which was received from [sender], and stores it, if
+// it's newer than the last proof of its signer. Returns true, if the proof was
+// stored. Signatures are only verified, if [sender] didn't exceed its rate.
+func (t *Tracker) Add(sender ids.NodeID, proof *Proof) (bool, error) {
+	unsigned := proof.Unsigned()
+	if err := unsigned.Verify(); err != nil {
+		return false, err
+	}
+
+	now := t.clock.Time()
+	timestamp := time.Unix(int64(unsigned.Timestamp), 0)
+	switch {
+	case timestamp.After(now.Add(maxClockDifference)):
+		return false, fmt.Errorf("%w: %s", errFutureProof, timestamp)
+	case now.Sub(timestamp) > t.maxAge:
+		return false, fmt.Errorf("%w: %s", errStaleProof, timestamp)
+	}
+
+	t.lock.RLock()
+	last, ok := t.proofs[unsigned.Signer]
+	t.lock.RUnlock()
+	if ok && last.Timestamp >= unsigned.Timestamp {
+		return false, nil
+	}
+
+	vdrs, ok := t.validators.Get(constants.PrimaryNetworkID)
+	if !ok {
+		return false, errMissingValidatorSet
+	}
+	vdr, ok := vdrs.Get(unsigned.Signer)
+	if !ok {
+		return false, fmt.Errorf("%w: %s", errNotValidator, unsigned.Signer)
+	}
+	if vdr.PublicKey == nil {
+		return false, fmt.Errorf("%w: %s", errMissingPublicKey, unsigned.Signer)
+	}
+	if !t.allow(sender, now) {
+		return false, fmt.Errorf("%w: %s", errRateLimited, sender)
+	}
+	if err := proof.VerifySignature(t.chainID, vdr.PublicKey); err != nil {
+		return false, err
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	// The proof could have been replaced, while the lock wasn't held
+	if last, ok := t.proofs[unsigned.Signer]; ok && last.Timestamp >= unsigned.Timestamp {
+		return false, nil
+	}
+	t.proofs[unsigned.Signer] = unsigned
+	t.prune(now)
+	return true, nil
+}
+
+// Estimate returns the uptime estimate of [nodeID]. Returns false, if no other
+// validator recently observed the uptime of [nodeID].
+func (t *Tracker) Estimate(nodeID ids.NodeID) (Estimate, bool) {
+	vdrs, ok := t.validators.Get(constants.PrimaryNetworkID)
+	if !ok {
+		return Estimate{}, false
+	}
+
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	var (
+		minTimestamp = t.clock.Time().Add(-t.maxAge).Unix()
+		weightedSum  float64
+		estimate     = Estimate{TotalWeight: vdrs.Weight() - vdrs.GetWeight(nodeID)}
+	)
+	for signer, proof := range t.proofs {
+		if signer == nodeID || int64(proof.Timestamp) < minTimestamp {
+			continue
+		}
+		weight := vdrs.GetWeight(signer)
+		if weight == 0 {
+			continue
+		}
+		uptime, ok := proof.uptime(nodeID)
+		if !ok {
+			continue
+		}
+		weightedSum += float64(weight) * uptime
+		estimate.Weight += weight // Impossible to overflow here
+	}
+	if estimate.Weight == 0 {
+		return Estimate{}, false
+	}
+	estimate.Uptime = weightedSum / float64(estimate.Weight)
+	return estimate, true
+}
+
+// allow returns true, if [sender] didn't exceed its signature verification
+// rate at [now].
+func (t *Tracker) allow(sender ids.NodeID, now time.Time) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	l, ok := t.limiters[sender]
+	if !ok {
+		l = &senderLimiter{
+			limiter: rate.NewLimiter(senderVerificationsPerSecond, senderVerificationBurst),
+		}
+		t.limiters[sender] = l
+	}
+	l.lastUsed = now
+	return l.limiter.AllowN(now, 1)
+}
+
+// prune forgets the proofs, that are too old, and the limiters, that are full.
+// Assumes [t.lock] is held.
+func (t *Tracker) prune(now time.Time) {
+	minTimestamp := now.Add(-t.maxAge).Unix()
+	for signer, proof := range t.proofs {
+		if int64(proof.Timestamp) < minTimestamp {
+			delete(t.proofs, signer)
+		}
+	}
+	for sender, l := range t.limiters {
+		if now.Sub(l.lastUsed) >= senderLimiterRefillTime {
+			delete(t.limiters, sender)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package uptimeproof
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+)
+
+const testMaxAge = 10 * time.Minute
+
+var testChainID = ids.GenerateTestID()
+
+type testValidator struct {
+	nodeID ids.NodeID
+	signer *testSigner
+}
+
+func newTestTracker(t *testing.T, weights ...uint64) (*Tracker, []*testValidator) {
+	vdrSet := validators.NewSet()
+	vdrs := make([]*testValidator, len(weights))
+	for i, weight := range weights {
+		vdr := &testValidator{
+			nodeID: ids.GenerateTestNodeID(),
+			signer: newTestSigner(t),
+		}
+		pk := bls.PublicFromSecretKey(vdr.signer.sk)
+		require.NoError(t, vdrSet.Add(vdr.nodeID, pk, ids.GenerateTestID(), weight))
+		vdrs[i] = vdr
+	}
+	vdrManager := validators.NewManager()
+	vdrManager.Add(constants.PrimaryNetworkID, vdrSet)
+
+	tracker := NewTracker(testChainID, vdrManager, testMaxAge)
+	tracker.clock.Set(time.Unix(1_000_000, 0))
+	return tracker, vdrs
+}
+
+func newTestProof(t *testing.T, signer *testValidator, timestamp time.Time, uptimes map[ids.NodeID]float64) *Proof {
+	unsigned := &UnsignedProof{
+		Signer:    signer.nodeID,
+		Timestamp: uint64(timestamp.Unix()),
+	}
+	for nodeID, uptime := range uptimes {
+		unsigned.Observations = append(unsigned.Observations, Observation{
+			NodeID: nodeID,
+			Uptime: uint32(uptime * reward.PercentDenominator),
+		})
+	}
+	utils.Sort(unsigned.Observations)
+	proof, err := NewProof(testChainID, unsigned, signer.signer)
+	require.NoError(t, err)
+	return proof
+}
+
+func TestTrackerAdd(t *testing.T) {
+	tracker, vdrs := newTestTracker(t, 1, 1)
+	now := tracker.clock.Time()
+	uptimes := map[ids.NodeID]float64{vdrs[1].nodeID: 1}
+
+	nonValidator := &testValidator{
+		nodeID: ids.GenerateTestNodeID(),
+		signer: newTestSigner(t),
+	}
+	forgedProof := newTestProof(t, &testValidator{
+		nodeID: vdrs[0].nodeID,
+		signer: newTestSigner(t),
+	}, now.Add(time.Second), uptimes)
+
+	tests := map[string]struct {
+		proof         *Proof
+		expectedAdded bool
+		expectedErr   error
+	}{
+		"non-validator": {
+			proof:       newTestProof(t, nonValidator, now, uptimes),
+			expectedErr: errNotValidator,
+		},
+		"forged signature": {
+			proof:       forgedProof,
+			expectedErr: errInvalidSignature,
+		},
+		"stale": {
+			proof:       newTestProof(t, vdrs[0], now.Add(-testMaxAge-time.Second), uptimes),
+			expectedErr: errStaleProof,
+		},
+		"from the future": {
+			proof:       newTestProof(t, vdrs[0], now.Add(maxClockDifference+time.Second), uptimes),
+			expectedErr: errFutureProof,
+		},
+		"valid": {
+			proof:         newTestProof(t, vdrs[0], now, uptimes),
+			expectedAdded: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			added, err := tracker.Add(vdrs[1].nodeID, tt.proof)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Equal(t, tt.expectedAdded, added)
+		})
+	}
+
+	require := require.New(t)
+
+	// known and older proofs aren't added again
+	added, err := tracker.Add(vdrs[0].nodeID, newTestProof(t, vdrs[0], now, uptimes))
+	require.NoError(err)
+	require.False(added)
+	added, err = tracker.Add(vdrs[0].nodeID, newTestProof(t, vdrs[0], now.Add(-time.Second), uptimes))
+	require.NoError(err)
+	require.False(added)
+
+	added, err = tracker.Add(vdrs[0].nodeID, newTestProof(t, vdrs[0], now.Add(time.Second), uptimes))
+	require.NoError(err)
+	require.True(added)
+}
+
+func TestTrackerRateLimit(t *testing.T) {
+	require := require.New(t)
+
+	tracker, vdrs := newTestTracker(t, 1, 1)
+	now := tracker.clock.Time()
+	sender := vdrs[1].nodeID
+	uptimes := map[ids.NodeID]float64{vdrs[1].nodeID: 1}
+
+	forgedProof := newTestProof(t, &testValidator{
+		nodeID: vdrs[0].nodeID,
+		signer: newTestSigner(t),
+	}, now, uptimes)
+	for i := 0; i < senderVerificationBurst; i++ {
+		_, err := tracker.Add(sender, forgedProof)
+		require.ErrorIs(err, errInvalidSignature)
+	}
+
+	// signatures aren't verified, once the sender exceeded its rate
+	proof := newTestProof(t, vdrs[0], now, uptimes)
+	added, err := tracker.Add(sender, proof)
+	require.ErrorIs(err, errRateLimited)
+	require.False(added)
+
+	// other senders aren't limited
+	added, err = tracker.Add(vdrs[0].nodeID, proof)
+	require.NoError(err)
+	require.True(added)
+
+	// limit is refilled over time
+	tracker.clock.Set(now.Add(time.Second))
+	added, err = tracker.Add(sender, newTestProof(t, vdrs[0], now.Add(time.Second), uptimes))
+	require.NoError(err)
+	require.True(added)
+
+	// limiters, which are full again, are pruned
+	tracker.clock.Set(now.Add(time.Second + senderLimiterRefillTime))
+	added, err = tracker.Add(sender, newTestProof(t, vdrs[0], now.Add(2*time.Second), uptimes))
+	require.NoError(err)
+	require.True(added)
+	require.Len(tracker.limiters, 1)
+}
+
+func TestTrackerEstimate(t *testing.T) {
+	require := require.New(t)
+
+	tracker, vdrs := newTestTracker(t, 1, 3, 4)
+	now := tracker.clock.Time()
+	subject := vdrs[2].nodeID
+
+	_, ok := tracker.Estimate(subject)
+	require.False(ok)
+
+	added, err := tracker.Add(vdrs[0].nodeID, newTestProof(t, vdrs[0], now, map[ids.NodeID]float64{subject: 1}))
+	require.NoError(err)
+	require.True(added)
+	added, err = tracker.Add(vdrs[1].nodeID, newTestProof(t, vdrs[1], now, map[ids.NodeID]float64{subject: 0.6}))
+	require.NoError(err)
+	require.True(added)
+	// own observations aren't included
+	added, err = tracker.Add(vdrs[2].nodeID, newTestProof(t, vdrs[2], now, map[ids.NodeID]float64{subject: 0}))
+	require.NoError(err)
+	require.True(added)
+
+	estimate, ok := tracker.Estimate(subject)
+	require.True(ok)
+	require.InDelta(0.7, estimate.Uptime, 0.0001)
+	require.Equal(uint64(4), estimate.Weight)
+	require.Equal(uint64(4), estimate.TotalWeight)
+
+	// observations of validators, which left, aren't included
+	vdrSet, _ := tracker.validators.Get(constants.PrimaryNetworkID)
+	require.NoError(vdrSet.RemoveWeight(vdrs[0].nodeID, 1))
+	estimate, ok = tracker.Estimate(subject)
+	require.True(ok)
+	require.InDelta(0.6, estimate.Uptime, 0.0001)
+	require.Equal(uint64(3), estimate.Weight)
+	require.Equal(uint64(3), estimate.TotalWeight)
+
+	// old proofs aren't included
+	tracker.clock.Set(now.Add(testMaxAge + time.Second))
+	_, ok = tracker.Estimate(subject)
+	require.False(ok)
+}
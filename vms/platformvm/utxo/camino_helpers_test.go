@@ -34,6 +34,23 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 )
 
+var _ state.MultisigState = noMsigState{}
+
+// noMsigState is multisig state without any multisig aliases
+type noMsigState struct{}
+
+func (noMsigState) GetMultisigOwner(ids.ShortID) (*state.MultisigOwner, error) {
+	return nil, database.ErrNotFound
+}
+
+func (noMsigState) GetMultisigPolicy(ids.ShortID) (*state.MultisigPolicy, error) {
+	return nil, database.ErrNotFound
+}
+
+func (noMsigState) GetTimestamp() time.Time {
+	return time.Time{}
+}
+
 const (
 	testNetworkID                = 10 // To be used in tests
 	defaultCaminoValidatorWeight = 2 * units.KiloAvax
@@ -75,9 +92,6 @@ func defaultConfig() *config.Config {
 		ApricotPhase3Time: defaultValidateEndTime,
 		ApricotPhase5Time: defaultValidateEndTime,
 		BanffTime:         mockable.MaxTime,
-		CaminoConfig: config.CaminoConfig{
-			DaoProposalBondAmount: 100 * units.Avax,
-		},
 	}
 }
 
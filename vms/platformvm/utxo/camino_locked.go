@@ -138,7 +138,7 @@ type CaminoVerifier interface {
 	// Precondition: [tx] has already been syntactically verified.
 	VerifyLock(
 		tx txs.UnsignedTx,
-		utxoDB state.UTXOGetter,
+		utxoDB state.Chain,
 		ins []*avax.TransferableInput,
 		outs []*avax.TransferableOutput,
 		creds []verify.Verifiable,
@@ -712,7 +712,7 @@ func (h *handler) UnlockDeposit(
 
 func (h *handler) VerifyLock(
 	tx txs.UnsignedTx,
-	utxoDB state.UTXOGetter,
+	utxoDB state.Chain,
 	ins []*avax.TransferableInput,
 	outs []*avax.TransferableOutput,
 	creds []verify.Verifiable,
@@ -733,10 +733,11 @@ func (h *handler) VerifyLock(
 		utxos[index] = utxo
 	}
 
-	return h.VerifyLockUTXOs(tx, utxos, ins, outs, creds, burnedAmount, assetID, appliedLockState)
+	return h.VerifyLockUTXOs(utxoDB, tx, utxos, ins, outs, creds, burnedAmount, assetID, appliedLockState)
 }
 
 func (h *handler) VerifyLockUTXOs(
+	msigState state.MultisigState,
 	tx txs.UnsignedTx,
 	utxos []*avax.UTXO,
 	ins []*avax.TransferableInput,
@@ -774,6 +775,11 @@ func (h *handler) VerifyLockUTXOs(
 		}
 	}
 
+	msigOwners, msigSpendings, err := h.multisigSpendings(msigState, utxos, outs)
+	if err != nil {
+		return err
+	}
+
 	// Track the amount of transfers and their owners
 	// if appliedLockState == bond, then otherLockTxID is depositTxID and vice versa
 	// ownerID -> otherLockTxID -> amount
@@ -835,7 +841,7 @@ func (h *handler) VerifyLockUTXOs(
 		}
 
 		// Get output signed by real owners (would stay the same if its not msig)
-		msigOut, err := h.getMultisigTransferOutput(utxo)
+		msigOut, err := h.getMultisigTransferOutput(msigOwners, utxo, msigSpendings)
 		if err != nil {
 			return err
 		}
@@ -924,7 +930,8 @@ func (h *handler) VerifyLockUTXOs(
 	for _, consumedOwnerAmounts := range consumed {
 		consumedUnlockedAmount := consumedOwnerAmounts[ids.Empty]
 		if consumedUnlockedAmount >= amountToBurn {
-			return nil
+			amountToBurn = 0
+			break
 		}
 		amountToBurn -= consumedUnlockedAmount
 	}
@@ -939,7 +946,7 @@ func (h *handler) VerifyLockUTXOs(
 		)
 	}
 
-	return nil
+	return h.verifyMultisigSpendings(msigSpendings, outs)
 }
 
 func (h *handler) VerifyUnlockDeposit(
@@ -1012,6 +1019,11 @@ func (h *handler) VerifyUnlockDepositedUTXOs(
 
 	currentTimestamp := uint64(chainState.GetTimestamp().Unix())
 
+	msigOwners, msigSpendings, err := h.multisigSpendings(chainState, utxos, outs)
+	if err != nil {
+		return nil, err
+	}
+
 	// iterate over ins, get utxos, fill the maps (consumed, depositUnlock)
 	for index, input := range ins {
 		utxo := utxos[index] // The UTXO consumed by [input]
@@ -1101,7 +1113,7 @@ func (h *handler) VerifyUnlockDepositedUTXOs(
 			depUnlock.consumed = newAmount
 		} else {
 			// Get output signed by real owners (would stay the same if its not msig)
-			msigOut, err := h.getMultisigTransferOutput(utxo)
+			msigOut, err := h.getMultisigTransferOutput(msigOwners, utxo, msigSpendings)
 			if err != nil {
 				return nil, err
 			}
@@ -1257,40 +1269,37 @@ func (h *handler) VerifyUnlockDepositedUTXOs(
 		)
 	}
 
+	if err := h.verifyMultisigSpendings(msigSpendings, outs); err != nil {
+		return nil, err
+	}
+
 	return unlockedAmount, nil
 }
 
-func (h *handler) getMultisigTransferOutput(utxo *avax.UTXO) (verify.Verifiable, error) {
+// getMultisigTransferOutput returns output with real owners of [utxo], if it
+// is owned by multisig alias. If alias spending is large according to its
+// policy, threshold is raised to the policy large threshold.
+// Aliases are resolved from [msigOwners], nil [msigOwners] means that there
+// are no aliases to resolve.
+func (h *handler) getMultisigTransferOutput(
+	msigOwners state.MultisigOwnerGetter,
+	utxo *avax.UTXO,
+	spendings map[ids.ShortID]*multisigSpending,
+) (verify.Verifiable, error) {
 	// It preprocesses the UTXO the same way as the `platformvm.utxo.handler.VerifySpendUTXOs`.
 	// Prepared `utxos` will be used only for signature verification
-	out := utxo.Out
-	if inner, ok := out.(*stakeable.LockOut); ok {
-		out = inner.TransferableOut
-	}
-	if inner, ok := out.(*locked.Out); ok {
-		out = inner.TransferableOut
-	}
-
-	secpOut, ok := out.(*secp256k1fx.TransferOutput)
+	secpOut, ok := innerTransferOutput(utxo.Out)
 	if !ok {
 		// Conversion should succeed, otherwise it will be handled by the caller
 		return secpOut, nil
 	}
 
-	if len(secpOut.Addrs) != 1 {
+	if len(secpOut.Addrs) != 1 || msigOwners == nil {
 		// There always should be just one, otherwise it is not a multisig
 		return secpOut, nil
 	}
 
-	// ! because currently there is no support for adding new msig aliases after genesis,
-	// ! we assume that state diffs won't contain any changes to msig aliases state
-	// ! that must be changed later
-	state, ok := h.utxosReader.(state.State)
-	if !ok {
-		return secpOut, nil
-	}
-
-	owner, err := state.GetMultisigOwner(secpOut.Addrs[0])
+	owner, err := msigOwners.GetMultisigOwner(secpOut.Addrs[0])
 	if err != nil {
 		if err == database.ErrNotFound {
 			return secpOut, nil
@@ -1299,9 +1308,14 @@ func (h *handler) getMultisigTransferOutput(utxo *avax.UTXO) (verify.Verifiable,
 		return secpOut, err
 	}
 
+	owners := owner.Owners
+	if spending, ok := spendings[secpOut.Addrs[0]]; ok {
+		owners.Threshold = spending.policy.ThresholdFor(spending.timestamp, spending.amount, owners.Threshold)
+	}
+
 	return &secp256k1fx.TransferOutput{
 		Amt:          secpOut.Amount(),
-		OutputOwners: owner.Owners,
+		OutputOwners: owners,
 	}, nil
 }
 
@@ -21,6 +21,7 @@ import (
 	"github.com/ava-labs/avalanchego/version"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
@@ -51,7 +52,8 @@ func TestUnlockUTXOs(t *testing.T) {
 			memdb.New(),
 			txs.Codec,
 		),
-		fx: fx,
+		fx:  fx,
+		cfg: &config.Config{},
 	}
 
 	cryptFactory := crypto.FactorySECP256K1R{}
@@ -398,6 +400,7 @@ func TestLock(t *testing.T) {
 				clk:         &mockable.Clock{},
 				utxosReader: internalState,
 				fx:          fx,
+				cfg:         config,
 			}
 
 			ins, outs, signers, err := testHandler.Lock(
@@ -434,7 +437,8 @@ func TestVerifyLockUTXOs(t *testing.T) {
 			memdb.New(),
 			txs.Codec,
 		),
-		fx: fx,
+		fx:  fx,
+		cfg: &config.Config{},
 	}
 	assetID := testHandler.ctx.AVAXAssetID
 
@@ -654,6 +658,7 @@ func TestVerifyLockUTXOs(t *testing.T) {
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			err := testHandler.VerifyLockUTXOs(
+				noMsigState{},
 				tx,
 				test.utxos,
 				test.ins,
@@ -806,7 +811,8 @@ func TestUnlockDeposit(t *testing.T) {
 			memdb.New(),
 			txs.Codec,
 		),
-		fx: fx,
+		fx:  fx,
+		cfg: &config.Config{},
 	}
 	txID := ids.GenerateTestID()
 	depositedAmount := uint64(2000)
@@ -954,7 +960,8 @@ func TestVerifyUnlockDepositedUTXOs(t *testing.T) {
 			memdb.New(),
 			txs.Codec,
 		),
-		fx: fx,
+		fx:  fx,
+		cfg: &config.Config{},
 	}
 	tx := &dummyUnsignedTx{txs.BaseTx{}}
 	tx.Initialize([]byte{0})
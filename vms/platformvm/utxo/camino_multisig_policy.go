@@ -0,0 +1,261 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utxo
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var (
+	errMultisigDailyLimitExceeded      = errors.New("multisig alias daily spending limit exceeded")
+	errMultisigLargeSpendNotDelayed    = errors.New("large multisig alias spending isn't timelocked for required delay")
+	errMultisigPolicyAliasSharedOutput = errors.New("multisig alias with spending policy can't share output ownership")
+)
+
+// multisigSpending is AVAX amount spent from multisig alias by one tx
+type multisigSpending struct {
+	policy *state.MultisigPolicy
+	// Chain time, at which amount is spent
+	timestamp uint64
+	// Consumed amount, which isn't produced back to the alias
+	amount uint64
+}
+
+// isLarge returns true if spending is large according to alias policy
+func (s *multisigSpending) isLarge() bool {
+	return s.policy.IsLarge(s.timestamp, s.amount)
+}
+
+// SpendMultisigPolicies records AVAX amounts, that [ins] consume from
+// multisig aliases with spending policies and that [outs] don't produce back
+// to them, in the policies of these aliases. It's a part of tx state changes,
+// so it must be called after tx is verified and before [ins] are consumed.
+func SpendMultisigPolicies(
+	chainState state.Chain,
+	avaxAssetID ids.ID,
+	ins []*avax.TransferableInput,
+	outs []*avax.TransferableOutput,
+) error {
+	utxos := make([]*avax.UTXO, len(ins))
+	for index, input := range ins {
+		utxo, err := chainState.GetUTXO(input.InputID())
+		if err != nil {
+			return fmt.Errorf(
+				"failed to read consumed UTXO %s due to: %w",
+				&input.UTXOID,
+				err,
+			)
+		}
+		utxos[index] = utxo
+	}
+
+	spendings, err := multisigSpendings(chainState, avaxAssetID, utxos, outs)
+	if err != nil {
+		return err
+	}
+
+	for _, spending := range spendings {
+		if spending.amount != 0 {
+			chainState.SetMultisigPolicy(spending.policy.Spend(spending.timestamp, spending.amount))
+		}
+	}
+	return nil
+}
+
+// multisigSpendings returns state, which multisig aliases owning [utxos] are
+// resolved from, and spendings of these aliases with spending policies.
+// Before AthensPhase aliases can't be changed after genesis and can't have
+// spending policies, so they are only resolved from the last accepted state,
+// if it is the utxos reader of this handler.
+func (h *handler) multisigSpendings(
+	msigState state.MultisigState,
+	utxos []*avax.UTXO,
+	outs []*avax.TransferableOutput,
+) (state.MultisigOwnerGetter, map[ids.ShortID]*multisigSpending, error) {
+	if !h.cfg.IsAthensPhaseActivated(msigState.GetTimestamp()) {
+		if lastAcceptedState, ok := h.utxosReader.(state.State); ok {
+			return lastAcceptedState, nil, nil
+		}
+		return nil, nil, nil
+	}
+
+	spendings, err := multisigSpendings(msigState, h.ctx.AVAXAssetID, utxos, outs)
+	return msigState, spendings, err
+}
+
+// multisigSpendings returns spendings of multisig aliases with spending
+// policies, which own consumed [utxos].
+// Spent amount is the AVAX amount consumed from alias, which isn't produced
+// by [outs] back to the alias.
+// Policies only restrict AVAX, other assets are spent without restrictions.
+// Only outputs owned by the alias alone are spendings of the alias: alias
+// can't be resolved for outputs with multiple addresses, so they are spent
+// by their other owners. To prevent moving funds under such shared ownership,
+// [outs] can't have multiple addresses, if one of them is alias with policy.
+func multisigSpendings(
+	msigState state.MultisigState,
+	avaxAssetID ids.ID,
+	utxos []*avax.UTXO,
+	outs []*avax.TransferableOutput,
+) (map[ids.ShortID]*multisigSpending, error) {
+	spendings := make(map[ids.ShortID]*multisigSpending)
+	for _, utxo := range utxos {
+		if utxo.AssetID() != avaxAssetID {
+			continue
+		}
+		secpOut, ok := innerTransferOutput(utxo.Out)
+		if !ok || len(secpOut.Addrs) != 1 {
+			continue
+		}
+		alias := secpOut.Addrs[0]
+
+		spending, ok := spendings[alias]
+		if !ok {
+			policy, err := getMultisigPolicy(msigState, alias)
+			if err != nil {
+				return nil, err
+			}
+			if policy == nil {
+				continue
+			}
+			spending = &multisigSpending{
+				policy:    policy,
+				timestamp: uint64(msigState.GetTimestamp().Unix()),
+			}
+			spendings[alias] = spending
+		}
+
+		newAmount, err := math.Add64(spending.amount, secpOut.Amt)
+		if err != nil {
+			return nil, err
+		}
+		spending.amount = newAmount
+	}
+
+	for _, out := range outs {
+		if out.AssetID() != avaxAssetID {
+			continue
+		}
+		secpOut, ok := innerTransferOutput(out.Out)
+		if !ok {
+			continue
+		}
+		if len(secpOut.Addrs) > 1 {
+			for _, addr := range secpOut.Addrs {
+				policy, err := getMultisigPolicy(msigState, addr)
+				if err != nil {
+					return nil, err
+				}
+				if policy != nil {
+					return nil, fmt.Errorf("alias %s: %w", addr, errMultisigPolicyAliasSharedOutput)
+				}
+			}
+			continue
+		}
+		if len(secpOut.Addrs) != 1 {
+			continue
+		}
+		spending, ok := spendings[secpOut.Addrs[0]]
+		if !ok {
+			continue
+		}
+		if secpOut.Amt > spending.amount {
+			spending.amount = 0
+		} else {
+			spending.amount -= secpOut.Amt
+		}
+	}
+
+	return spendings, nil
+}
+
+// getMultisigPolicy returns non-empty spending policy of multisig [alias] or
+// nil, if [alias] isn't multisig alias or has no policy
+func getMultisigPolicy(msigState state.MultisigState, alias ids.ShortID) (*state.MultisigPolicy, error) {
+	if _, err := msigState.GetMultisigOwner(alias); err == database.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	policy, err := msigState.GetMultisigPolicy(alias)
+	if err == database.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if policy.IsEmpty() {
+		return nil, nil
+	}
+	return policy, nil
+}
+
+// verifyMultisigSpendings verifies that [spendings] don't exceed daily limits
+// of their aliases and that large spendings are timelocked in [outs] for
+// required delay. Signature threshold of large spendings is verified together
+// with credentials, see getMultisigTransferOutput.
+// Spent amounts are recorded by SpendMultisigPolicies, when tx is executed.
+func (h *handler) verifyMultisigSpendings(
+	spendings map[ids.ShortID]*multisigSpending,
+	outs []*avax.TransferableOutput,
+) error {
+	for alias, spending := range spendings {
+		if spending.amount == 0 {
+			continue
+		}
+		policy := spending.policy
+
+		if policy.DailyLimit != 0 {
+			spent, err := math.Add64(policy.SpentAt(spending.timestamp), spending.amount)
+			if err != nil || spent > policy.DailyLimit {
+				return fmt.Errorf("alias %s spends %d, daily limit is %d, already spent %d: %w",
+					alias, spending.amount, policy.DailyLimit, policy.SpentAt(spending.timestamp), errMultisigDailyLimitExceeded)
+			}
+		}
+
+		if spending.isLarge() && policy.LargeDelay != 0 {
+			minLocktime, err := math.Add64(spending.timestamp, policy.LargeDelay)
+			if err != nil {
+				return err
+			}
+			for _, out := range outs {
+				if out.AssetID() != h.ctx.AVAXAssetID {
+					continue
+				}
+				secpOut, ok := innerTransferOutput(out.Out)
+				if ok && len(secpOut.Addrs) == 1 && secpOut.Addrs[0] == alias {
+					continue
+				}
+				if !ok || secpOut.Locktime < minLocktime {
+					return fmt.Errorf("alias %s spends %d, outputs must be timelocked until %d: %w",
+						alias, spending.amount, minLocktime, errMultisigLargeSpendNotDelayed)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// innerTransferOutput returns secp256k1fx transfer output wrapped by [out]
+func innerTransferOutput(out verify.State) (*secp256k1fx.TransferOutput, bool) {
+	if inner, ok := out.(*stakeable.LockOut); ok {
+		out = inner.TransferableOut
+	}
+	if inner, ok := out.(*locked.Out); ok {
+		out = inner.TransferableOut
+	}
+	secpOut, ok := out.(*secp256k1fx.TransferOutput)
+	return secpOut, ok
+}
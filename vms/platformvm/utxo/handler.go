@@ -28,6 +28,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
@@ -125,7 +126,7 @@ type Verifier interface {
 	// Note: [unlockedProduced] is modified by this method.
 	VerifySpend(
 		tx txs.UnsignedTx,
-		utxoDB state.UTXOGetter,
+		utxoDB state.Chain,
 		ins []*avax.TransferableInput,
 		outs []*avax.TransferableOutput,
 		creds []verify.Verifiable,
@@ -133,6 +134,7 @@ type Verifier interface {
 	) error
 
 	// Verify that [tx] is semantically valid.
+	// [msigState] is used to resolve multisig aliases owning [utxos].
 	// [utxos[i]] is the UTXO being consumed by [ins[i]].
 	// [ins] and [outs] are the inputs and outputs of [tx].
 	// [creds] are the credentials of [tx], which allow [ins] to be spent.
@@ -144,6 +146,7 @@ type Verifier interface {
 	//
 	// Note: [unlockedProduced] is modified by this method.
 	VerifySpendUTXOs(
+		msigState state.MultisigState,
 		tx txs.UnsignedTx,
 		utxos []*avax.UTXO,
 		ins []*avax.TransferableInput,
@@ -165,12 +168,14 @@ func NewHandler(
 	clk *mockable.Clock,
 	utxoReader avax.UTXOReader,
 	fx fx.Fx,
+	cfg *config.Config,
 ) Handler {
 	return &handler{
 		ctx:         ctx,
 		clk:         clk,
 		utxosReader: utxoReader,
 		fx:          fx,
+		cfg:         cfg,
 	}
 }
 
@@ -179,6 +184,7 @@ type handler struct {
 	clk         *mockable.Clock
 	utxosReader avax.UTXOReader
 	fx          fx.Fx
+	cfg         *config.Config
 }
 
 func (h *handler) Spend(
@@ -468,7 +474,7 @@ func (h *handler) Authorize(
 
 func (h *handler) VerifySpend(
 	tx txs.UnsignedTx,
-	utxoDB state.UTXOGetter,
+	utxoDB state.Chain,
 	ins []*avax.TransferableInput,
 	outs []*avax.TransferableOutput,
 	creds []verify.Verifiable,
@@ -487,10 +493,11 @@ func (h *handler) VerifySpend(
 		utxos[index] = utxo
 	}
 
-	return h.VerifySpendUTXOs(tx, utxos, ins, outs, creds, unlockedProduced)
+	return h.VerifySpendUTXOs(utxoDB, tx, utxos, ins, outs, creds, unlockedProduced)
 }
 
 func (h *handler) VerifySpendUTXOs(
+	msigState state.MultisigState,
 	tx txs.UnsignedTx,
 	utxos []*avax.UTXO,
 	ins []*avax.TransferableInput,
@@ -530,6 +537,11 @@ func (h *handler) VerifySpendUTXOs(
 	lockedProduced := make(map[ids.ID]map[uint64]map[ids.ID]uint64)
 	lockedConsumed := make(map[ids.ID]map[uint64]map[ids.ID]uint64)
 
+	msigOwners, msigSpendings, err := h.multisigSpendings(msigState, utxos, outs)
+	if err != nil {
+		return err
+	}
+
 	for index, input := range ins {
 		utxo := utxos[index] // The UTXO consumed by [input]
 
@@ -567,7 +579,7 @@ func (h *handler) VerifySpendUTXOs(
 		}
 
 		// Get output signed by real owners (would stay the same if its not msig)
-		msigOut, err := h.getMultisigTransferOutput(utxo)
+		msigOut, err := h.getMultisigTransferOutput(msigOwners, utxo, msigSpendings)
 		if err != nil {
 			return err
 		}
@@ -701,5 +713,6 @@ func (h *handler) VerifySpendUTXOs(
 			)
 		}
 	}
-	return nil
+
+	return h.verifyMultisigSpendings(msigSpendings, outs)
 }
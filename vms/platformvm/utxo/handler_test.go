@@ -15,6 +15,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
@@ -47,7 +48,8 @@ func TestVerifySpendUTXOs(t *testing.T) {
 			memdb.New(),
 			txs.Codec,
 		),
-		fx: fx,
+		fx:  fx,
+		cfg: &config.Config{},
 	}
 
 	// The handler time during a test, unless [chainTimestamp] is set
@@ -1095,6 +1097,7 @@ func TestVerifySpendUTXOs(t *testing.T) {
 
 		t.Run(test.description, func(t *testing.T) {
 			err := h.VerifySpendUTXOs(
+				noMsigState{},
 				&unsignedTx,
 				test.utxos,
 				test.ins,
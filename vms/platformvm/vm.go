@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/gorilla/rpc/v2"
@@ -31,6 +32,7 @@ import (
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/database/manager"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/pubsub"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
@@ -53,8 +55,11 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/statesync"
+	"github.com/ava-labs/avalanchego/vms/platformvm/teleporter"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/mempool"
+	"github.com/ava-labs/avalanchego/vms/platformvm/uptimeproof"
 	"github.com/ava-labs/avalanchego/vms/platformvm/utxo"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 
@@ -116,6 +121,30 @@ type VM struct {
 	txBuilder         txbuilder.CaminoBuilder
 	txExecutorBackend *txexecutor.Backend
 	manager           blockexecutor.Manager
+
+	pubsub          *pubsub.Server
+	addressTxsIndex *blockexecutor.AddressTxsIndex
+
+	toEngine         chan<- common.Message
+	stateSyncEnabled bool
+	stateSyncServer  *statesync.Server
+	stateSyncer      *statesync.Syncer
+	stateSyncCancel  context.CancelFunc
+	stateSyncWG      sync.WaitGroup
+	// stateSynced is true, if the summary was downloaded and the chain state
+	// must be replaced with it
+	stateSynced utils.AtomicBool
+
+	appSender            common.AppSender
+	uptimeProofsEnabled  bool
+	uptimeProofFrequency time.Duration
+	uptimeProofTracker   *uptimeproof.Tracker
+	uptimeProofsCancel   context.CancelFunc
+	uptimeProofsWG       sync.WaitGroup
+
+	teleporterHandler         *teleporter.Handler
+	teleporterSignatureGetter *teleporter.NetworkSignatureGetter
+	teleporterAggregator      *teleporter.Aggregator
 }
 
 // Initialize this blockchain.
@@ -126,7 +155,7 @@ func (vm *VM) Initialize(
 	dbManager manager.Manager,
 	genesisBytes []byte,
 	_ []byte,
-	_ []byte,
+	configBytes []byte,
 	toEngine chan<- common.Message,
 	_ []*common.Fx,
 	appSender common.AppSender,
@@ -147,6 +176,13 @@ func (vm *VM) Initialize(
 
 	vm.ctx = chainCtx
 	vm.dbManager = dbManager
+	vm.toEngine = toEngine
+	vm.appSender = appSender
+
+	chainConfig, err := parseChainConfig(configBytes)
+	if err != nil {
+		return err
+	}
 
 	vm.codecRegistry = linearcodec.NewDefault()
 	vm.fx = &secp256k1fx.Fx{}
@@ -163,6 +199,10 @@ func (vm *VM) Initialize(
 		},
 	)
 
+	if err := vm.recoverStateSync(chainConfig, appSender); err != nil {
+		return fmt.Errorf("failed to recover state sync: %w", err)
+	}
+
 	rewards := reward.NewCalculator(vm.RewardConfig)
 	vm.state, err = state.New(
 		vm.dbManager.Current().Database,
@@ -178,9 +218,10 @@ func (vm *VM) Initialize(
 	}
 
 	vm.atomicUtxosManager = avax.NewAtomicUTXOManager(chainCtx.SharedMemory, txs.Codec)
-	utxoHandler := utxo.NewHandler(vm.ctx, &vm.clock, vm.state, vm.fx)
+	utxoHandler := utxo.NewHandler(vm.ctx, &vm.clock, vm.state, vm.fx, &vm.Config)
 	vm.uptimeManager = uptime.NewManager(vm.state)
 	vm.UptimeLockedCalculator.SetCalculator(&vm.bootstrapped, &chainCtx.Lock, vm.uptimeManager)
+	rewardUptimes := vm.initUptimeProofs(chainConfig)
 
 	vm.txBuilder = txbuilder.NewCamino(
 		vm.ctx,
@@ -198,7 +239,7 @@ func (vm *VM) Initialize(
 		Clk:          &vm.clock,
 		Fx:           vm.fx,
 		FlowChecker:  utxoHandler,
-		Uptimes:      vm.uptimeManager,
+		Uptimes:      rewardUptimes,
 		Rewards:      rewards,
 		Bootstrapped: &vm.bootstrapped,
 	}
@@ -210,12 +251,26 @@ func (vm *VM) Initialize(
 		return fmt.Errorf("failed to create mempool: %w", err)
 	}
 
+	if err := vm.initAddressTxsIndex(chainConfig, registerer); err != nil {
+		return err
+	}
+
+	if err := vm.initStateSyncServer(chainConfig, appSender); err != nil {
+		return fmt.Errorf("failed to initialize state sync server: %w", err)
+	}
+
+	vm.initTeleporter(appSender)
+
+	vm.pubsub = pubsub.New(vm.ctx.Log)
 	vm.manager = blockexecutor.NewManager(
 		mempool,
 		vm.metrics,
 		vm.state,
 		vm.txExecutorBackend,
 		vm.recentlyAccepted,
+		vm.pubsub,
+		vm.addressTxsIndex,
+		vm.stateSyncServer,
 	)
 	vm.Builder = blockbuilder.New(
 		mempool,
@@ -322,14 +377,21 @@ func (vm *VM) onNormalOperationsStarted() error {
 		return err
 	}
 
+	vm.startUptimeProofs()
+
 	// Start the block builder
 	vm.Builder.ResetBlockTimer()
 	return nil
 }
 
-func (vm *VM) SetState(_ context.Context, state snow.State) error {
+func (vm *VM) SetState(ctx context.Context, state snow.State) error {
 	switch state {
+	case snow.StateSyncing:
+		return nil
 	case snow.Bootstrapping:
+		if err := vm.finishStateSync(ctx); err != nil {
+			return err
+		}
 		return vm.onBootstrapStarted()
 	case snow.NormalOp:
 		return vm.onNormalOperationsStarted()
@@ -345,6 +407,8 @@ func (vm *VM) Shutdown(context.Context) error {
 	}
 
 	vm.Builder.Shutdown()
+	vm.shutdownStateSync()
+	vm.shutdownUptimeProofs()
 
 	if vm.bootstrapped.GetValue() {
 		primaryVdrIDs, exists := vm.getValidatorIDs(constants.PrimaryNetworkID)
@@ -451,6 +515,10 @@ func (vm *VM) CreateHandlers(context.Context) (map[string]*common.HTTPHandler, e
 		"": {
 			Handler: server,
 		},
+		"/events": {
+			LockOptions: common.NoLock,
+			Handler:     vm.pubsub,
+		},
 	}, nil
 }
 
@@ -474,6 +542,7 @@ func (*VM) CreateStaticHandlers(context.Context) (map[string]*common.HTTPHandler
 }
 
 func (vm *VM) Connected(_ context.Context, nodeID ids.NodeID, _ *version.Application) error {
+	vm.stateSyncer.Connected(nodeID)
 	return vm.uptimeManager.Connect(nodeID, constants.PrimaryNetworkID)
 }
 
@@ -482,6 +551,7 @@ func (vm *VM) ConnectedSubnet(_ context.Context, nodeID ids.NodeID, subnetID ids
 }
 
 func (vm *VM) Disconnected(_ context.Context, nodeID ids.NodeID) error {
+	vm.stateSyncer.Disconnected(nodeID)
 	if err := vm.uptimeManager.Disconnect(nodeID); err != nil {
 		return err
 	}
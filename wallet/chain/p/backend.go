@@ -14,6 +14,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
 var _ Backend = (*backend)(nil)
@@ -42,14 +43,14 @@ type backend struct {
 	txsLock sync.RWMutex
 	// txID -> tx
 	txs map[ids.ID]*txs.Tx
+
+	msigAliasesLock sync.RWMutex
+	// multisig alias -> alias owners
+	msigAliases map[ids.ShortID]*secp256k1fx.OutputOwners
 }
 
 func NewBackend(ctx Context, utxos ChainUTXOs, txs map[ids.ID]*txs.Tx) Backend {
-	return &backend{
-		Context:    ctx,
-		ChainUTXOs: utxos,
-		txs:        txs,
-	}
+	return NewCaminoBackend(ctx, utxos, txs, nil)
 }
 
 func (b *backend) AcceptTx(ctx stdcontext.Context, tx *txs.Tx) error {
@@ -63,7 +64,7 @@ func (b *backend) AcceptTx(ctx stdcontext.Context, tx *txs.Tx) error {
 		return err
 	}
 
-	producedUTXOSlice := tx.UTXOs()
+	producedUTXOSlice := fixLockedUTXOs(txID, tx.UTXOs())
 	err = b.addUTXOs(ctx, constants.PlatformChainID, producedUTXOSlice)
 	if err != nil {
 		return err
@@ -16,6 +16,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/math"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
 	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
@@ -37,6 +38,8 @@ var (
 // Builder provides a convenient interface for building unsigned P-chain
 // transactions.
 type Builder interface {
+	CaminoBuilder
+
 	// GetBalance calculates the amount of each asset that this builder has
 	// control over.
 	GetBalance(
@@ -250,6 +253,7 @@ type Builder interface {
 // P-chain transactions.
 type BuilderBackend interface {
 	Context
+	CaminoBackend
 	UTXOs(ctx stdcontext.Context, sourceChainID ids.ID) ([]*avax.UTXO, error)
 	GetTx(ctx stdcontext.Context, txID ids.ID) (*txs.Tx, error)
 }
@@ -839,6 +843,10 @@ func (b *builder) getBalance(
 			}
 			outIntf = lockedOut.TransferableOut
 		}
+		if _, ok := outIntf.(*locked.Out); ok {
+			// This output is deposited or bonded, so it isn't available.
+			continue
+		}
 
 		out, ok := outIntf.(*secp256k1fx.TransferOutput)
 		if !ok {
@@ -1002,6 +1010,10 @@ func (b *builder) spend(
 			}
 			outIntf = lockedOut.TransferableOut
 		}
+		if _, ok := outIntf.(*locked.Out); ok {
+			// This output is deposited or bonded, so it can't be burned.
+			continue
+		}
 
 		out, ok := outIntf.(*secp256k1fx.TransferOutput)
 		if !ok {
@@ -0,0 +1,117 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	stdcontext "context"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/genesis"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+// CaminoBackend defines the camino specific information required to build and
+// sign unsigned camino P-chain transactions.
+type CaminoBackend interface {
+	// GetMultisigAlias returns owners of the multisig [alias] or
+	// database.ErrNotFound, if [alias] isn't known multisig alias.
+	GetMultisigAlias(ctx stdcontext.Context, alias ids.ShortID) (*secp256k1fx.OutputOwners, error)
+}
+
+// NewCaminoBackend returns a new backend, which is aware of the provided
+// multisig aliases.
+//
+//   - [msigAliases] maps multisig alias to its owners. UTXOs owned by these
+//     aliases could be spent with keys of alias owners.
+func NewCaminoBackend(
+	ctx Context,
+	utxos ChainUTXOs,
+	txs map[ids.ID]*txs.Tx,
+	msigAliases map[ids.ShortID]*secp256k1fx.OutputOwners,
+) Backend {
+	if msigAliases == nil {
+		msigAliases = make(map[ids.ShortID]*secp256k1fx.OutputOwners)
+	}
+	return &backend{
+		Context:     ctx,
+		ChainUTXOs:  utxos,
+		txs:         txs,
+		msigAliases: msigAliases,
+	}
+}
+
+func (b *backend) GetMultisigAlias(_ stdcontext.Context, alias ids.ShortID) (*secp256k1fx.OutputOwners, error) {
+	b.msigAliasesLock.RLock()
+	defer b.msigAliasesLock.RUnlock()
+
+	owners, exists := b.msigAliases[alias]
+	if !exists {
+		return nil, database.ErrNotFound
+	}
+	return owners, nil
+}
+
+func (b *backend) setMultisigAlias(alias ids.ShortID, owners *secp256k1fx.OutputOwners) {
+	b.msigAliasesLock.Lock()
+	defer b.msigAliasesLock.Unlock()
+
+	b.msigAliases[alias] = owners
+}
+
+// getSpendingOwners returns owners, whose signatures are required to spend
+// output owned by [owners]. If [owners] is a single multisig alias, then
+// owners of this alias are returned.
+func getSpendingOwners(
+	ctx stdcontext.Context,
+	backend CaminoBackend,
+	owners *secp256k1fx.OutputOwners,
+) (*secp256k1fx.OutputOwners, error) {
+	if len(owners.Addrs) != 1 {
+		return owners, nil
+	}
+	aliasOwners, err := backend.GetMultisigAlias(ctx, owners.Addrs[0])
+	if err == database.ErrNotFound {
+		return owners, nil
+	}
+	return aliasOwners, err
+}
+
+// fixLockedUTXOs replaces lock ids of outputs, that were locked by the tx
+// [txID], with [txID] itself. Provided utxos aren't modified.
+func fixLockedUTXOs(txID ids.ID, utxos []*avax.UTXO) []*avax.UTXO {
+	fixedUTXOs := make([]*avax.UTXO, len(utxos))
+	for i, utxo := range utxos {
+		lockedOut, ok := utxo.Out.(*locked.Out)
+		if !ok || !lockedOut.IDs.IsLocked() {
+			fixedUTXOs[i] = utxo
+			continue
+		}
+		fixedOut := *lockedOut
+		fixedOut.FixLockID(txID, locked.StateDeposited)
+		fixedOut.FixLockID(txID, locked.StateBonded)
+		fixedUTXOs[i] = &avax.UTXO{
+			UTXOID: utxo.UTXOID,
+			Asset:  utxo.Asset,
+			Out:    &fixedOut,
+		}
+	}
+	return fixedUTXOs
+}
+
+func (b *backendVisitor) multisigAlias(tx *txs.MultisigAliasTx) error {
+	alias := tx.Alias
+	if alias == ids.ShortEmpty {
+		newAlias, err := genesis.NewMultisigAlias(b.txID, tx.Addresses, tx.Threshold)
+		if err != nil {
+			return err
+		}
+		alias = newAlias.Alias
+	}
+	b.b.setMultisigAlias(alias, tx.Owners())
+	return nil
+}
@@ -0,0 +1,1140 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"errors"
+	"fmt"
+
+	stdcontext "context"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/validator"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+)
+
+var (
+	errInvalidTargetLockState = errors.New("invalid target lock state")
+	errLockAmountNotZero      = errors.New("lock amount must be 0 for unlocked state")
+)
+
+// CaminoBuilder provides a convenient interface for building unsigned camino
+// P-chain transactions.
+type CaminoBuilder interface {
+	// NewCaminoAddValidatorTx creates a new validator of the primary network,
+	// which stake is bonded.
+	//
+	// - [vdr] specifies all the details of the validation period such as the
+	//   startTime, endTime, stake weight, and nodeID.
+	// - [rewardsOwner] specifies the owner of all the rewards this validator
+	//   may accrue during its validation period.
+	NewCaminoAddValidatorTx(
+		vdr *validator.Validator,
+		rewardsOwner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.CaminoAddValidatorTx, error)
+
+	// NewAddAddressStateTx creates a new tx, that adds or removes address
+	// state.
+	//
+	// - [address] specifies the address which state will be modified.
+	// - [remove] specifies whether the state will be removed or added.
+	// - [state] specifies the bit index of the state.
+	NewAddAddressStateTx(
+		address ids.ShortID,
+		remove bool,
+		state uint8,
+		options ...common.Option,
+	) (*txs.AddAddressStateTx, error)
+
+	// NewSetKycExpirationTx creates a new tx, that sets or clears expiration
+	// of address kyc verified state.
+	//
+	// - [address] specifies the address which kyc expiration will be set.
+	// - [expiration] specifies the unix timestamp at which kyc verified
+	//   state will expire. Zero means that it never expires.
+	NewSetKycExpirationTx(
+		address ids.ShortID,
+		expiration uint64,
+		options ...common.Option,
+	) (*txs.SetKycExpirationTx, error)
+
+	// NewDepositTx creates a new deposit of AVAX.
+	//
+	// - [amount] specifies the amount of AVAX that will be deposited.
+	// - [duration] specifies the duration of the deposit in seconds.
+	// - [depositOfferID] specifies the offer that will be used for deposit.
+	// - [rewardsOwner] specifies the owner of deposit rewards.
+	NewDepositTx(
+		amount uint64,
+		duration uint32,
+		depositOfferID ids.ID,
+		rewardsOwner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.DepositTx, error)
+
+	// NewUnlockDepositTx creates a new tx, that unlocks deposited AVAX.
+	//
+	// - [unlockAmounts] maps deposit tx ID to the amount of AVAX that will be
+	//   unlocked from this deposit. Expired deposits must be unlocked fully.
+	NewUnlockDepositTx(
+		unlockAmounts map[ids.ID]uint64,
+		options ...common.Option,
+	) (*txs.UnlockDepositTx, error)
+
+	// NewRegisterNodeTx creates a new tx, that registers node for consortium
+	// member.
+	//
+	// - [oldNodeID] specifies the node that will be unregistered. Could be
+	//   empty, if there is no registered node.
+	// - [newNodeID] specifies the node that will be registered. Could be
+	//   empty, if node is only unregistered. Key of this node must be known by
+	//   the signer.
+	// - [consortiumMemberAddress] specifies the consortium member address.
+	NewRegisterNodeTx(
+		oldNodeID ids.NodeID,
+		newNodeID ids.NodeID,
+		consortiumMemberAddress ids.ShortID,
+		options ...common.Option,
+	) (*txs.RegisterNodeTx, error)
+
+	// NewAddDepositOfferTx creates a new deposit offer or updates existing one.
+	//
+	// - [depositOfferID] specifies the offer that will be updated. If empty,
+	//   new offer will be added.
+	// - [offer] specifies the offer.
+	NewAddDepositOfferTx(
+		depositOfferID ids.ID,
+		offer *deposit.Offer,
+		options ...common.Option,
+	) (*txs.AddDepositOfferTx, error)
+
+	// NewClaimTx creates a new tx, that claims deposits rewards.
+	//
+	// - [claimAmounts] maps deposit tx ID to the amount of rewards that will be
+	//   claimed for this deposit. Deposit txs must be known by the backend.
+	NewClaimTx(
+		claimAmounts map[ids.ID]uint64,
+		options ...common.Option,
+	) (*txs.ClaimTx, error)
+
+	// NewAddProposalTx creates a new dao proposal.
+	//
+	// - [proposal] specifies the proposal.
+	// - [bondAmount] specifies the amount of AVAX that will be bonded until
+	//   the proposal voting ends.
+	NewAddProposalTx(
+		proposal dao.Proposal,
+		bondAmount uint64,
+		options ...common.Option,
+	) (*txs.AddProposalTx, error)
+
+	// NewAddVoteTx creates a new vote for dao proposal.
+	//
+	// - [proposalID] specifies the proposal that is voted on.
+	// - [optionIndex] specifies the proposal option that is voted for.
+	// - [voterAddress] specifies the consortium member address that votes.
+	NewAddVoteTx(
+		proposalID ids.ID,
+		optionIndex uint32,
+		voterAddress ids.ShortID,
+		options ...common.Option,
+	) (*txs.AddVoteTx, error)
+
+	// NewMultisigAliasTx creates a new multisig alias or updates existing one.
+	//
+	// - [alias] specifies the multisig alias that will be updated. If empty,
+	//   new alias will be created.
+	// - [threshold] specifies the number of owners signatures required to
+	//   authorize the alias.
+	// - [addresses] specifies the owners of the alias.
+	NewMultisigAliasTx(
+		alias ids.ShortID,
+		threshold uint32,
+		addresses []ids.ShortID,
+		options ...common.Option,
+	) (*txs.MultisigAliasTx, error)
+
+	// NewMultisigAliasPolicyTx sets spending policy of multisig alias.
+	//
+	// - [alias] specifies the multisig alias, which policy will be set.
+	// - [dailyLimit] specifies max AVAX amount, that can be spent from the
+	//   alias during one day. Zero means no limit.
+	// - [largeAmount] specifies AVAX amount, above which spendings are large.
+	//   Zero means there are no large spendings.
+	// - [largeThreshold] specifies the number of owners signatures required
+	//   for large spendings.
+	// - [largeDelay] specifies duration in seconds, for which outputs of
+	//   large spendings must be timelocked.
+	NewMultisigAliasPolicyTx(
+		alias ids.ShortID,
+		dailyLimit uint64,
+		largeAmount uint64,
+		largeThreshold uint32,
+		largeDelay uint64,
+		options ...common.Option,
+	) (*txs.MultisigAliasPolicyTx, error)
+}
+
+func (b *builder) NewCaminoAddValidatorTx(
+	vdr *validator.Validator,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.CaminoAddValidatorTx, error) {
+	ops := common.NewOptions(options)
+	inputs, outputs, err := b.lock(vdr.Wght, b.backend.AddPrimaryNetworkValidatorFee(), locked.StateBonded, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	utils.Sort(rewardsOwner.Addrs)
+	return &txs.CaminoAddValidatorTx{
+		AddValidatorTx: txs.AddValidatorTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    b.backend.NetworkID(),
+				BlockchainID: constants.PlatformChainID,
+				Ins:          inputs,
+				Outs:         outputs,
+				Memo:         ops.Memo(),
+			}},
+			Validator:    *vdr,
+			RewardsOwner: rewardsOwner,
+		},
+	}, nil
+}
+
+func (b *builder) NewAddAddressStateTx(
+	address ids.ShortID,
+	remove bool,
+	state uint8,
+	options ...common.Option,
+) (*txs.AddAddressStateTx, error) {
+	ops := common.NewOptions(options)
+	inputs, outputs, err := b.lock(0, b.backend.BaseTxFee(), locked.StateUnlocked, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.AddAddressStateTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		Address: address,
+		State:   state,
+		Remove:  remove,
+	}, nil
+}
+
+func (b *builder) NewSetKycExpirationTx(
+	address ids.ShortID,
+	expiration uint64,
+	options ...common.Option,
+) (*txs.SetKycExpirationTx, error) {
+	ops := common.NewOptions(options)
+	inputs, outputs, err := b.lock(0, b.backend.BaseTxFee(), locked.StateUnlocked, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.SetKycExpirationTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		Address:    address,
+		Expiration: expiration,
+	}, nil
+}
+
+func (b *builder) NewDepositTx(
+	amount uint64,
+	duration uint32,
+	depositOfferID ids.ID,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.DepositTx, error) {
+	ops := common.NewOptions(options)
+	inputs, outputs, err := b.lock(amount, b.backend.BaseTxFee(), locked.StateDeposited, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	utils.Sort(rewardsOwner.Addrs)
+	return &txs.DepositTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		DepositOfferID:  depositOfferID,
+		DepositDuration: duration,
+		RewardsOwner:    rewardsOwner,
+	}, nil
+}
+
+func (b *builder) NewUnlockDepositTx(
+	unlockAmounts map[ids.ID]uint64,
+	options ...common.Option,
+) (*txs.UnlockDepositTx, error) {
+	ops := common.NewOptions(options)
+	inputs, outputs, err := b.unlockDeposit(unlockAmounts, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	feeInputs, feeOutputs, err := b.lock(0, b.backend.BaseTxFee(), locked.StateUnlocked, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs = append(inputs, feeInputs...)
+	outputs = append(outputs, feeOutputs...)
+	utils.Sort(inputs)                               // sort inputs
+	avax.SortTransferableOutputs(outputs, txs.Codec) // sort outputs
+
+	return &txs.UnlockDepositTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+	}, nil
+}
+
+func (b *builder) NewRegisterNodeTx(
+	oldNodeID ids.NodeID,
+	newNodeID ids.NodeID,
+	consortiumMemberAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.RegisterNodeTx, error) {
+	ops := common.NewOptions(options)
+	inputs, outputs, err := b.lock(0, b.backend.BaseTxFee(), locked.StateUnlocked, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	consortiumMemberAuth, err := b.authorizeAddress(consortiumMemberAddress, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.RegisterNodeTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		OldNodeID:               oldNodeID,
+		NewNodeID:               newNodeID,
+		ConsortiumMemberAuth:    consortiumMemberAuth,
+		ConsortiumMemberAddress: consortiumMemberAddress,
+	}, nil
+}
+
+func (b *builder) NewAddDepositOfferTx(
+	depositOfferID ids.ID,
+	offer *deposit.Offer,
+	options ...common.Option,
+) (*txs.AddDepositOfferTx, error) {
+	ops := common.NewOptions(options)
+	inputs, outputs, err := b.lock(0, b.backend.BaseTxFee(), locked.StateUnlocked, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.AddDepositOfferTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		DepositOfferID: depositOfferID,
+		DepositOffer:   offer,
+	}, nil
+}
+
+func (b *builder) NewClaimTx(
+	claimAmounts map[ids.ID]uint64,
+	options ...common.Option,
+) (*txs.ClaimTx, error) {
+	ops := common.NewOptions(options)
+	inputs, outputs, err := b.lock(0, b.backend.BaseTxFee(), locked.StateUnlocked, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	// deposit tx ids must be sorted, claim amounts and auths must match them
+	depositTxIDs := make([]ids.ID, 0, len(claimAmounts))
+	for depositTxID := range claimAmounts {
+		depositTxIDs = append(depositTxIDs, depositTxID)
+	}
+	utils.Sort(depositTxIDs)
+
+	sortedClaimAmounts := make([]uint64, len(depositTxIDs))
+	rewardsAuths := make([]verify.Verifiable, len(depositTxIDs))
+	for i, depositTxID := range depositTxIDs {
+		rewardsAuth, err := b.authorizeDepositRewards(depositTxID, ops)
+		if err != nil {
+			return nil, err
+		}
+		sortedClaimAmounts[i] = claimAmounts[depositTxID]
+		rewardsAuths[i] = rewardsAuth
+	}
+
+	return &txs.ClaimTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		DepositTxIDs: depositTxIDs,
+		ClaimAmounts: sortedClaimAmounts,
+		RewardsAuth:  rewardsAuths,
+	}, nil
+}
+
+func (b *builder) NewAddProposalTx(
+	proposal dao.Proposal,
+	bondAmount uint64,
+	options ...common.Option,
+) (*txs.AddProposalTx, error) {
+	ops := common.NewOptions(options)
+	inputs, outputs, err := b.lock(bondAmount, b.backend.BaseTxFee(), locked.StateBonded, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.AddProposalTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		Proposal: proposal,
+	}, nil
+}
+
+func (b *builder) NewAddVoteTx(
+	proposalID ids.ID,
+	optionIndex uint32,
+	voterAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.AddVoteTx, error) {
+	ops := common.NewOptions(options)
+	inputs, outputs, err := b.lock(0, b.backend.BaseTxFee(), locked.StateUnlocked, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	voterAuth, err := b.authorizeAddress(voterAddress, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.AddVoteTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		ProposalID:   proposalID,
+		OptionIndex:  optionIndex,
+		VoterAddress: voterAddress,
+		VoterAuth:    voterAuth,
+	}, nil
+}
+
+func (b *builder) NewMultisigAliasTx(
+	alias ids.ShortID,
+	threshold uint32,
+	addresses []ids.ShortID,
+	options ...common.Option,
+) (*txs.MultisigAliasTx, error) {
+	ops := common.NewOptions(options)
+	inputs, outputs, err := b.lock(0, b.backend.BaseTxFee(), locked.StateUnlocked, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	aliasAuth := &secp256k1fx.Input{}
+	if alias != ids.ShortEmpty {
+		aliasAuth, err = b.authorizeAddress(alias, ops)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sortedAddresses := make([]ids.ShortID, len(addresses))
+	copy(sortedAddresses, addresses)
+	utils.Sort(sortedAddresses)
+
+	return &txs.MultisigAliasTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		Alias:     alias,
+		Threshold: threshold,
+		Addresses: sortedAddresses,
+		AliasAuth: aliasAuth,
+	}, nil
+}
+
+func (b *builder) NewMultisigAliasPolicyTx(
+	alias ids.ShortID,
+	dailyLimit uint64,
+	largeAmount uint64,
+	largeThreshold uint32,
+	largeDelay uint64,
+	options ...common.Option,
+) (*txs.MultisigAliasPolicyTx, error) {
+	ops := common.NewOptions(options)
+	inputs, outputs, err := b.lock(0, b.backend.BaseTxFee(), locked.StateUnlocked, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	aliasAuth, err := b.authorizeAddress(alias, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.MultisigAliasPolicyTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		Alias:          alias,
+		DailyLimit:     dailyLimit,
+		LargeAmount:    largeAmount,
+		LargeThreshold: largeThreshold,
+		LargeDelay:     largeDelay,
+		AliasAuth:      aliasAuth,
+	}, nil
+}
+
+func (b *builderWithOptions) NewCaminoAddValidatorTx(
+	vdr *validator.Validator,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.CaminoAddValidatorTx, error) {
+	return b.Builder.NewCaminoAddValidatorTx(
+		vdr,
+		rewardsOwner,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewAddAddressStateTx(
+	address ids.ShortID,
+	remove bool,
+	state uint8,
+	options ...common.Option,
+) (*txs.AddAddressStateTx, error) {
+	return b.Builder.NewAddAddressStateTx(
+		address,
+		remove,
+		state,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewSetKycExpirationTx(
+	address ids.ShortID,
+	expiration uint64,
+	options ...common.Option,
+) (*txs.SetKycExpirationTx, error) {
+	return b.Builder.NewSetKycExpirationTx(
+		address,
+		expiration,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewDepositTx(
+	amount uint64,
+	duration uint32,
+	depositOfferID ids.ID,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.DepositTx, error) {
+	return b.Builder.NewDepositTx(
+		amount,
+		duration,
+		depositOfferID,
+		rewardsOwner,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewUnlockDepositTx(
+	unlockAmounts map[ids.ID]uint64,
+	options ...common.Option,
+) (*txs.UnlockDepositTx, error) {
+	return b.Builder.NewUnlockDepositTx(
+		unlockAmounts,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewRegisterNodeTx(
+	oldNodeID ids.NodeID,
+	newNodeID ids.NodeID,
+	consortiumMemberAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.RegisterNodeTx, error) {
+	return b.Builder.NewRegisterNodeTx(
+		oldNodeID,
+		newNodeID,
+		consortiumMemberAddress,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewAddDepositOfferTx(
+	depositOfferID ids.ID,
+	offer *deposit.Offer,
+	options ...common.Option,
+) (*txs.AddDepositOfferTx, error) {
+	return b.Builder.NewAddDepositOfferTx(
+		depositOfferID,
+		offer,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewClaimTx(
+	claimAmounts map[ids.ID]uint64,
+	options ...common.Option,
+) (*txs.ClaimTx, error) {
+	return b.Builder.NewClaimTx(
+		claimAmounts,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewAddProposalTx(
+	proposal dao.Proposal,
+	bondAmount uint64,
+	options ...common.Option,
+) (*txs.AddProposalTx, error) {
+	return b.Builder.NewAddProposalTx(
+		proposal,
+		bondAmount,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewAddVoteTx(
+	proposalID ids.ID,
+	optionIndex uint32,
+	voterAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.AddVoteTx, error) {
+	return b.Builder.NewAddVoteTx(
+		proposalID,
+		optionIndex,
+		voterAddress,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewMultisigAliasTx(
+	alias ids.ShortID,
+	threshold uint32,
+	addresses []ids.ShortID,
+	options ...common.Option,
+) (*txs.MultisigAliasTx, error) {
+	return b.Builder.NewMultisigAliasTx(
+		alias,
+		threshold,
+		addresses,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewMultisigAliasPolicyTx(
+	alias ids.ShortID,
+	dailyLimit uint64,
+	largeAmount uint64,
+	largeThreshold uint32,
+	largeDelay uint64,
+	options ...common.Option,
+) (*txs.MultisigAliasPolicyTx, error) {
+	return b.Builder.NewMultisigAliasPolicyTx(
+		alias,
+		dailyLimit,
+		largeAmount,
+		largeThreshold,
+		largeDelay,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+// lock takes in the requested lock and burn amounts of AVAX.
+//
+//   - [amountToLock] is the amount of AVAX that will be locked with
+//     [appliedLockState]. First UTXOs, that are already locked with other lock
+//     state, are attempted to be used for these funds, and then unlocked UTXOs
+//     will be attempted to be used. Locked outputs keep owners of the consumed
+//     UTXOs. Must be zero, if [appliedLockState] is unlocked.
+//   - [amountToBurn] is the amount of AVAX that will be consumed without
+//     producing an output. Only unlocked UTXOs are able to be burned here.
+//
+// UTXOs owned by multisig aliases known to the backend are spent, if the
+// builder has enough addresses of the alias owners.
+func (b *builder) lock(
+	amountToLock uint64,
+	amountToBurn uint64,
+	appliedLockState locked.State,
+	options *common.Options,
+) (
+	inputs []*avax.TransferableInput,
+	outputs []*avax.TransferableOutput,
+	err error,
+) {
+	switch appliedLockState {
+	case locked.StateBonded, locked.StateDeposited:
+	case locked.StateUnlocked:
+		if amountToLock > 0 {
+			return nil, nil, errLockAmountNotZero
+		}
+	default:
+		return nil, nil, errInvalidTargetLockState
+	}
+
+	ctx := options.Context()
+	utxos, err := b.backend.UTXOs(ctx, constants.PlatformChainID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	addrs := options.Addresses(b.addrs)
+	minIssuanceTime := options.MinIssuanceTime()
+	avaxAssetID := b.backend.AVAXAssetID()
+
+	addr, ok := addrs.Peek()
+	if !ok {
+		return nil, nil, errNoChangeAddress
+	}
+	changeOwner := options.ChangeOwner(&secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{addr},
+	})
+
+	// Iterate over the locked UTXOs
+	for _, utxo := range utxos {
+		// If we have locked enough AVAX, then we have no need to consume more
+		// locked UTXOs.
+		if amountToLock == 0 {
+			break
+		}
+
+		if utxo.AssetID() != avaxAssetID {
+			continue
+		}
+
+		lockedOut, ok := utxo.Out.(*locked.Out)
+		if !ok {
+			// This output isn't locked, so it will be handled during the next
+			// iteration of the UTXO set
+			continue
+		}
+		if lockedOut.IsLockedWith(appliedLockState) {
+			// This output is already locked with [appliedLockState], so it
+			// can't be locked again
+			continue
+		}
+
+		out, ok := lockedOut.TransferableOut.(*secp256k1fx.TransferOutput)
+		if !ok {
+			return nil, nil, errUnknownOutputType
+		}
+
+		inputSigIndices, ok, err := b.matchOwners(ctx, &out.OutputOwners, addrs, minIssuanceTime)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			// We couldn't spend this UTXO, so we skip to the next one
+			continue
+		}
+
+		inputs = append(inputs, &avax.TransferableInput{
+			UTXOID: utxo.UTXOID,
+			Asset:  utxo.Asset,
+			In: &locked.In{
+				IDs: lockedOut.IDs,
+				TransferableIn: &secp256k1fx.TransferInput{
+					Amt: out.Amt,
+					Input: secp256k1fx.Input{
+						SigIndices: inputSigIndices,
+					},
+				},
+			},
+		})
+
+		// Lock any value that should be locked
+		amountLocked := math.Min(
+			amountToLock, // Amount we still need to lock
+			out.Amt,      // Amount available to lock
+		)
+		amountToLock -= amountLocked
+
+		outputs = append(outputs, &avax.TransferableOutput{
+			Asset: utxo.Asset,
+			Out: &locked.Out{
+				IDs: lockedOut.IDs.Lock(appliedLockState),
+				TransferableOut: &secp256k1fx.TransferOutput{
+					Amt:          amountLocked,
+					OutputOwners: out.OutputOwners,
+				},
+			},
+		})
+		if remainingAmount := out.Amt - amountLocked; remainingAmount > 0 {
+			// This input had extra value, so some of it must be returned with
+			// its original lock
+			outputs = append(outputs, &avax.TransferableOutput{
+				Asset: utxo.Asset,
+				Out: &locked.Out{
+					IDs: lockedOut.IDs,
+					TransferableOut: &secp256k1fx.TransferOutput{
+						Amt:          remainingAmount,
+						OutputOwners: out.OutputOwners,
+					},
+				},
+			})
+		}
+	}
+
+	// Iterate over the unlocked UTXOs
+	for _, utxo := range utxos {
+		// If we have consumed enough AVAX, then we have no need to consume
+		// more.
+		if amountToLock == 0 && amountToBurn == 0 {
+			break
+		}
+
+		if utxo.AssetID() != avaxAssetID {
+			continue
+		}
+
+		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok {
+			// This output is locked, so it can't be burned or used for
+			// locking with [appliedLockState] anymore
+			continue
+		}
+
+		inputSigIndices, ok, err := b.matchOwners(ctx, &out.OutputOwners, addrs, minIssuanceTime)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			// We couldn't spend this UTXO, so we skip to the next one
+			continue
+		}
+
+		inputs = append(inputs, &avax.TransferableInput{
+			UTXOID: utxo.UTXOID,
+			Asset:  utxo.Asset,
+			In: &secp256k1fx.TransferInput{
+				Amt: out.Amt,
+				Input: secp256k1fx.Input{
+					SigIndices: inputSigIndices,
+				},
+			},
+		})
+
+		// Burn any value that should be burned
+		amountBurned := math.Min(
+			amountToBurn, // Amount we still need to burn
+			out.Amt,      // Amount available to burn
+		)
+		amountToBurn -= amountBurned
+
+		amountAvailableToLock := out.Amt - amountBurned
+		// Lock any value that should be locked
+		amountLocked := math.Min(
+			amountToLock,          // Amount we still need to lock
+			amountAvailableToLock, // Amount available to lock
+		)
+		amountToLock -= amountLocked
+		if amountLocked > 0 {
+			// Some of this input was put for locking
+			outputs = append(outputs, &avax.TransferableOutput{
+				Asset: utxo.Asset,
+				Out: &locked.Out{
+					IDs: locked.IDsEmpty.Lock(appliedLockState),
+					TransferableOut: &secp256k1fx.TransferOutput{
+						Amt:          amountLocked,
+						OutputOwners: out.OutputOwners,
+					},
+				},
+			})
+		}
+		if remainingAmount := amountAvailableToLock - amountLocked; remainingAmount > 0 {
+			// This input had extra value, so some of it must be returned
+			outputs = append(outputs, &avax.TransferableOutput{
+				Asset: utxo.Asset,
+				Out: &secp256k1fx.TransferOutput{
+					Amt:          remainingAmount,
+					OutputOwners: *changeOwner,
+				},
+			})
+		}
+	}
+
+	if amountToLock != 0 {
+		return nil, nil, fmt.Errorf(
+			"%w: provided UTXOs need %d more units of asset %q to lock",
+			errInsufficientFunds,
+			amountToLock,
+			avaxAssetID,
+		)
+	}
+	if amountToBurn != 0 {
+		return nil, nil, fmt.Errorf(
+			"%w: provided UTXOs need %d more units of asset %q",
+			errInsufficientFunds,
+			amountToBurn,
+			avaxAssetID,
+		)
+	}
+
+	utils.Sort(inputs)                               // sort inputs
+	avax.SortTransferableOutputs(outputs, txs.Codec) // sort outputs
+	return inputs, outputs, nil
+}
+
+// unlockDeposit consumes deposited UTXOs and unlocks requested amounts of
+// AVAX from them.
+//
+//   - [unlockAmounts] maps deposit tx ID to the amount of AVAX that will be
+//     unlocked from this deposit. Unlocked outputs could be still bonded.
+func (b *builder) unlockDeposit(
+	unlockAmounts map[ids.ID]uint64,
+	options *common.Options,
+) (
+	inputs []*avax.TransferableInput,
+	outputs []*avax.TransferableOutput,
+	err error,
+) {
+	ctx := options.Context()
+	utxos, err := b.backend.UTXOs(ctx, constants.PlatformChainID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	addrs := options.Addresses(b.addrs)
+	minIssuanceTime := options.MinIssuanceTime()
+
+	amountsToUnlock := make(map[ids.ID]uint64, len(unlockAmounts))
+	for depositTxID, amount := range unlockAmounts {
+		amountsToUnlock[depositTxID] = amount
+	}
+
+	for _, utxo := range utxos {
+		lockedOut, ok := utxo.Out.(*locked.Out)
+		if !ok || lockedOut.DepositTxID == ids.Empty {
+			// This output isn't deposited
+			continue
+		}
+
+		amountToUnlock := amountsToUnlock[lockedOut.DepositTxID]
+		if amountToUnlock == 0 {
+			// We have unlocked enough of this deposit or it wasn't requested
+			continue
+		}
+
+		out, ok := lockedOut.TransferableOut.(*secp256k1fx.TransferOutput)
+		if !ok {
+			return nil, nil, errUnknownOutputType
+		}
+
+		inputSigIndices, ok, err := b.matchOwners(ctx, &out.OutputOwners, addrs, minIssuanceTime)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			// We couldn't spend this UTXO, so we skip to the next one
+			continue
+		}
+
+		inputs = append(inputs, &avax.TransferableInput{
+			UTXOID: utxo.UTXOID,
+			Asset:  utxo.Asset,
+			In: &locked.In{
+				IDs: lockedOut.IDs,
+				TransferableIn: &secp256k1fx.TransferInput{
+					Amt: out.Amt,
+					Input: secp256k1fx.Input{
+						SigIndices: inputSigIndices,
+					},
+				},
+			},
+		})
+
+		amountUnlocked := math.Min(
+			amountToUnlock, // Amount we still need to unlock
+			out.Amt,        // Amount available to unlock
+		)
+		amountsToUnlock[lockedOut.DepositTxID] -= amountUnlocked
+
+		var unlockedOut avax.TransferableOut = &secp256k1fx.TransferOutput{
+			Amt:          amountUnlocked,
+			OutputOwners: out.OutputOwners,
+		}
+		if newLockIDs := lockedOut.Unlock(locked.StateDeposited); newLockIDs.IsLocked() {
+			// This output is still bonded
+			unlockedOut = &locked.Out{
+				IDs:             newLockIDs,
+				TransferableOut: unlockedOut,
+			}
+		}
+		outputs = append(outputs, &avax.TransferableOutput{
+			Asset: utxo.Asset,
+			Out:   unlockedOut,
+		})
+
+		if remainingAmount := out.Amt - amountUnlocked; remainingAmount > 0 {
+			// This input had extra value, so some of it must stay deposited
+			outputs = append(outputs, &avax.TransferableOutput{
+				Asset: utxo.Asset,
+				Out: &locked.Out{
+					IDs: lockedOut.IDs,
+					TransferableOut: &secp256k1fx.TransferOutput{
+						Amt:          remainingAmount,
+						OutputOwners: out.OutputOwners,
+					},
+				},
+			})
+		}
+	}
+
+	for depositTxID, amount := range amountsToUnlock {
+		if amount != 0 {
+			return nil, nil, fmt.Errorf(
+				"%w: provided UTXOs need %d more units deposited by %q",
+				errInsufficientFunds,
+				amount,
+				depositTxID,
+			)
+		}
+	}
+
+	utils.Sort(inputs)                               // sort inputs
+	avax.SortTransferableOutputs(outputs, txs.Codec) // sort outputs
+	return inputs, outputs, nil
+}
+
+// matchOwners attempts to match [addrs] to the owners, whose signatures are
+// required to spend output owned by [owners].
+func (b *builder) matchOwners(
+	ctx stdcontext.Context,
+	owners *secp256k1fx.OutputOwners,
+	addrs set.Set[ids.ShortID],
+	minIssuanceTime uint64,
+) ([]uint32, bool, error) {
+	spendingOwners, err := getSpendingOwners(ctx, b.backend, owners)
+	if err != nil {
+		return nil, false, err
+	}
+	inputSigIndices, ok := common.MatchOwners(spendingOwners, addrs, minIssuanceTime)
+	return inputSigIndices, ok, nil
+}
+
+// authorizeAddress returns auth for [address], which could be multisig alias.
+func (b *builder) authorizeAddress(address ids.ShortID, options *common.Options) (*secp256k1fx.Input, error) {
+	addrs := options.Addresses(b.addrs)
+	minIssuanceTime := options.MinIssuanceTime()
+	inputSigIndices, ok, err := b.matchOwners(
+		options.Context(),
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{address},
+		},
+		addrs,
+		minIssuanceTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		// We can't authorize the address
+		return nil, errInsufficientAuthorization
+	}
+	return &secp256k1fx.Input{
+		SigIndices: inputSigIndices,
+	}, nil
+}
+
+func (b *builder) authorizeDepositRewards(depositTxID ids.ID, options *common.Options) (*secp256k1fx.Input, error) {
+	depositTx, err := b.backend.GetTx(options.Context(), depositTxID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to fetch deposit %q: %w",
+			depositTxID,
+			err,
+		)
+	}
+	unsignedDepositTx, ok := depositTx.Unsigned.(*txs.DepositTx)
+	if !ok {
+		return nil, errWrongTxType
+	}
+
+	owner, ok := unsignedDepositTx.RewardsOwner.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return nil, errUnknownOwnerType
+	}
+
+	addrs := options.Addresses(b.addrs)
+	minIssuanceTime := options.MinIssuanceTime()
+	inputSigIndices, ok := common.MatchOwners(owner, addrs, minIssuanceTime)
+	if !ok {
+		// We can't authorize the deposit rewards
+		return nil, errInsufficientAuthorization
+	}
+	return &secp256k1fx.Input{
+		SigIndices: inputSigIndices,
+	}, nil
+}
@@ -4,8 +4,22 @@
 package p
 
 import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var (
+	errUnknownRewardsAuthType          = errors.New("unknown rewards auth type")
+	errUnknownVoterAuthType            = errors.New("unknown voter auth type")
+	errUnknownConsortiumMemberAuthType = errors.New("unknown consortium member auth type")
+	errUnknownAliasAuthType            = errors.New("unknown multisig alias auth type")
 )
 
 // backend
@@ -26,6 +40,41 @@ func (b *backendVisitor) RegisterNodeTx(tx *txs.RegisterNodeTx) error {
 	return b.baseTx(&tx.BaseTx)
 }
 
+func (b *backendVisitor) AddDepositOfferTx(tx *txs.AddDepositOfferTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) ClaimTx(tx *txs.ClaimTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) AddProposalTx(tx *txs.AddProposalTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) AddVoteTx(tx *txs.AddVoteTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (*backendVisitor) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	return errUnsupportedTxType
+}
+
+func (b *backendVisitor) MultisigAliasTx(tx *txs.MultisigAliasTx) error {
+	if err := b.multisigAlias(tx); err != nil {
+		return err
+	}
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) MultisigAliasPolicyTx(tx *txs.MultisigAliasPolicyTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) SetKycExpirationTx(tx *txs.SetKycExpirationTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
 // signer
 
 func (s *signerVisitor) AddAddressStateTx(tx *txs.AddAddressStateTx) error {
@@ -57,5 +106,199 @@ func (s *signerVisitor) RegisterNodeTx(tx *txs.RegisterNodeTx) error {
 	if err != nil {
 		return err
 	}
+	nodeSigners := []keychain.Signer{}
+	if tx.NewNodeID != ids.EmptyNodeID {
+		nodeSigners = make([]keychain.Signer, 1)
+		if key, ok := s.kc.Get(ids.ShortID(tx.NewNodeID)); ok {
+			nodeSigners[0] = key
+		}
+	}
+	consortiumMemberSigners, err := s.getConsortiumMemberSigners(tx.ConsortiumMemberAddress, tx.ConsortiumMemberAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, nodeSigners, consortiumMemberSigners)
+	return sign(s.tx, txSigners)
+}
+
+func (s *signerVisitor) AddDepositOfferTx(tx *txs.AddDepositOfferTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	return sign(s.tx, txSigners)
+}
+
+func (s *signerVisitor) ClaimTx(tx *txs.ClaimTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	for i, depositTxID := range tx.DepositTxIDs {
+		rewardsSigners, err := s.getDepositRewardsSigners(depositTxID, tx.RewardsAuth[i])
+		if err != nil {
+			return err
+		}
+		txSigners = append(txSigners, rewardsSigners)
+	}
+	return sign(s.tx, txSigners)
+}
+
+func (s *signerVisitor) AddProposalTx(tx *txs.AddProposalTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	return sign(s.tx, txSigners)
+}
+
+func (s *signerVisitor) AddVoteTx(tx *txs.AddVoteTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	voterSigners, err := s.getVoterSigners(tx.VoterAddress, tx.VoterAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, voterSigners)
+	return sign(s.tx, txSigners)
+}
+
+func (*signerVisitor) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	return errUnsupportedTxType
+}
+
+func (s *signerVisitor) MultisigAliasTx(tx *txs.MultisigAliasTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	if tx.Alias != ids.ShortEmpty {
+		// Alias update must be authorized by its current owners
+		aliasSigners, err := s.getAliasSigners(tx.Alias, tx.AliasAuth)
+		if err != nil {
+			return err
+		}
+		txSigners = append(txSigners, aliasSigners)
+	}
+	return sign(s.tx, txSigners)
+}
+
+func (s *signerVisitor) MultisigAliasPolicyTx(tx *txs.MultisigAliasPolicyTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	// Alias policy change must be authorized by its current owners
+	aliasSigners, err := s.getAliasSigners(tx.Alias, tx.AliasAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, aliasSigners)
 	return sign(s.tx, txSigners)
 }
+
+func (s *signerVisitor) SetKycExpirationTx(tx *txs.SetKycExpirationTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	return sign(s.tx, txSigners)
+}
+
+func (s *signerVisitor) getAliasSigners(alias ids.ShortID, aliasAuth verify.Verifiable) ([]keychain.Signer, error) {
+	aliasInput, ok := aliasAuth.(*secp256k1fx.Input)
+	if !ok {
+		return nil, errUnknownAliasAuthType
+	}
+	return s.getAddressSigners(alias, aliasInput)
+}
+
+func (s *signerVisitor) getVoterSigners(voterAddress ids.ShortID, voterAuth verify.Verifiable) ([]keychain.Signer, error) {
+	voterInput, ok := voterAuth.(*secp256k1fx.Input)
+	if !ok {
+		return nil, errUnknownVoterAuthType
+	}
+	return s.getAddressSigners(voterAddress, voterInput)
+}
+
+func (s *signerVisitor) getConsortiumMemberSigners(
+	consortiumMemberAddress ids.ShortID,
+	consortiumMemberAuth verify.Verifiable,
+) ([]keychain.Signer, error) {
+	consortiumMemberInput, ok := consortiumMemberAuth.(*secp256k1fx.Input)
+	if !ok {
+		return nil, errUnknownConsortiumMemberAuthType
+	}
+	return s.getAddressSigners(consortiumMemberAddress, consortiumMemberInput)
+}
+
+// getAddressSigners returns signers for [input], which authorizes [address].
+// If [address] is multisig alias, then [input] must be signed by alias owners.
+func (s *signerVisitor) getAddressSigners(address ids.ShortID, input *secp256k1fx.Input) ([]keychain.Signer, error) {
+	owners, err := getSpendingOwners(s.ctx, s.backend, &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{address},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	authSigners := make([]keychain.Signer, len(input.SigIndices))
+	for sigIndex, addrIndex := range input.SigIndices {
+		if addrIndex >= uint32(len(owners.Addrs)) {
+			return nil, errInvalidUTXOSigIndex
+		}
+		key, ok := s.kc.Get(owners.Addrs[addrIndex])
+		if !ok {
+			// If we don't have access to the key, then we can't sign this
+			// transaction. However, we can attempt to partially sign it.
+			continue
+		}
+		authSigners[sigIndex] = key
+	}
+	return authSigners, nil
+}
+
+func (s *signerVisitor) getDepositRewardsSigners(depositTxID ids.ID, rewardsAuth verify.Verifiable) ([]keychain.Signer, error) {
+	rewardsInput, ok := rewardsAuth.(*secp256k1fx.Input)
+	if !ok {
+		return nil, errUnknownRewardsAuthType
+	}
+
+	depositTx, err := s.backend.GetTx(s.ctx, depositTxID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to fetch deposit %q: %w",
+			depositTxID,
+			err,
+		)
+	}
+	deposit, ok := depositTx.Unsigned.(*txs.DepositTx)
+	if !ok {
+		return nil, errWrongTxType
+	}
+
+	owner, ok := deposit.RewardsOwner.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return nil, errUnknownOwnerType
+	}
+
+	authSigners := make([]keychain.Signer, len(rewardsInput.SigIndices))
+	for sigIndex, addrIndex := range rewardsInput.SigIndices {
+		if addrIndex >= uint32(len(owner.Addrs)) {
+			return nil, errInvalidUTXOSigIndex
+		}
+
+		addr := owner.Addrs[addrIndex]
+		key, ok := s.kc.Get(addr)
+		if !ok {
+			// If we don't have access to the key, then we can't sign this
+			// transaction. However, we can attempt to partially sign it.
+			continue
+		}
+		authSigners[sigIndex] = key
+	}
+	return authSigners, nil
+}
@@ -0,0 +1,484 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/validator"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+)
+
+// CaminoWallet provides an interface for issuing camino P-chain transactions.
+type CaminoWallet interface {
+	// IssueCaminoAddValidatorTx creates, signs, and issues a new validator of
+	// the primary network, which stake is bonded.
+	//
+	// - [vdr] specifies all the details of the validation period such as the
+	//   startTime, endTime, stake weight, and nodeID.
+	// - [rewardsOwner] specifies the owner of all the rewards this validator
+	//   may accrue during its validation period.
+	IssueCaminoAddValidatorTx(
+		vdr *validator.Validator,
+		rewardsOwner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueAddAddressStateTx creates, signs, and issues a transaction that
+	// adds or removes address state.
+	//
+	// - [address] specifies the address which state will be modified.
+	// - [remove] specifies whether the state will be removed or added.
+	// - [state] specifies the bit index of the state.
+	IssueAddAddressStateTx(
+		address ids.ShortID,
+		remove bool,
+		state uint8,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueSetKycExpirationTx creates, signs, and issues a transaction that
+	// sets or clears expiration of address kyc verified state.
+	//
+	// - [address] specifies the address which kyc expiration will be set.
+	// - [expiration] specifies the unix timestamp at which kyc verified
+	//   state will expire. Zero means that it never expires.
+	IssueSetKycExpirationTx(
+		address ids.ShortID,
+		expiration uint64,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueDepositTx creates, signs, and issues a new deposit of AVAX.
+	//
+	// - [amount] specifies the amount of AVAX that will be deposited.
+	// - [duration] specifies the duration of the deposit in seconds.
+	// - [depositOfferID] specifies the offer that will be used for deposit.
+	// - [rewardsOwner] specifies the owner of deposit rewards.
+	IssueDepositTx(
+		amount uint64,
+		duration uint32,
+		depositOfferID ids.ID,
+		rewardsOwner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueUnlockDepositTx creates, signs, and issues a transaction that
+	// unlocks deposited AVAX.
+	//
+	// - [unlockAmounts] maps deposit tx ID to the amount of AVAX that will be
+	//   unlocked from this deposit. Expired deposits must be unlocked fully.
+	IssueUnlockDepositTx(
+		unlockAmounts map[ids.ID]uint64,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueRegisterNodeTx creates, signs, and issues a transaction that
+	// registers node for consortium member.
+	//
+	// - [oldNodeID] specifies the node that will be unregistered. Could be
+	//   empty, if there is no registered node.
+	// - [newNodeID] specifies the node that will be registered. Could be
+	//   empty, if node is only unregistered.
+	// - [consortiumMemberAddress] specifies the consortium member address.
+	IssueRegisterNodeTx(
+		oldNodeID ids.NodeID,
+		newNodeID ids.NodeID,
+		consortiumMemberAddress ids.ShortID,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueAddDepositOfferTx creates, signs, and issues a transaction that
+	// adds a new deposit offer or updates existing one.
+	//
+	// - [depositOfferID] specifies the offer that will be updated. If empty,
+	//   new offer will be added.
+	// - [offer] specifies the offer.
+	IssueAddDepositOfferTx(
+		depositOfferID ids.ID,
+		offer *deposit.Offer,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueClaimTx creates, signs, and issues a transaction that claims
+	// deposits rewards.
+	//
+	// - [claimAmounts] maps deposit tx ID to the amount of rewards that will be
+	//   claimed for this deposit.
+	IssueClaimTx(
+		claimAmounts map[ids.ID]uint64,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueAddProposalTx creates, signs, and issues a new dao proposal.
+	//
+	// - [proposal] specifies the proposal.
+	// - [bondAmount] specifies the amount of AVAX that will be bonded until
+	//   the proposal voting ends.
+	IssueAddProposalTx(
+		proposal dao.Proposal,
+		bondAmount uint64,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueAddVoteTx creates, signs, and issues a new vote for dao proposal.
+	//
+	// - [proposalID] specifies the proposal that is voted on.
+	// - [optionIndex] specifies the proposal option that is voted for.
+	// - [voterAddress] specifies the consortium member address that votes.
+	IssueAddVoteTx(
+		proposalID ids.ID,
+		optionIndex uint32,
+		voterAddress ids.ShortID,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueMultisigAliasTx creates, signs, and issues a transaction that
+	// creates a new multisig alias or updates existing one.
+	//
+	// - [alias] specifies the multisig alias that will be updated. If empty,
+	//   new alias will be created.
+	// - [threshold] specifies the number of owners signatures required to
+	//   authorize the alias.
+	// - [addresses] specifies the owners of the alias.
+	IssueMultisigAliasTx(
+		alias ids.ShortID,
+		threshold uint32,
+		addresses []ids.ShortID,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueMultisigAliasPolicyTx creates, signs, and issues a transaction
+	// that sets spending policy of multisig alias. Policy with all
+	// parameters set to zero removes the alias policy.
+	//
+	// - [alias] specifies the multisig alias, which policy will be set.
+	// - [dailyLimit] specifies max AVAX amount, that can be spent from the
+	//   alias during one day. Zero means no limit.
+	// - [largeAmount] specifies AVAX amount, above which spendings are large.
+	//   Zero means there are no large spendings.
+	// - [largeThreshold] specifies the number of owners signatures required
+	//   for large spendings.
+	// - [largeDelay] specifies duration in seconds, for which outputs of
+	//   large spendings must be timelocked.
+	IssueMultisigAliasPolicyTx(
+		alias ids.ShortID,
+		dailyLimit uint64,
+		largeAmount uint64,
+		largeThreshold uint32,
+		largeDelay uint64,
+		options ...common.Option,
+	) (ids.ID, error)
+}
+
+func (w *wallet) IssueCaminoAddValidatorTx(
+	vdr *validator.Validator,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewCaminoAddValidatorTx(vdr, rewardsOwner, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueAddAddressStateTx(
+	address ids.ShortID,
+	remove bool,
+	state uint8,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewAddAddressStateTx(address, remove, state, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueSetKycExpirationTx(
+	address ids.ShortID,
+	expiration uint64,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewSetKycExpirationTx(address, expiration, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueDepositTx(
+	amount uint64,
+	duration uint32,
+	depositOfferID ids.ID,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewDepositTx(amount, duration, depositOfferID, rewardsOwner, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueUnlockDepositTx(
+	unlockAmounts map[ids.ID]uint64,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewUnlockDepositTx(unlockAmounts, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueRegisterNodeTx(
+	oldNodeID ids.NodeID,
+	newNodeID ids.NodeID,
+	consortiumMemberAddress ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewRegisterNodeTx(oldNodeID, newNodeID, consortiumMemberAddress, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueAddDepositOfferTx(
+	depositOfferID ids.ID,
+	offer *deposit.Offer,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewAddDepositOfferTx(depositOfferID, offer, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueClaimTx(
+	claimAmounts map[ids.ID]uint64,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewClaimTx(claimAmounts, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueAddProposalTx(
+	proposal dao.Proposal,
+	bondAmount uint64,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewAddProposalTx(proposal, bondAmount, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueAddVoteTx(
+	proposalID ids.ID,
+	optionIndex uint32,
+	voterAddress ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewAddVoteTx(proposalID, optionIndex, voterAddress, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueMultisigAliasTx(
+	alias ids.ShortID,
+	threshold uint32,
+	addresses []ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewMultisigAliasTx(alias, threshold, addresses, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueMultisigAliasPolicyTx(
+	alias ids.ShortID,
+	dailyLimit uint64,
+	largeAmount uint64,
+	largeThreshold uint32,
+	largeDelay uint64,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewMultisigAliasPolicyTx(alias, dailyLimit, largeAmount, largeThreshold, largeDelay, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *walletWithOptions) IssueCaminoAddValidatorTx(
+	vdr *validator.Validator,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.Wallet.IssueCaminoAddValidatorTx(
+		vdr,
+		rewardsOwner,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) IssueAddAddressStateTx(
+	address ids.ShortID,
+	remove bool,
+	state uint8,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.Wallet.IssueAddAddressStateTx(
+		address,
+		remove,
+		state,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) IssueSetKycExpirationTx(
+	address ids.ShortID,
+	expiration uint64,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.Wallet.IssueSetKycExpirationTx(
+		address,
+		expiration,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) IssueDepositTx(
+	amount uint64,
+	duration uint32,
+	depositOfferID ids.ID,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.Wallet.IssueDepositTx(
+		amount,
+		duration,
+		depositOfferID,
+		rewardsOwner,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) IssueUnlockDepositTx(
+	unlockAmounts map[ids.ID]uint64,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.Wallet.IssueUnlockDepositTx(
+		unlockAmounts,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) IssueRegisterNodeTx(
+	oldNodeID ids.NodeID,
+	newNodeID ids.NodeID,
+	consortiumMemberAddress ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.Wallet.IssueRegisterNodeTx(
+		oldNodeID,
+		newNodeID,
+		consortiumMemberAddress,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) IssueAddDepositOfferTx(
+	depositOfferID ids.ID,
+	offer *deposit.Offer,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.Wallet.IssueAddDepositOfferTx(
+		depositOfferID,
+		offer,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) IssueClaimTx(
+	claimAmounts map[ids.ID]uint64,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.Wallet.IssueClaimTx(
+		claimAmounts,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) IssueAddProposalTx(
+	proposal dao.Proposal,
+	bondAmount uint64,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.Wallet.IssueAddProposalTx(
+		proposal,
+		bondAmount,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) IssueAddVoteTx(
+	proposalID ids.ID,
+	optionIndex uint32,
+	voterAddress ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.Wallet.IssueAddVoteTx(
+		proposalID,
+		optionIndex,
+		voterAddress,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) IssueMultisigAliasTx(
+	alias ids.ShortID,
+	threshold uint32,
+	addresses []ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.Wallet.IssueMultisigAliasTx(
+		alias,
+		threshold,
+		addresses,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) IssueMultisigAliasPolicyTx(
+	alias ids.ShortID,
+	dailyLimit uint64,
+	largeAmount uint64,
+	largeThreshold uint32,
+	largeDelay uint64,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.Wallet.IssueMultisigAliasPolicyTx(
+		alias,
+		dailyLimit,
+		largeAmount,
+		largeThreshold,
+		largeDelay,
+		common.UnionOptions(w.options, options)...,
+	)
+}
@@ -20,6 +20,8 @@ type Signer interface {
 }
 
 type SignerBackend interface {
+	CaminoBackend
+
 	GetUTXO(ctx stdcontext.Context, chainID, utxoID ids.ID) (*avax.UTXO, error)
 	GetTx(ctx stdcontext.Context, txID ids.ID) (*txs.Tx, error)
 }
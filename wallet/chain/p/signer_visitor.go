@@ -17,6 +17,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/hashing"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
@@ -171,6 +172,9 @@ func (s *signerVisitor) getSigners(sourceChainID ids.ID, ins []*avax.Transferabl
 		if stakeableIn, ok := inIntf.(*stakeable.LockIn); ok {
 			inIntf = stakeableIn.TransferableIn
 		}
+		if lockedIn, ok := inIntf.(*locked.In); ok {
+			inIntf = lockedIn.TransferableIn
+		}
 
 		input, ok := inIntf.(*secp256k1fx.TransferInput)
 		if !ok {
@@ -195,18 +199,27 @@ func (s *signerVisitor) getSigners(sourceChainID ids.ID, ins []*avax.Transferabl
 		if stakeableOut, ok := outIntf.(*stakeable.LockOut); ok {
 			outIntf = stakeableOut.TransferableOut
 		}
+		if lockedOut, ok := outIntf.(*locked.Out); ok {
+			outIntf = lockedOut.TransferableOut
+		}
 
 		out, ok := outIntf.(*secp256k1fx.TransferOutput)
 		if !ok {
 			return nil, errUnknownOutputType
 		}
 
+		// UTXOs owned by multisig alias are signed by the alias owners
+		owners, err := getSpendingOwners(s.ctx, s.backend, &out.OutputOwners)
+		if err != nil {
+			return nil, err
+		}
+
 		for sigIndex, addrIndex := range input.SigIndices {
-			if addrIndex >= uint32(len(out.Addrs)) {
+			if addrIndex >= uint32(len(owners.Addrs)) {
 				return nil, errInvalidUTXOSigIndex
 			}
 
-			addr := out.Addrs[addrIndex]
+			addr := owners.Addrs[addrIndex]
 			key, ok := s.kc.Get(addr)
 			if !ok {
 				// If we don't have access to the key, then we can't sign this
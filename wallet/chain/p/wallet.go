@@ -26,6 +26,7 @@ var (
 
 type Wallet interface {
 	Context
+	CaminoWallet
 
 	// Builder returns the builder that will be used to create the transactions.
 	Builder() Builder
@@ -0,0 +1,53 @@
+// Copyright (C) 2022, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package primary
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/chain/p"
+)
+
+// NewCaminoWalletFromURI returns a wallet like NewWalletFromURI does, which is
+// also able to spend P-chain UTXOs owned by the provided multisig aliases.
+//
+// Owners of [msigAliases] are fetched from the provided [uri] together with
+// UTXOs that reference any of these aliases. Alias owned UTXOs are signed with
+// the keys of alias owners contained in [kc].
+func NewCaminoWalletFromURI(
+	ctx context.Context,
+	uri string,
+	kc keychain.Keychain,
+	msigAliases ...ids.ShortID,
+) (Wallet, error) {
+	pClient := platformvm.NewClient(uri)
+	addrs := set.NewSet[ids.ShortID](kc.Addresses().Len() + len(msigAliases))
+	addrs.Union(kc.Addresses())
+	msigAliasOwners := make(map[ids.ShortID]*secp256k1fx.OutputOwners, len(msigAliases))
+	for _, alias := range msigAliases {
+		owners, err := pClient.GetMultisigAlias(ctx, alias)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch multisig alias %s: %w", alias, err)
+		}
+		msigAliasOwners[alias] = owners
+		addrs.Add(alias)
+	}
+
+	pCTX, xCTX, utxos, err := FetchState(ctx, uri, addrs)
+	if err != nil {
+		return nil, err
+	}
+
+	pUTXOs := NewChainUTXOs(constants.PlatformChainID, utxos)
+	pBackend := p.NewCaminoBackend(pCTX, pUTXOs, make(map[ids.ID]*txs.Tx), msigAliasOwners)
+	return newWalletWithBackend(uri, pBackend, xCTX, utxos, kc), nil
+}
@@ -86,9 +86,19 @@ func NewWalletWithTxsAndState(
 	kc keychain.Keychain,
 	pTXs map[ids.ID]*txs.Tx,
 ) Wallet {
-	addrs := kc.Addresses()
 	pUTXOs := NewChainUTXOs(constants.PlatformChainID, utxos)
 	pBackend := p.NewBackend(pCTX, pUTXOs, pTXs)
+	return newWalletWithBackend(uri, pBackend, xCTX, utxos, kc)
+}
+
+func newWalletWithBackend(
+	uri string,
+	pBackend p.Backend,
+	xCTX x.Context,
+	utxos UTXOs,
+	kc keychain.Keychain,
+) Wallet {
+	addrs := kc.Addresses()
 	pBuilder := p.NewBuilder(addrs, pBackend)
 	pSigner := p.NewSigner(kc, pBackend)
 	pClient := platformvm.NewClient(uri)